@@ -0,0 +1,357 @@
+// Command journal captures entries from the terminal:
+//
+//	echo "note" | journal capture -tag work
+//	journal capture Remember to call the bank
+//	journal sync
+//
+// Entries are queued in a local spool file first and sent from there, so a
+// capture made while the server is unreachable is sent by the next capture
+// or sync. Entries the server rejects as invalid are moved to a rejected
+// file next to the spool so they do not hold back later captures.
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/parkernilson/micro-journal/gen/journal/v1"
+	"github.com/parkernilson/micro-journal/internal/manager"
+	"github.com/parkernilson/micro-journal/internal/spool"
+)
+
+// syncBatchSize is the number of queued entries sent per import batch.
+const syncBatchSize = 100
+
+// errUnreachable is returned by send when the server could not be reached
+// and the entries stay queued.
+var errUnreachable = errors.New("server unreachable")
+
+// options are the settings shared by every subcommand.
+type options struct {
+	server     string
+	tls        bool
+	serverCA   string
+	clientCert string
+	clientKey  string
+	spoolPath  string
+	timeout    time.Duration
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "capture":
+		err = capture(os.Args[2:])
+	case "sync":
+		err = syncCommand(os.Args[2:])
+	case "-h", "-help", "--help", "help":
+		usage()
+		return
+	default:
+		usage()
+		os.Exit(2)
+	}
+	if errors.Is(err, flag.ErrHelp) {
+		return
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "journal: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n  %[1]s capture [flags] [text...]\n  %[1]s sync [flags]\n", filepath.Base(os.Args[0]))
+}
+
+// capture runs the capture subcommand, which queues an entry read from the
+// arguments or stdin and sends every queued entry.
+func capture(args []string) error {
+	fs := flag.NewFlagSet("capture", flag.ContinueOnError)
+	opts := registerOptions(fs)
+	title := fs.String("title", "", "entry title; suggested from the content if empty")
+	var tags []string
+	fs.Func("tag", "tag the entry; may be repeated", func(tag string) error {
+		tags = append(tags, tag)
+		return nil
+	})
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	content, err := readContent(fs.Args(), os.Stdin)
+	if err != nil {
+		return err
+	}
+
+	// Check the tags as the server will, so an invalid one is reported now
+	// rather than when the entry is sent
+	tags, err = manager.NormalizeTags(tags)
+	if err != nil {
+		return err
+	}
+
+	queue := spool.NewSpool(opts.spoolPath)
+	if err := queue.Add(spool.Entry{Title: *title, Content: content, Tags: tags, CreatedAt: time.Now()}); err != nil {
+		return err
+	}
+
+	// The entry is safe in the queue, so an unreachable server is not an error
+	sent, rejected, err := send(opts, queue)
+	if errors.Is(err, errUnreachable) {
+		fmt.Fprintf(os.Stderr, "Server unreachable; queued in %s until the next capture or sync\n", queue.Path())
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	switch {
+	case sent > 1:
+		fmt.Printf("Captured, along with %d queued entries\n", sent-1)
+	case sent == 1:
+		fmt.Println("Captured")
+	}
+	return rejectedError(queue, rejected)
+}
+
+// syncCommand runs the sync subcommand, which sends every queued entry.
+func syncCommand(args []string) error {
+	fs := flag.NewFlagSet("sync", flag.ContinueOnError)
+	opts := registerOptions(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return fmt.Errorf("unexpected arguments: %v", fs.Args())
+	}
+
+	queue := spool.NewSpool(opts.spoolPath)
+	sent, rejected, err := send(opts, queue)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Sent %d queued entries\n", sent)
+	return rejectedError(queue, rejected)
+}
+
+// rejectedError returns an error saying where rejected entries were moved,
+// or nil if there are none.
+func rejectedError(queue *spool.Spool, rejected int) error {
+	if rejected == 0 {
+		return nil
+	}
+	return fmt.Errorf("the server rejected %d entries as invalid; they were moved to %s", rejected, queue.RejectedPath())
+}
+
+// registerOptions registers the shared flags on fs, with defaults from the
+// environment.
+func registerOptions(fs *flag.FlagSet) *options {
+	opts := &options{}
+	fs.StringVar(&opts.server, "server", envOr("MJ_SERVER", "localhost:50051"), "gRPC address of the journal server (MJ_SERVER)")
+	fs.BoolVar(&opts.tls, "tls", false, "connect with TLS, verifying the server against the system's CAs")
+	fs.StringVar(&opts.serverCA, "server-ca", os.Getenv("MJ_SERVER_CA"), "PEM file of CAs to verify the server against; implies -tls (MJ_SERVER_CA)")
+	fs.StringVar(&opts.clientCert, "client-cert", os.Getenv("MJ_CLIENT_CERT"), "PEM client certificate for mutual TLS (MJ_CLIENT_CERT)")
+	fs.StringVar(&opts.clientKey, "client-key", os.Getenv("MJ_CLIENT_KEY"), "PEM private key of the client certificate (MJ_CLIENT_KEY)")
+	fs.StringVar(&opts.spoolPath, "spool", envOr("MJ_SPOOL", defaultSpoolPath()), "file entries are queued in until sent (MJ_SPOOL)")
+	fs.DurationVar(&opts.timeout, "timeout", 10*time.Second, "how long to try reaching the server")
+	return opts
+}
+
+// envOr returns the environment variable name, or fallback if it is unset.
+func envOr(name, fallback string) string {
+	if v := os.Getenv(name); v != "" {
+		return v
+	}
+	return fallback
+}
+
+// defaultSpoolPath returns the spool file under $XDG_STATE_HOME, or
+// ~/.local/state if it is unset.
+func defaultSpoolPath() string {
+	dir := os.Getenv("XDG_STATE_HOME")
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "micro-journal-spool.jsonl"
+		}
+		dir = filepath.Join(home, ".local", "state")
+	}
+	return filepath.Join(dir, "micro-journal", "spool.jsonl")
+}
+
+// readContent returns the entry text from args, or from stdin if there are
+// no arguments and stdin is not a terminal.
+func readContent(args []string, stdin *os.File) (string, error) {
+	content := strings.Join(args, " ")
+	if content == "" {
+		info, err := stdin.Stat()
+		if err != nil {
+			return "", fmt.Errorf("failed to inspect stdin: %w", err)
+		}
+		if info.Mode()&os.ModeCharDevice != 0 {
+			return "", fmt.Errorf("nothing to capture; pass the text as arguments or pipe it to stdin")
+		}
+		data, err := io.ReadAll(stdin)
+		if err != nil {
+			return "", fmt.Errorf("failed to read stdin: %w", err)
+		}
+		content = string(data)
+	}
+
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return "", fmt.Errorf("nothing to capture; the text is empty")
+	}
+	return content, nil
+}
+
+// send sends every queued entry to the server and removes them from the
+// queue, returning the number sent and the number rejected. Entries the
+// server rejects as invalid are moved to the queue's rejected file. If the
+// server cannot be reached, the remaining entries stay queued and
+// errUnreachable is returned.
+func send(opts *options, queue *spool.Spool) (int, int, error) {
+	entries, err := queue.Pending()
+	if err != nil || len(entries) == 0 {
+		return 0, 0, err
+	}
+
+	creds, err := transportCredentials(opts)
+	if err != nil {
+		return 0, 0, err
+	}
+	conn, err := grpc.NewClient(opts.server, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid server address: %w", err)
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), opts.timeout)
+	defer cancel()
+
+	client := pb.NewJournalServiceClient(conn)
+	var sent, rejected []spool.Entry
+	err = importEntries(ctx, client, entries)
+	switch status.Code(err) {
+	case codes.OK:
+		sent = entries
+	case codes.InvalidArgument:
+		// The server does not say which entry is invalid, so send them one
+		// at a time to set the invalid ones aside
+		sent, rejected, err = importEach(ctx, client, entries)
+	}
+
+	if err := queue.Reject(rejected); err != nil {
+		return 0, 0, err
+	}
+	if err := queue.Remove(append(sent, rejected...)); err != nil {
+		return 0, 0, err
+	}
+
+	switch status.Code(err) {
+	case codes.OK:
+		return len(sent), len(rejected), nil
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return len(sent), len(rejected), fmt.Errorf("%w: %d entries stay queued in %s", errUnreachable, len(entries)-len(sent)-len(rejected), queue.Path())
+	default:
+		return len(sent), len(rejected), fmt.Errorf("server rejected the queued entries in %s: %w", queue.Path(), err)
+	}
+}
+
+// importEach sends entries one import at a time, returning those the server
+// accepted and those it rejected as invalid. It stops at the first other
+// error, returning it with the entries handled before it.
+func importEach(ctx context.Context, client pb.JournalServiceClient, entries []spool.Entry) (sent, rejected []spool.Entry, err error) {
+	for i := range entries {
+		err := importEntries(ctx, client, entries[i:i+1])
+		switch status.Code(err) {
+		case codes.OK:
+			sent = append(sent, entries[i])
+		case codes.InvalidArgument:
+			rejected = append(rejected, entries[i])
+		default:
+			return sent, rejected, err
+		}
+	}
+	return sent, rejected, nil
+}
+
+// importEntries sends entries with ImportJournal, which keeps their capture
+// time and skips entries already imported. A send that failed partway can
+// therefore be retried without creating duplicates.
+func importEntries(ctx context.Context, client pb.JournalServiceClient, entries []spool.Entry) error {
+	stream, err := client.ImportJournal(ctx)
+	if err != nil {
+		return err
+	}
+
+	for start := 0; start < len(entries); start += syncBatchSize {
+		req := &pb.ImportJournalRequest{}
+		for _, entry := range entries[start:min(start+syncBatchSize, len(entries))] {
+			req.Entries = append(req.Entries, &pb.ImportEntry{
+				Title:     entry.Title,
+				Content:   entry.Content,
+				CreatedAt: timestamppb.New(entry.CreatedAt),
+				Tags:      entry.Tags,
+			})
+		}
+		// io.EOF means the server ended the stream; CloseAndRecv returns why
+		if err := stream.Send(req); err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+	}
+
+	_, err = stream.CloseAndRecv()
+	return err
+}
+
+// transportCredentials returns TLS credentials if any TLS option is set,
+// and plaintext otherwise.
+func transportCredentials(opts *options) (credentials.TransportCredentials, error) {
+	if !opts.tls && opts.serverCA == "" && opts.clientCert == "" {
+		return insecure.NewCredentials(), nil
+	}
+
+	config := &tls.Config{MinVersion: tls.VersionTLS12}
+	if opts.serverCA != "" {
+		pem, err := os.ReadFile(opts.serverCA)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read server CA: %w", err)
+		}
+		config.RootCAs = x509.NewCertPool()
+		if !config.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in server CA %s", opts.serverCA)
+		}
+	}
+	if opts.clientCert != "" || opts.clientKey != "" {
+		cert, err := tls.LoadX509KeyPair(opts.clientCert, opts.clientKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	return credentials.NewTLS(config), nil
+}
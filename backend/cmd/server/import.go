@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/parkernilson/micro-journal/internal/config"
+	"github.com/parkernilson/micro-journal/internal/domain"
+	"github.com/parkernilson/micro-journal/internal/importer"
+	"github.com/parkernilson/micro-journal/internal/logging"
+	"github.com/parkernilson/micro-journal/internal/manager"
+)
+
+// importDayOne runs the import-dayone subcommand, which imports the
+// Journal.json file of a Day One JSON export directly into the database:
+//
+//	server import-dayone [-db-path path] [-auto-migrate] Journal.json
+func importDayOne(args []string) {
+	runImport("import-dayone", "Journal.json", args, nil, importer.ParseDayOne)
+}
+
+// importJrnl runs the import-jrnl subcommand, which imports a jrnl plain-text
+// journal directly into the database:
+//
+//	server import-jrnl [-db-path path] [-auto-migrate] [-time-zone zone] journal.txt
+func importJrnl(args []string) {
+	var timeZone string
+	flags := func(fs *flag.FlagSet) {
+		fs.StringVar(&timeZone, "time-zone", "Local", "IANA time zone the journal's timestamps were written in")
+	}
+
+	runImport("import-jrnl", "journal.txt", args, flags, func(r io.Reader) ([]*domain.JournalEntry, error) {
+		loc, err := time.LoadLocation(timeZone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time zone: %w", err)
+		}
+		return importer.ParseJrnl(r, loc)
+	})
+}
+
+// runImport parses the file named on the command line of an import
+// subcommand with parse and loads the entries into the database. flags, if
+// set, registers flags specific to the subcommand.
+func runImport(name, fileArg string, args []string, flags func(*flag.FlagSet), parse func(io.Reader) ([]*domain.JournalEntry, error)) {
+	cfg, err := config.Load(nil, os.Getenv)
+	if err != nil {
+		fatal("invalid configuration", "error", err)
+	}
+	slog.SetDefault(logging.NewLogger(os.Stderr, cfg.LogLevel, cfg.LogFormat))
+
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.StringVar(&cfg.DBDriver, "db-driver", cfg.DBDriver, "storage backend, sqlite, postgres, or markdown (MJ_DB_DRIVER)")
+	fs.StringVar(&cfg.DBPath, "db-path", cfg.DBPath, "path of the SQLite database file (MJ_DB_PATH)")
+	fs.StringVar(&cfg.DBDSN, "db-dsn", cfg.DBDSN, "PostgreSQL connection string (MJ_DB_DSN)")
+	fs.StringVar(&cfg.MarkdownDir, "markdown-dir", cfg.MarkdownDir, "directory of Markdown entry files (MJ_MARKDOWN_DIR)")
+	fs.BoolVar(&cfg.MarkdownGit, "markdown-git", cfg.MarkdownGit, "commit the imported entries to git (MJ_MARKDOWN_GIT)")
+	fs.BoolVar(&cfg.AutoMigrate, "auto-migrate", cfg.AutoMigrate, "apply pending migrations first (MJ_AUTO_MIGRATE)")
+	if flags != nil {
+		flags(fs)
+	}
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: %s %s [flags] %s\n", os.Args[0], name, fileArg)
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return
+		}
+		os.Exit(2)
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		os.Exit(2)
+	}
+	if cfg.DBDriver == config.DriverMemory {
+		fatal("imports need a persistent store, not the memory driver", "command", name)
+	}
+
+	file, err := os.Open(fs.Arg(0))
+	if err != nil {
+		fatal("failed to open export", "error", err)
+	}
+	defer file.Close()
+
+	entries, err := parse(file)
+	if err != nil {
+		fatal("failed to parse export", "error", err)
+	}
+
+	journalStore, db := openStore(cfg)
+	if db != nil {
+		defer db.Close()
+	}
+
+	result, err := importer.Load(context.Background(), manager.NewJournalManager(journalStore), entries)
+	if err != nil {
+		fatal("failed to import export", "error", err, "imported", result.Imported)
+	}
+
+	slog.Info("Imported entries", "imported", result.Imported, "skipped", result.Skipped)
+}
@@ -1,58 +1,253 @@
 package main
 
 import (
+	"context"
+	"crypto/tls"
 	"database/sql"
-	"log"
+	"errors"
+	"flag"
+	"log/slog"
 	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
 
+	// Embed the time zone database so date filters work in minimal containers
+	_ "time/tzdata"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/reflection"
 
 	_ "modernc.org/sqlite"
 
 	pb "github.com/parkernilson/micro-journal/gen/journal/v1"
+	"github.com/parkernilson/micro-journal/internal/config"
+	"github.com/parkernilson/micro-journal/internal/domain"
+	"github.com/parkernilson/micro-journal/internal/feed"
+	"github.com/parkernilson/micro-journal/internal/inbox"
+	"github.com/parkernilson/micro-journal/internal/loadshed"
+	"github.com/parkernilson/micro-journal/internal/logging"
+	"github.com/parkernilson/micro-journal/internal/maintenance"
 	"github.com/parkernilson/micro-journal/internal/manager"
+	"github.com/parkernilson/micro-journal/internal/recovery"
+	"github.com/parkernilson/micro-journal/internal/rest"
 	"github.com/parkernilson/micro-journal/internal/service"
 	"github.com/parkernilson/micro-journal/internal/store"
+	"github.com/parkernilson/micro-journal/internal/store/mdstore"
+	"github.com/parkernilson/micro-journal/internal/store/memstore"
+	"github.com/parkernilson/micro-journal/internal/store/postgres"
+	"github.com/parkernilson/micro-journal/internal/systemd"
+	"github.com/parkernilson/micro-journal/internal/tlsconfig"
+	"github.com/parkernilson/micro-journal/internal/tracing"
+	"github.com/parkernilson/micro-journal/migrations"
 )
 
 const (
-	port   = ":50051"
-	dbPath = "data/micro_journal.db"
+	// inboxInterval is how often the inbox directory is scanned, and
+	// inboxSettle is how long a file must be unmodified before ingestion
+	inboxInterval = 10 * time.Second
+	inboxSettle   = 2 * time.Second
+
+	// postgresMaxConns is the connection pool size for PostgreSQL
+	postgresMaxConns = 10
+
+	// postgresRetryBackoff is the first wait between attempts to reach
+	// PostgreSQL on startup, doubling up to postgresMaxRetryBackoff
+	postgresRetryBackoff    = 500 * time.Millisecond
+	postgresMaxRetryBackoff = 5 * time.Second
+
+	// shutdownTimeout is how long in-flight requests get to finish after a
+	// shutdown signal before connections are closed
+	shutdownTimeout = 10 * time.Second
 )
 
 func main() {
-	// Open database connection
-	db, err := sql.Open("sqlite", dbPath)
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "import-dayone":
+			importDayOne(os.Args[2:])
+			return
+		case "import-jrnl":
+			importJrnl(os.Args[2:])
+			return
+		}
+	}
+
+	// Load settings from the environment and command-line flags
+	cfg, err := config.Load(os.Args[1:], os.Getenv)
+	if errors.Is(err, flag.ErrHelp) {
+		return
+	}
 	if err != nil {
-		log.Fatalf("failed to open database: %v", err)
+		fatal("invalid configuration", "error", err)
 	}
-	defer db.Close()
+	slog.SetDefault(logging.NewLogger(os.Stderr, cfg.LogLevel, cfg.LogFormat))
 
-	// Configure connection pool (SQLite works best with limited connections)
-	db.SetMaxOpenConns(1)
-	db.SetMaxIdleConns(1)
+	run(cfg)
+}
 
-	// Verify database connection
-	if err := db.Ping(); err != nil {
-		log.Fatalf("failed to connect to database: %v", err)
+// fatal logs msg with args at error level and exits.
+func fatal(msg string, args ...any) {
+	slog.Error(msg, args...)
+	os.Exit(1)
+}
+
+// run starts the server described by cfg and blocks until it stops.
+func run(cfg *config.Config) {
+	// Stop on SIGINT or SIGTERM, letting in-flight requests finish
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Export traces if enabled. This comes first so the database driver's
+	// spans are exported too
+	tracingEnabled := cfg.OTLPEndpoint != ""
+	if tracingEnabled {
+		shutdown, err := tracing.Setup(context.Background(), cfg.OTLPEndpoint)
+		if err != nil {
+			fatal("failed to set up tracing", "error", err)
+		}
+		defer shutdown(context.Background())
+		slog.Info("Exporting traces", "endpoint", cfg.OTLPEndpoint)
 	}
 
-	log.Printf("Connected to database at %s", dbPath)
+	// Create layers: Store -> Manager -> Service. Stores without a connection
+	// pool give the load shedder empty pool stats
+	journalStore, db := openStore(cfg)
+	dbStats := func() sql.DBStats { return sql.DBStats{} }
+	if db != nil {
+		defer db.Close()
+		dbStats = db.Stats
 
-	// Create layers: Store -> Manager -> Service
-	journalStore := store.NewJournalStore(db)
-	journalManager := manager.NewJournalManager(journalStore)
+		// Run periodic SQLite maintenance (optimize, analyze, checkpoint,
+		// vacuum); PostgreSQL runs its own autovacuum
+		if cfg.DBDriver == config.DriverSQLite {
+			maintainer := maintenance.NewMaintainer(db, cfg.MaintenanceInterval, cfg.MaintenanceWindow)
+			go maintainer.Run(ctx)
+		}
+	}
+
+	// Record a span for every store and manager call when tracing
+	if tracingEnabled {
+		journalStore = tracing.NewJournalStore(journalStore)
+	}
+	var journalManager service.JournalManager = manager.NewJournalManager(journalStore)
+	if tracingEnabled {
+		journalManager = tracing.NewJournalManager(journalManager)
+	}
 	journalService := service.NewJournalService(journalManager)
 
-	// Create a TCP listener on port 50051
-	lis, err := net.Listen("tcp", port)
+	// Ingest files dropped into the inbox directory if enabled
+	if cfg.InboxDir != "" {
+		watcher := inbox.NewWatcher(journalManager, cfg.InboxDir, inboxInterval, inboxSettle)
+		slog.Info("Watching inbox directory", "dir", cfg.InboxDir)
+		go watcher.Run(ctx)
+	}
+
+	// HTTP servers started below, shut down together with the gRPC server
+	var httpServers []*http.Server
+
+	// Shed list and search requests first when the database is under
+	// pressure, on every API
+	shedder := loadshed.NewShedder(dbStats, loadshed.DefaultOptions())
+
+	// Load the TLS settings shared by every listener if a certificate is
+	// configured. Without one, the APIs and feed are only served in plaintext
+	// on addresses other machines cannot reach, unless plaintext was
+	// explicitly allowed
+	var tlsConfig *tls.Config
+	if cfg.TLSCert != "" {
+		var err error
+		tlsConfig, err = tlsconfig.Load(cfg.TLSCert, cfg.TLSKey, cfg.TLSClientCA)
+		if err != nil {
+			fatal("failed to load TLS settings", "error", err)
+		}
+		slog.Info("Serving APIs over TLS", "client_certificates", cfg.TLSClientCA != "")
+	}
+
+	// Serve the token-protected iCalendar feed if enabled
+	if cfg.FeedToken != "" {
+		mux := http.NewServeMux()
+		mux.Handle("/feed.ics", feed.NewICSHandler(journalManager, cfg.FeedToken, cfg.FeedLinkBase))
+
+		feedServer := &http.Server{Addr: cfg.FeedAddr, Handler: mux}
+		httpServers = append(httpServers, feedServer)
+		serveHTTP("iCalendar feed", feedServer, tlsConfig, cfg.Plaintext)
+	}
+
+	// Serve the REST/JSON API if enabled
+	if cfg.RESTAddr != "" {
+		restServer := &http.Server{Addr: cfg.RESTAddr, Handler: rest.NewHandler(journalService, slog.Default(), shedder)}
+		httpServers = append(httpServers, restServer)
+		serveHTTP("REST API", restServer, tlsConfig, cfg.Plaintext)
+	}
+
+	// Serve the Connect API if enabled. HTTP/2 is allowed without TLS too so
+	// gRPC clients can use it; Connect clients can use HTTP/1.1
+	if cfg.ConnectAddr != "" {
+		mux := http.NewServeMux()
+		mux.Handle(service.NewConnectHandler(journalService, slog.Default(), shedder))
+
+		connectServer := &http.Server{Addr: cfg.ConnectAddr, Handler: mux, Protocols: new(http.Protocols)}
+		connectServer.Protocols.SetHTTP1(true)
+		connectServer.Protocols.SetHTTP2(true)
+		connectServer.Protocols.SetUnencryptedHTTP2(true)
+		httpServers = append(httpServers, connectServer)
+		serveHTTP("Connect API", connectServer, tlsConfig, cfg.Plaintext)
+	}
+
+	// Use the socket passed by systemd socket activation if there is one,
+	// otherwise create a TCP listener on the configured address
+	listeners, err := systemd.Listeners()
 	if err != nil {
-		log.Fatalf("failed to listen: %v", err)
+		fatal("failed to inherit systemd sockets", "error", err)
+	}
+
+	var lis net.Listener
+	if len(listeners) > 0 {
+		lis = listeners[0]
+		for _, extra := range listeners[1:] {
+			slog.Warn("Ignoring extra systemd socket", "addr", extra.Addr().String())
+			extra.Close()
+		}
+		slog.Info("Using systemd socket", "addr", lis.Addr().String())
+	} else {
+		lis, err = net.Listen("tcp", cfg.ListenAddr)
+		if err != nil {
+			fatal("failed to listen", "error", err)
+		}
+	}
+
+	// Create a new gRPC server that logs every request, traces it if enabled,
+	// turns panics into Internal errors, and sheds requests under pressure
+	serverOpts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(
+			logging.UnaryServerInterceptor(slog.Default()),
+			recovery.UnaryServerInterceptor(slog.Default()),
+			shedder.UnaryServerInterceptor(),
+		),
+		grpc.ChainStreamInterceptor(
+			logging.StreamServerInterceptor(slog.Default()),
+			recovery.StreamServerInterceptor(slog.Default()),
+			shedder.StreamServerInterceptor(),
+		),
+	}
+	if tracingEnabled {
+		serverOpts = append(serverOpts, grpc.StatsHandler(otelgrpc.NewServerHandler()))
 	}
 
-	// Create a new gRPC server
-	grpcServer := grpc.NewServer()
+	// Serve TLS if a certificate is configured, otherwise follow the
+	// plaintext policy
+	if tlsConfig != nil {
+		serverOpts = append(serverOpts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	} else if err := tlsconfig.CheckPlaintext(lis.Addr(), cfg.Plaintext); err != nil {
+		fatal("failed to serve gRPC", "error", err)
+	}
+	grpcServer := grpc.NewServer(serverOpts...)
 
 	// Register the JournalService
 	pb.RegisterJournalServiceServer(grpcServer, journalService)
@@ -60,11 +255,226 @@ func main() {
 	// Register reflection service on gRPC server (useful for debugging with grpcurl)
 	reflection.Register(grpcServer)
 
-	log.Printf("Starting gRPC server on port %s", port)
-	log.Printf("Server is ready to accept connections")
+	slog.Info("Starting gRPC server", "addr", lis.Addr().String())
+	slog.Info("Server is ready to accept connections")
 
-	// Start serving
+	// Tell systemd the server is ready and keep its watchdog fed
+	if _, err := systemd.Notify("READY=1"); err != nil {
+		slog.Warn("Failed to notify systemd", "error", err)
+	}
+	if interval, ok := systemd.WatchdogInterval(); ok {
+		go func() {
+			ticker := time.NewTicker(interval / 2)
+			defer ticker.Stop()
+			for range ticker.C {
+				if _, err := systemd.Notify("WATCHDOG=1"); err != nil {
+					slog.Warn("Failed to notify systemd watchdog", "error", err)
+				}
+			}
+		}()
+	}
+
+	// Shut everything down gracefully once a signal arrives
+	go func() {
+		<-ctx.Done()
+		shutdown(grpcServer, httpServers)
+	}()
+
+	// Start serving. Serve returns once the gRPC server has stopped
 	if err := grpcServer.Serve(lis); err != nil {
-		log.Fatalf("failed to serve: %v", err)
+		fatal("failed to serve", "error", err)
+	}
+	slog.Info("Server stopped")
+}
+
+// shutdown tells systemd the server is stopping, then stops accepting new
+// requests and waits up to shutdownTimeout for in-flight ones to finish
+// before closing the remaining connections.
+func shutdown(grpcServer *grpc.Server, httpServers []*http.Server) {
+	slog.Info("Shutting down")
+	if _, err := systemd.Notify("STOPPING=1"); err != nil {
+		slog.Warn("Failed to notify systemd", "error", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	for _, server := range httpServers {
+		if err := server.Shutdown(ctx); err != nil {
+			slog.Warn("Failed to shut down HTTP server gracefully", "addr", server.Addr, "error", err)
+		}
+	}
+
+	stopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		slog.Warn("Timed out waiting for gRPC requests to finish")
+		grpcServer.Stop()
+	}
+}
+
+// serveHTTP listens on server's address and serves it in the background,
+// over TLS if tlsConfig is set. Without TLS, it refuses an address other
+// machines can reach unless plaintext is allowed. name identifies the API
+// in logs.
+func serveHTTP(name string, server *http.Server, tlsConfig *tls.Config, plaintext bool) {
+	lis, err := net.Listen("tcp", server.Addr)
+	if err != nil {
+		fatal("failed to listen", "api", name, "error", err)
+	}
+	if tlsConfig == nil {
+		if err := tlsconfig.CheckPlaintext(lis.Addr(), plaintext); err != nil {
+			fatal("failed to serve "+name, "error", err)
+		}
+	}
+
+	go func() {
+		slog.Info("Serving "+name, "addr", lis.Addr().String(), "tls", tlsConfig != nil)
+		if tlsConfig != nil {
+			server.TLSConfig = tlsConfig
+			err = server.ServeTLS(lis, "", "")
+		} else {
+			err = server.Serve(lis)
+		}
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			fatal("failed to serve "+name, "error", err)
+		}
+	}()
+}
+
+// openDatabase opens the database described by cfg and applies migrations
+// if enabled.
+func openDatabase(cfg *config.Config) *sql.DB {
+	var db *sql.DB
+	var apply func(context.Context, *sql.DB) ([]string, error)
+
+	switch cfg.DBDriver {
+	case config.DriverPostgres:
+		db, apply = openPostgres(cfg), migrations.ApplyPostgres
+	default:
+		db, apply = openSQLite(cfg), migrations.Apply
+	}
+
+	// Apply pending migrations on startup if enabled
+	if cfg.AutoMigrate {
+		applied, err := apply(context.Background(), db)
+		if err != nil {
+			fatal("failed to apply migrations", "error", err)
+		}
+		slog.Info("Applied migrations", "count", len(applied))
+	}
+
+	return db
+}
+
+// openSQLite opens the SQLite database file, creating its directory on first
+// run.
+func openSQLite(cfg *config.Config) *sql.DB {
+	// Create the data directory on first run
+	if err := os.MkdirAll(filepath.Dir(cfg.DBPath), 0o755); err != nil {
+		fatal("failed to create data directory", "error", err)
+	}
+
+	// Open database connection
+	db, err := store.Open(cfg.DBPath, cfg.BusyTimeout)
+	if err != nil {
+		fatal("failed to open database", "error", err)
+	}
+
+	// Configure connection pool (SQLite works best with limited connections)
+	db.SetMaxOpenConns(1)
+	db.SetMaxIdleConns(1)
+
+	// Verify database connection
+	if err := db.Ping(); err != nil {
+		fatal("failed to connect to database", "error", err)
+	}
+
+	slog.Info("Connected to database", "path", cfg.DBPath)
+	return db
+}
+
+// openPostgres opens a connection pool to the PostgreSQL database. The DSN
+// is not logged because it may contain a password.
+func openPostgres(cfg *config.Config) *sql.DB {
+	db, err := postgres.Open(cfg.DBDSN)
+	if err != nil {
+		fatal("failed to open database", "error", err)
+	}
+
+	db.SetMaxOpenConns(postgresMaxConns)
+	db.SetMaxIdleConns(postgresMaxConns)
+
+	if err := waitForDatabase(db, cfg.DBWait); err != nil {
+		fatal("failed to connect to database", "error", err, "waited", cfg.DBWait)
+	}
+
+	slog.Info("Connected to PostgreSQL database")
+	return db
+}
+
+// waitForDatabase pings db until it answers or timeout passes, backing off
+// between attempts, so the server can start alongside its database.
+func waitForDatabase(db *sql.DB, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	backoff := postgresRetryBackoff
+	for {
+		err := db.Ping()
+		if err == nil {
+			return nil
+		}
+		wait := min(backoff, time.Until(deadline))
+		if wait <= 0 {
+			return err
+		}
+		slog.Warn("Database is not reachable yet, retrying", "error", err, "retry_in", wait)
+		time.Sleep(wait)
+		backoff = min(2*backoff, postgresMaxRetryBackoff)
+	}
+}
+
+// openStore creates the store selected by cfg. db is the store's database,
+// or nil for stores that do not use one.
+func openStore(cfg *config.Config) (journalStore domain.JournalStore, db *sql.DB) {
+	switch cfg.DBDriver {
+	case config.DriverMemory:
+		slog.Info("Using the in-memory store; entries are lost when the server stops")
+		return memstore.NewJournalStore(), nil
+
+	case config.DriverMarkdown:
+		markdownStore, err := mdstore.Open(cfg.MarkdownDir, mdstore.Options{Git: cfg.MarkdownGit, GitRemote: cfg.GitRemote})
+		if err != nil {
+			fatal("failed to open Markdown store", "error", err)
+		}
+		slog.Info("Using Markdown files", "dir", cfg.MarkdownDir)
+		if cfg.MarkdownGit {
+			slog.Info("Committing every change to git", "remote", cfg.GitRemote)
+		}
+		return markdownStore, nil
+
+	case config.DriverPostgres:
+		db = openDatabase(cfg)
+		return postgres.NewJournalStore(db), db
+	}
+
+	db = openDatabase(cfg)
+	rebuilt, err := store.SetSearchTokenizer(context.Background(), db, cfg.SearchTokenizer)
+	if err != nil {
+		fatal("failed to set search tokenizer", "error", err)
+	}
+	if rebuilt {
+		slog.Info("Rebuilt search indexes", "tokenizer", cfg.SearchTokenizer)
+	}
+
+	var storeOpts []store.Option
+	if cfg.Archive {
+		storeOpts = append(storeOpts, store.WithArchive())
+		slog.Info("Entry archive enabled")
 	}
+	return store.NewJournalStore(db, storeOpts...), db
 }
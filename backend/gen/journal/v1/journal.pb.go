@@ -22,14 +22,88 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// ExportFormat is the format of a journal export
+type ExportFormat int32
+
+const (
+	// EXPORT_FORMAT_UNSPECIFIED exports JSON Lines
+	ExportFormat_EXPORT_FORMAT_UNSPECIFIED ExportFormat = 0
+	// EXPORT_FORMAT_JSON_LINES writes one JSON object per entry to journal.jsonl
+	ExportFormat_EXPORT_FORMAT_JSON_LINES ExportFormat = 1
+	// EXPORT_FORMAT_MARKDOWN writes one Markdown file with front matter per entry
+	ExportFormat_EXPORT_FORMAT_MARKDOWN ExportFormat = 2
+	// EXPORT_FORMAT_CSV writes one row per entry to journal.csv
+	ExportFormat_EXPORT_FORMAT_CSV ExportFormat = 3
+)
+
+// Enum value maps for ExportFormat.
+var (
+	ExportFormat_name = map[int32]string{
+		0: "EXPORT_FORMAT_UNSPECIFIED",
+		1: "EXPORT_FORMAT_JSON_LINES",
+		2: "EXPORT_FORMAT_MARKDOWN",
+		3: "EXPORT_FORMAT_CSV",
+	}
+	ExportFormat_value = map[string]int32{
+		"EXPORT_FORMAT_UNSPECIFIED": 0,
+		"EXPORT_FORMAT_JSON_LINES":  1,
+		"EXPORT_FORMAT_MARKDOWN":    2,
+		"EXPORT_FORMAT_CSV":         3,
+	}
+)
+
+func (x ExportFormat) Enum() *ExportFormat {
+	p := new(ExportFormat)
+	*p = x
+	return p
+}
+
+func (x ExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_journal_v1_journal_proto_enumTypes[0].Descriptor()
+}
+
+func (ExportFormat) Type() protoreflect.EnumType {
+	return &file_journal_v1_journal_proto_enumTypes[0]
+}
+
+func (x ExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportFormat.Descriptor instead.
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_journal_v1_journal_proto_rawDescGZIP(), []int{0}
+}
+
 // JournalEntry represents a single journal entry
 type JournalEntry struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title     string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Content   string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// reveal_at is when a sealed entry becomes readable (unset if never sealed)
+	RevealAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=reveal_at,json=revealAt,proto3" json:"reveal_at,omitempty"`
+	// sealed is true while content is withheld until reveal_at
+	Sealed bool `protobuf:"varint,7,opt,name=sealed,proto3" json:"sealed,omitempty"`
+	// document is the structured content of the entry (unset for plain text);
+	// content always holds a plain-text rendering of it
+	Document *EntryDocument `protobuf:"bytes,8,opt,name=document,proto3" json:"document,omitempty"`
+	// tags are the entry's lowercase tag names, sorted
+	Tags []string `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
+	// deleted_at is when the entry was moved to the trash (unset if it is not)
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	// commit_hash is the git commit that last changed the entry, set only when
+	// the journal is kept in git
+	CommitHash string `protobuf:"bytes,11,opt,name=commit_hash,json=commitHash,proto3" json:"commit_hash,omitempty"`
+	// version starts at 1 and is incremented each time the entry's content
+	// changes; pass it as expected_version when updating the entry
+	Version       int64 `protobuf:"varint,12,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -99,29 +173,83 @@ func (x *JournalEntry) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-// CreateJournalEntryRequest is the request to create a new journal entry
-type CreateJournalEntryRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+func (x *JournalEntry) GetRevealAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevealAt
+	}
+	return nil
+}
+
+func (x *JournalEntry) GetSealed() bool {
+	if x != nil {
+		return x.Sealed
+	}
+	return false
+}
+
+func (x *JournalEntry) GetDocument() *EntryDocument {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+func (x *JournalEntry) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *JournalEntry) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *JournalEntry) GetCommitHash() string {
+	if x != nil {
+		return x.CommitHash
+	}
+	return ""
+}
+
+func (x *JournalEntry) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// Revision is a previous version of a journal entry, saved when it was updated
+type Revision struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EntryId  string                 `protobuf:"bytes,2,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	Title    string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Content  string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	Document *EntryDocument         `protobuf:"bytes,5,opt,name=document,proto3" json:"document,omitempty"`
+	// created_at is when this version was written
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateJournalEntryRequest) Reset() {
-	*x = CreateJournalEntryRequest{}
+func (x *Revision) Reset() {
+	*x = Revision{}
 	mi := &file_journal_v1_journal_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateJournalEntryRequest) String() string {
+func (x *Revision) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateJournalEntryRequest) ProtoMessage() {}
+func (*Revision) ProtoMessage() {}
 
-func (x *CreateJournalEntryRequest) ProtoReflect() protoreflect.Message {
+func (x *Revision) ProtoReflect() protoreflect.Message {
 	mi := &file_journal_v1_journal_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -133,47 +261,77 @@ func (x *CreateJournalEntryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateJournalEntryRequest.ProtoReflect.Descriptor instead.
-func (*CreateJournalEntryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Revision.ProtoReflect.Descriptor instead.
+func (*Revision) Descriptor() ([]byte, []int) {
 	return file_journal_v1_journal_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *CreateJournalEntryRequest) GetTitle() string {
+func (x *Revision) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Revision) GetEntryId() string {
+	if x != nil {
+		return x.EntryId
+	}
+	return ""
+}
+
+func (x *Revision) GetTitle() string {
 	if x != nil {
 		return x.Title
 	}
 	return ""
 }
 
-func (x *CreateJournalEntryRequest) GetContent() string {
+func (x *Revision) GetContent() string {
 	if x != nil {
 		return x.Content
 	}
 	return ""
 }
 
-// CreateJournalEntryResponse is the response after creating a journal entry
-type CreateJournalEntryResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Entry         *JournalEntry          `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+func (x *Revision) GetDocument() *EntryDocument {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+func (x *Revision) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Tag is a label shared by one or more journal entries
+type Tag struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// entry_count is the number of entries with this tag
+	EntryCount    int64 `protobuf:"varint,2,opt,name=entry_count,json=entryCount,proto3" json:"entry_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateJournalEntryResponse) Reset() {
-	*x = CreateJournalEntryResponse{}
+func (x *Tag) Reset() {
+	*x = Tag{}
 	mi := &file_journal_v1_journal_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateJournalEntryResponse) String() string {
+func (x *Tag) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateJournalEntryResponse) ProtoMessage() {}
+func (*Tag) ProtoMessage() {}
 
-func (x *CreateJournalEntryResponse) ProtoReflect() protoreflect.Message {
+func (x *Tag) ProtoReflect() protoreflect.Message {
 	mi := &file_journal_v1_journal_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -185,42 +343,49 @@ func (x *CreateJournalEntryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateJournalEntryResponse.ProtoReflect.Descriptor instead.
-func (*CreateJournalEntryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Tag.ProtoReflect.Descriptor instead.
+func (*Tag) Descriptor() ([]byte, []int) {
 	return file_journal_v1_journal_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *CreateJournalEntryResponse) GetEntry() *JournalEntry {
+func (x *Tag) GetName() string {
 	if x != nil {
-		return x.Entry
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-// UpdateJournalEntryRequest is the request to update an existing journal entry
-type UpdateJournalEntryRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+func (x *Tag) GetEntryCount() int64 {
+	if x != nil {
+		return x.EntryCount
+	}
+	return 0
+}
+
+// EntryDocument is the versioned, structured content of an entry
+type EntryDocument struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// version is the document schema version (defaults to the current version)
+	Version       int32      `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Sections      []*Section `protobuf:"bytes,2,rep,name=sections,proto3" json:"sections,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateJournalEntryRequest) Reset() {
-	*x = UpdateJournalEntryRequest{}
+func (x *EntryDocument) Reset() {
+	*x = EntryDocument{}
 	mi := &file_journal_v1_journal_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateJournalEntryRequest) String() string {
+func (x *EntryDocument) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateJournalEntryRequest) ProtoMessage() {}
+func (*EntryDocument) ProtoMessage() {}
 
-func (x *UpdateJournalEntryRequest) ProtoReflect() protoreflect.Message {
+func (x *EntryDocument) ProtoReflect() protoreflect.Message {
 	mi := &file_journal_v1_journal_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -232,54 +397,54 @@ func (x *UpdateJournalEntryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateJournalEntryRequest.ProtoReflect.Descriptor instead.
-func (*UpdateJournalEntryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use EntryDocument.ProtoReflect.Descriptor instead.
+func (*EntryDocument) Descriptor() ([]byte, []int) {
 	return file_journal_v1_journal_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *UpdateJournalEntryRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *UpdateJournalEntryRequest) GetTitle() string {
+func (x *EntryDocument) GetVersion() int32 {
 	if x != nil {
-		return x.Title
+		return x.Version
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateJournalEntryRequest) GetContent() string {
+func (x *EntryDocument) GetSections() []*Section {
 	if x != nil {
-		return x.Content
+		return x.Sections
 	}
-	return ""
+	return nil
 }
 
-// UpdateJournalEntryResponse is the response after updating a journal entry
-type UpdateJournalEntryResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Entry         *JournalEntry          `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+// Section is a single typed block of an EntryDocument
+type Section struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Heading string                 `protobuf:"bytes,1,opt,name=heading,proto3" json:"heading,omitempty"`
+	// Types that are valid to be assigned to Body:
+	//
+	//	*Section_Text
+	//	*Section_Checklist
+	//	*Section_Rating
+	//	*Section_Photo
+	Body          isSection_Body `protobuf_oneof:"body"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateJournalEntryResponse) Reset() {
-	*x = UpdateJournalEntryResponse{}
+func (x *Section) Reset() {
+	*x = Section{}
 	mi := &file_journal_v1_journal_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateJournalEntryResponse) String() string {
+func (x *Section) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateJournalEntryResponse) ProtoMessage() {}
+func (*Section) ProtoMessage() {}
 
-func (x *UpdateJournalEntryResponse) ProtoReflect() protoreflect.Message {
+func (x *Section) ProtoReflect() protoreflect.Message {
 	mi := &file_journal_v1_journal_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -291,40 +456,111 @@ func (x *UpdateJournalEntryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateJournalEntryResponse.ProtoReflect.Descriptor instead.
-func (*UpdateJournalEntryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Section.ProtoReflect.Descriptor instead.
+func (*Section) Descriptor() ([]byte, []int) {
 	return file_journal_v1_journal_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *UpdateJournalEntryResponse) GetEntry() *JournalEntry {
+func (x *Section) GetHeading() string {
 	if x != nil {
-		return x.Entry
+		return x.Heading
+	}
+	return ""
+}
+
+func (x *Section) GetBody() isSection_Body {
+	if x != nil {
+		return x.Body
 	}
 	return nil
 }
 
-// DeleteJournalEntryRequest is the request to delete a journal entry
-type DeleteJournalEntryRequest struct {
+func (x *Section) GetText() *TextSection {
+	if x != nil {
+		if x, ok := x.Body.(*Section_Text); ok {
+			return x.Text
+		}
+	}
+	return nil
+}
+
+func (x *Section) GetChecklist() *ChecklistSection {
+	if x != nil {
+		if x, ok := x.Body.(*Section_Checklist); ok {
+			return x.Checklist
+		}
+	}
+	return nil
+}
+
+func (x *Section) GetRating() *RatingSection {
+	if x != nil {
+		if x, ok := x.Body.(*Section_Rating); ok {
+			return x.Rating
+		}
+	}
+	return nil
+}
+
+func (x *Section) GetPhoto() *PhotoSection {
+	if x != nil {
+		if x, ok := x.Body.(*Section_Photo); ok {
+			return x.Photo
+		}
+	}
+	return nil
+}
+
+type isSection_Body interface {
+	isSection_Body()
+}
+
+type Section_Text struct {
+	Text *TextSection `protobuf:"bytes,2,opt,name=text,proto3,oneof"`
+}
+
+type Section_Checklist struct {
+	Checklist *ChecklistSection `protobuf:"bytes,3,opt,name=checklist,proto3,oneof"`
+}
+
+type Section_Rating struct {
+	Rating *RatingSection `protobuf:"bytes,4,opt,name=rating,proto3,oneof"`
+}
+
+type Section_Photo struct {
+	Photo *PhotoSection `protobuf:"bytes,5,opt,name=photo,proto3,oneof"`
+}
+
+func (*Section_Text) isSection_Body() {}
+
+func (*Section_Checklist) isSection_Body() {}
+
+func (*Section_Rating) isSection_Body() {}
+
+func (*Section_Photo) isSection_Body() {}
+
+// TextSection is a block of free-form text
+type TextSection struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteJournalEntryRequest) Reset() {
-	*x = DeleteJournalEntryRequest{}
+func (x *TextSection) Reset() {
+	*x = TextSection{}
 	mi := &file_journal_v1_journal_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteJournalEntryRequest) String() string {
+func (x *TextSection) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteJournalEntryRequest) ProtoMessage() {}
+func (*TextSection) ProtoMessage() {}
 
-func (x *DeleteJournalEntryRequest) ProtoReflect() protoreflect.Message {
+func (x *TextSection) ProtoReflect() protoreflect.Message {
 	mi := &file_journal_v1_journal_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -336,40 +572,40 @@ func (x *DeleteJournalEntryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteJournalEntryRequest.ProtoReflect.Descriptor instead.
-func (*DeleteJournalEntryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TextSection.ProtoReflect.Descriptor instead.
+func (*TextSection) Descriptor() ([]byte, []int) {
 	return file_journal_v1_journal_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *DeleteJournalEntryRequest) GetId() string {
+func (x *TextSection) GetText() string {
 	if x != nil {
-		return x.Id
+		return x.Text
 	}
 	return ""
 }
 
-// DeleteJournalEntryResponse is the response after deleting a journal entry
-type DeleteJournalEntryResponse struct {
+// ChecklistSection is a list of items that can be checked off
+type ChecklistSection struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Items         []*ChecklistItem       `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteJournalEntryResponse) Reset() {
-	*x = DeleteJournalEntryResponse{}
+func (x *ChecklistSection) Reset() {
+	*x = ChecklistSection{}
 	mi := &file_journal_v1_journal_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteJournalEntryResponse) String() string {
+func (x *ChecklistSection) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteJournalEntryResponse) ProtoMessage() {}
+func (*ChecklistSection) ProtoMessage() {}
 
-func (x *DeleteJournalEntryResponse) ProtoReflect() protoreflect.Message {
+func (x *ChecklistSection) ProtoReflect() protoreflect.Message {
 	mi := &file_journal_v1_journal_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -381,41 +617,41 @@ func (x *DeleteJournalEntryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteJournalEntryResponse.ProtoReflect.Descriptor instead.
-func (*DeleteJournalEntryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChecklistSection.ProtoReflect.Descriptor instead.
+func (*ChecklistSection) Descriptor() ([]byte, []int) {
 	return file_journal_v1_journal_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *DeleteJournalEntryResponse) GetSuccess() bool {
+func (x *ChecklistSection) GetItems() []*ChecklistItem {
 	if x != nil {
-		return x.Success
+		return x.Items
 	}
-	return false
+	return nil
 }
 
-// ListJournalEntriesRequest is the request to get paginated journal entries
-type ListJournalEntriesRequest struct {
+// ChecklistItem is a single item of a checklist
+type ChecklistItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Checked       bool                   `protobuf:"varint,2,opt,name=checked,proto3" json:"checked,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListJournalEntriesRequest) Reset() {
-	*x = ListJournalEntriesRequest{}
+func (x *ChecklistItem) Reset() {
+	*x = ChecklistItem{}
 	mi := &file_journal_v1_journal_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListJournalEntriesRequest) String() string {
+func (x *ChecklistItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListJournalEntriesRequest) ProtoMessage() {}
+func (*ChecklistItem) ProtoMessage() {}
 
-func (x *ListJournalEntriesRequest) ProtoReflect() protoreflect.Message {
+func (x *ChecklistItem) ProtoReflect() protoreflect.Message {
 	mi := &file_journal_v1_journal_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -427,49 +663,48 @@ func (x *ListJournalEntriesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListJournalEntriesRequest.ProtoReflect.Descriptor instead.
-func (*ListJournalEntriesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChecklistItem.ProtoReflect.Descriptor instead.
+func (*ChecklistItem) Descriptor() ([]byte, []int) {
 	return file_journal_v1_journal_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ListJournalEntriesRequest) GetPageSize() int32 {
+func (x *ChecklistItem) GetText() string {
 	if x != nil {
-		return x.PageSize
+		return x.Text
 	}
-	return 0
+	return ""
 }
 
-func (x *ListJournalEntriesRequest) GetPageToken() string {
+func (x *ChecklistItem) GetChecked() bool {
 	if x != nil {
-		return x.PageToken
+		return x.Checked
 	}
-	return ""
+	return false
 }
 
-// ListJournalEntriesResponse is the response containing paginated journal entries
-type ListJournalEntriesResponse struct {
+// RatingSection is a score out of max (max defaults to 5)
+type RatingSection struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Entries       []*JournalEntry        `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Value         int32                  `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	Max           int32                  `protobuf:"varint,2,opt,name=max,proto3" json:"max,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListJournalEntriesResponse) Reset() {
-	*x = ListJournalEntriesResponse{}
+func (x *RatingSection) Reset() {
+	*x = RatingSection{}
 	mi := &file_journal_v1_journal_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListJournalEntriesResponse) String() string {
+func (x *RatingSection) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListJournalEntriesResponse) ProtoMessage() {}
+func (*RatingSection) ProtoMessage() {}
 
-func (x *ListJournalEntriesResponse) ProtoReflect() protoreflect.Message {
+func (x *RatingSection) ProtoReflect() protoreflect.Message {
 	mi := &file_journal_v1_journal_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -481,38 +716,2729 @@ func (x *ListJournalEntriesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListJournalEntriesResponse.ProtoReflect.Descriptor instead.
-func (*ListJournalEntriesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RatingSection.ProtoReflect.Descriptor instead.
+func (*RatingSection) Descriptor() ([]byte, []int) {
 	return file_journal_v1_journal_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *ListJournalEntriesResponse) GetEntries() []*JournalEntry {
+func (x *RatingSection) GetValue() int32 {
 	if x != nil {
-		return x.Entries
+		return x.Value
 	}
-	return nil
+	return 0
+}
+
+func (x *RatingSection) GetMax() int32 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+// PhotoSection is a reference to an image with an optional caption
+type PhotoSection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Caption       string                 `protobuf:"bytes,2,opt,name=caption,proto3" json:"caption,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PhotoSection) Reset() {
+	*x = PhotoSection{}
+	mi := &file_journal_v1_journal_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PhotoSection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PhotoSection) ProtoMessage() {}
+
+func (x *PhotoSection) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_v1_journal_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PhotoSection.ProtoReflect.Descriptor instead.
+func (*PhotoSection) Descriptor() ([]byte, []int) {
+	return file_journal_v1_journal_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PhotoSection) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *PhotoSection) GetCaption() string {
+	if x != nil {
+		return x.Caption
+	}
+	return ""
+}
+
+// CreateJournalEntryRequest is the request to create a new journal entry
+type CreateJournalEntryRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Title   string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Content string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// reveal_at optionally seals the entry until the given future time
+	RevealAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=reveal_at,json=revealAt,proto3" json:"reveal_at,omitempty"`
+	// document optionally makes the entry structured; content may be left
+	// empty to derive it from the document
+	Document *EntryDocument `protobuf:"bytes,4,opt,name=document,proto3" json:"document,omitempty"`
+	// tags are normalized to lowercase; a leading # is ignored
+	Tags          []string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateJournalEntryRequest) Reset() {
+	*x = CreateJournalEntryRequest{}
+	mi := &file_journal_v1_journal_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateJournalEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateJournalEntryRequest) ProtoMessage() {}
+
+func (x *CreateJournalEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_v1_journal_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateJournalEntryRequest.ProtoReflect.Descriptor instead.
+func (*CreateJournalEntryRequest) Descriptor() ([]byte, []int) {
+	return file_journal_v1_journal_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CreateJournalEntryRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateJournalEntryRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CreateJournalEntryRequest) GetRevealAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevealAt
+	}
+	return nil
+}
+
+func (x *CreateJournalEntryRequest) GetDocument() *EntryDocument {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+func (x *CreateJournalEntryRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// CreateJournalEntryResponse is the response after creating a journal entry
+type CreateJournalEntryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *JournalEntry          `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateJournalEntryResponse) Reset() {
+	*x = CreateJournalEntryResponse{}
+	mi := &file_journal_v1_journal_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateJournalEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateJournalEntryResponse) ProtoMessage() {}
+
+func (x *CreateJournalEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_v1_journal_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateJournalEntryResponse.ProtoReflect.Descriptor instead.
+func (*CreateJournalEntryResponse) Descriptor() ([]byte, []int) {
+	return file_journal_v1_journal_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CreateJournalEntryResponse) GetEntry() *JournalEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+// GetJournalEntryRequest is the request to get a single journal entry
+type GetJournalEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJournalEntryRequest) Reset() {
+	*x = GetJournalEntryRequest{}
+	mi := &file_journal_v1_journal_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJournalEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJournalEntryRequest) ProtoMessage() {}
+
+func (x *GetJournalEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_v1_journal_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJournalEntryRequest.ProtoReflect.Descriptor instead.
+func (*GetJournalEntryRequest) Descriptor() ([]byte, []int) {
+	return file_journal_v1_journal_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetJournalEntryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// GetJournalEntryResponse is the response containing a single journal entry
+type GetJournalEntryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *JournalEntry          `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJournalEntryResponse) Reset() {
+	*x = GetJournalEntryResponse{}
+	mi := &file_journal_v1_journal_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJournalEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJournalEntryResponse) ProtoMessage() {}
+
+func (x *GetJournalEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_v1_journal_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJournalEntryResponse.ProtoReflect.Descriptor instead.
+func (*GetJournalEntryResponse) Descriptor() ([]byte, []int) {
+	return file_journal_v1_journal_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetJournalEntryResponse) GetEntry() *JournalEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+// UpdateJournalEntryRequest is the request to update an existing journal entry
+type UpdateJournalEntryRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title   string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Content string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	// document replaces the entry's structured content (unset for plain text)
+	Document *EntryDocument `protobuf:"bytes,4,opt,name=document,proto3" json:"document,omitempty"`
+	// tags replace the entry's tags
+	Tags []string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	// expected_version, if set, is the version the client last read; the update
+	// fails with ABORTED if the entry has changed since
+	ExpectedVersion int64 `protobuf:"varint,6,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateJournalEntryRequest) Reset() {
+	*x = UpdateJournalEntryRequest{}
+	mi := &file_journal_v1_journal_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateJournalEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateJournalEntryRequest) ProtoMessage() {}
+
+func (x *UpdateJournalEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_v1_journal_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateJournalEntryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateJournalEntryRequest) Descriptor() ([]byte, []int) {
+	return file_journal_v1_journal_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateJournalEntryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateJournalEntryRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateJournalEntryRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *UpdateJournalEntryRequest) GetDocument() *EntryDocument {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+func (x *UpdateJournalEntryRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdateJournalEntryRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+// UpdateJournalEntryResponse is the response after updating a journal entry
+type UpdateJournalEntryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *JournalEntry          `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateJournalEntryResponse) Reset() {
+	*x = UpdateJournalEntryResponse{}
+	mi := &file_journal_v1_journal_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateJournalEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateJournalEntryResponse) ProtoMessage() {}
+
+func (x *UpdateJournalEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_v1_journal_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateJournalEntryResponse.ProtoReflect.Descriptor instead.
+func (*UpdateJournalEntryResponse) Descriptor() ([]byte, []int) {
+	return file_journal_v1_journal_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UpdateJournalEntryResponse) GetEntry() *JournalEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+// DeleteJournalEntryRequest is the request to delete a journal entry
+type DeleteJournalEntryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// permanent skips the trash and removes the entry for good
+	Permanent     bool `protobuf:"varint,2,opt,name=permanent,proto3" json:"permanent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteJournalEntryRequest) Reset() {
+	*x = DeleteJournalEntryRequest{}
+	mi := &file_journal_v1_journal_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteJournalEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteJournalEntryRequest) ProtoMessage() {}
+
+func (x *DeleteJournalEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_v1_journal_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteJournalEntryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteJournalEntryRequest) Descriptor() ([]byte, []int) {
+	return file_journal_v1_journal_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DeleteJournalEntryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteJournalEntryRequest) GetPermanent() bool {
+	if x != nil {
+		return x.Permanent
+	}
+	return false
+}
+
+// DeleteJournalEntryResponse is the response after deleting a journal entry
+type DeleteJournalEntryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteJournalEntryResponse) Reset() {
+	*x = DeleteJournalEntryResponse{}
+	mi := &file_journal_v1_journal_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteJournalEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteJournalEntryResponse) ProtoMessage() {}
+
+func (x *DeleteJournalEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_v1_journal_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteJournalEntryResponse.ProtoReflect.Descriptor instead.
+func (*DeleteJournalEntryResponse) Descriptor() ([]byte, []int) {
+	return file_journal_v1_journal_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DeleteJournalEntryResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// BatchResult is the outcome of one item of a batch request
+type BatchResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// entry is the created or retrieved entry (unset for deletes and failures)
+	Entry *JournalEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	// code is the gRPC status code of the item; 0 (OK) if it succeeded
+	Code int32 `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	// message explains why the item failed
+	Message       string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchResult) Reset() {
+	*x = BatchResult{}
+	mi := &file_journal_v1_journal_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchResult) ProtoMessage() {}
+
+func (x *BatchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_v1_journal_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchResult.ProtoReflect.Descriptor instead.
+func (*BatchResult) Descriptor() ([]byte, []int) {
+	return file_journal_v1_journal_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *BatchResult) GetEntry() *JournalEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *BatchResult) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *BatchResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// BatchCreateJournalEntriesRequest is the request to create several journal
+// entries at once
+type BatchCreateJournalEntriesRequest struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Requests      []*CreateJournalEntryRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchCreateJournalEntriesRequest) Reset() {
+	*x = BatchCreateJournalEntriesRequest{}
+	mi := &file_journal_v1_journal_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCreateJournalEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateJournalEntriesRequest) ProtoMessage() {}
+
+func (x *BatchCreateJournalEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_v1_journal_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateJournalEntriesRequest.ProtoReflect.Descriptor instead.
+func (*BatchCreateJournalEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_journal_v1_journal_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *BatchCreateJournalEntriesRequest) GetRequests() []*CreateJournalEntryRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+// BatchCreateJournalEntriesResponse holds one result per request, in order
+type BatchCreateJournalEntriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*BatchResult         `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchCreateJournalEntriesResponse) Reset() {
+	*x = BatchCreateJournalEntriesResponse{}
+	mi := &file_journal_v1_journal_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCreateJournalEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateJournalEntriesResponse) ProtoMessage() {}
+
+func (x *BatchCreateJournalEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_v1_journal_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateJournalEntriesResponse.ProtoReflect.Descriptor instead.
+func (*BatchCreateJournalEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_journal_v1_journal_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *BatchCreateJournalEntriesResponse) GetResults() []*BatchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// BatchGetJournalEntriesRequest is the request to get several journal entries
+// by ID
+type BatchGetJournalEntriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetJournalEntriesRequest) Reset() {
+	*x = BatchGetJournalEntriesRequest{}
+	mi := &file_journal_v1_journal_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetJournalEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetJournalEntriesRequest) ProtoMessage() {}
+
+func (x *BatchGetJournalEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_v1_journal_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetJournalEntriesRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetJournalEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_journal_v1_journal_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *BatchGetJournalEntriesRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+// BatchGetJournalEntriesResponse holds one result per ID, in order
+type BatchGetJournalEntriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*BatchResult         `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetJournalEntriesResponse) Reset() {
+	*x = BatchGetJournalEntriesResponse{}
+	mi := &file_journal_v1_journal_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetJournalEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetJournalEntriesResponse) ProtoMessage() {}
+
+func (x *BatchGetJournalEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_v1_journal_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetJournalEntriesResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetJournalEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_journal_v1_journal_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *BatchGetJournalEntriesResponse) GetResults() []*BatchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// BatchDeleteJournalEntriesRequest is the request to delete several journal
+// entries by ID
+type BatchDeleteJournalEntriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Ids   []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	// permanent skips the trash and removes the entries for good
+	Permanent     bool `protobuf:"varint,2,opt,name=permanent,proto3" json:"permanent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchDeleteJournalEntriesRequest) Reset() {
+	*x = BatchDeleteJournalEntriesRequest{}
+	mi := &file_journal_v1_journal_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDeleteJournalEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteJournalEntriesRequest) ProtoMessage() {}
+
+func (x *BatchDeleteJournalEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_v1_journal_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteJournalEntriesRequest.ProtoReflect.Descriptor instead.
+func (*BatchDeleteJournalEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_journal_v1_journal_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *BatchDeleteJournalEntriesRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *BatchDeleteJournalEntriesRequest) GetPermanent() bool {
+	if x != nil {
+		return x.Permanent
+	}
+	return false
+}
+
+// BatchDeleteJournalEntriesResponse holds one result per ID, in order
+type BatchDeleteJournalEntriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*BatchResult         `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchDeleteJournalEntriesResponse) Reset() {
+	*x = BatchDeleteJournalEntriesResponse{}
+	mi := &file_journal_v1_journal_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDeleteJournalEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteJournalEntriesResponse) ProtoMessage() {}
+
+func (x *BatchDeleteJournalEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_v1_journal_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteJournalEntriesResponse.ProtoReflect.Descriptor instead.
+func (*BatchDeleteJournalEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_journal_v1_journal_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *BatchDeleteJournalEntriesResponse) GetResults() []*BatchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// ListJournalEntriesRequest is the request to get paginated journal entries
+type ListJournalEntriesRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	PageSize  int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// date_filter restricts results to a human date phrase such as "last week",
+	// "two summers ago", or "March 2023"
+	DateFilter string `protobuf:"bytes,3,opt,name=date_filter,json=dateFilter,proto3" json:"date_filter,omitempty"`
+	// time_zone is the IANA time zone date_filter is interpreted in (UTC if empty)
+	TimeZone string `protobuf:"bytes,4,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	// tag restricts results to entries with this tag
+	Tag           string `protobuf:"bytes,5,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJournalEntriesRequest) Reset() {
+	*x = ListJournalEntriesRequest{}
+	mi := &file_journal_v1_journal_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJournalEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJournalEntriesRequest) ProtoMessage() {}
+
+func (x *ListJournalEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_v1_journal_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJournalEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ListJournalEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_journal_v1_journal_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListJournalEntriesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListJournalEntriesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListJournalEntriesRequest) GetDateFilter() string {
+	if x != nil {
+		return x.DateFilter
+	}
+	return ""
+}
+
+func (x *ListJournalEntriesRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *ListJournalEntriesRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+// ListJournalEntriesResponse is the response containing paginated journal entries
+type ListJournalEntriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*JournalEntry        `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJournalEntriesResponse) Reset() {
+	*x = ListJournalEntriesResponse{}
+	mi := &file_journal_v1_journal_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJournalEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJournalEntriesResponse) ProtoMessage() {}
+
+func (x *ListJournalEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_v1_journal_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJournalEntriesResponse.ProtoReflect.Descriptor instead.
+func (*ListJournalEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_journal_v1_journal_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListJournalEntriesResponse) GetEntries() []*JournalEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ListJournalEntriesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListJournalEntriesResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// SearchJournalEntriesRequest is the request to search journal entries
+type SearchJournalEntriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// query is free text; every word must match, and a trailing * on a word
+	// matches it as a prefix
+	Query         string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	PageSize      int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchJournalEntriesRequest) Reset() {
+	*x = SearchJournalEntriesRequest{}
+	mi := &file_journal_v1_journal_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchJournalEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchJournalEntriesRequest) ProtoMessage() {}
+
+func (x *SearchJournalEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_v1_journal_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchJournalEntriesRequest.ProtoReflect.Descriptor instead.
+func (*SearchJournalEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_journal_v1_journal_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SearchJournalEntriesRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchJournalEntriesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchJournalEntriesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// SearchJournalEntriesResponse is the response containing matching journal
+// entries, best match first
+type SearchJournalEntriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*JournalEntry        `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchJournalEntriesResponse) Reset() {
+	*x = SearchJournalEntriesResponse{}
+	mi := &file_journal_v1_journal_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchJournalEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchJournalEntriesResponse) ProtoMessage() {}
+
+func (x *SearchJournalEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_v1_journal_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchJournalEntriesResponse.ProtoReflect.Descriptor instead.
+func (*SearchJournalEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_journal_v1_journal_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SearchJournalEntriesResponse) GetEntries() []*JournalEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *SearchJournalEntriesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *SearchJournalEntriesResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// ListTagsRequest is the request to list every tag
+type ListTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsRequest) Reset() {
+	*x = ListTagsRequest{}
+	mi := &file_journal_v1_journal_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsRequest) ProtoMessage() {}
+
+func (x *ListTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_v1_journal_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsRequest.ProtoReflect.Descriptor instead.
+func (*ListTagsRequest) Descriptor() ([]byte, []int) {
+	return file_journal_v1_journal_proto_rawDescGZIP(), []int{29}
+}
+
+// ListTagsResponse is the response containing every tag, sorted by name
+type ListTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []*Tag                 `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsResponse) Reset() {
+	*x = ListTagsResponse{}
+	mi := &file_journal_v1_journal_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsResponse) ProtoMessage() {}
+
+func (x *ListTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_v1_journal_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsResponse.ProtoReflect.Descriptor instead.
+func (*ListTagsResponse) Descriptor() ([]byte, []int) {
+	return file_journal_v1_journal_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListTagsResponse) GetTags() []*Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// RenameTagRequest is the request to rename a tag on every entry
+type RenameTagRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// new_name may name an existing tag, in which case the two are merged
+	NewName       string `protobuf:"bytes,2,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameTagRequest) Reset() {
+	*x = RenameTagRequest{}
+	mi := &file_journal_v1_journal_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameTagRequest) ProtoMessage() {}
+
+func (x *RenameTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_v1_journal_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameTagRequest.ProtoReflect.Descriptor instead.
+func (*RenameTagRequest) Descriptor() ([]byte, []int) {
+	return file_journal_v1_journal_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RenameTagRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RenameTagRequest) GetNewName() string {
+	if x != nil {
+		return x.NewName
+	}
+	return ""
+}
+
+// RenameTagResponse is the response containing the renamed tag
+type RenameTagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           *Tag                   `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameTagResponse) Reset() {
+	*x = RenameTagResponse{}
+	mi := &file_journal_v1_journal_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameTagResponse) ProtoMessage() {}
+
+func (x *RenameTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_v1_journal_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameTagResponse.ProtoReflect.Descriptor instead.
+func (*RenameTagResponse) Descriptor() ([]byte, []int) {
+	return file_journal_v1_journal_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RenameTagResponse) GetTag() *Tag {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
+// DeleteTagRequest is the request to remove a tag from every entry
+type DeleteTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTagRequest) Reset() {
+	*x = DeleteTagRequest{}
+	mi := &file_journal_v1_journal_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTagRequest) ProtoMessage() {}
+
+func (x *DeleteTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_v1_journal_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTagRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTagRequest) Descriptor() ([]byte, []int) {
+	return file_journal_v1_journal_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DeleteTagRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// DeleteTagResponse is the response after deleting a tag
+type DeleteTagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTagResponse) Reset() {
+	*x = DeleteTagResponse{}
+	mi := &file_journal_v1_journal_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTagResponse) ProtoMessage() {}
+
+func (x *DeleteTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_v1_journal_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTagResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTagResponse) Descriptor() ([]byte, []int) {
+	return file_journal_v1_journal_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DeleteTagResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// ListTrashedEntriesRequest is the request to get paginated entries in the trash
+type ListTrashedEntriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrashedEntriesRequest) Reset() {
+	*x = ListTrashedEntriesRequest{}
+	mi := &file_journal_v1_journal_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrashedEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashedEntriesRequest) ProtoMessage() {}
+
+func (x *ListTrashedEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_v1_journal_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashedEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ListTrashedEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_journal_v1_journal_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListTrashedEntriesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTrashedEntriesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// ListTrashedEntriesResponse is the response containing paginated entries in
+// the trash, most recently deleted first
+type ListTrashedEntriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*JournalEntry        `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrashedEntriesResponse) Reset() {
+	*x = ListTrashedEntriesResponse{}
+	mi := &file_journal_v1_journal_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrashedEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashedEntriesResponse) ProtoMessage() {}
+
+func (x *ListTrashedEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_v1_journal_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashedEntriesResponse.ProtoReflect.Descriptor instead.
+func (*ListTrashedEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_journal_v1_journal_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListTrashedEntriesResponse) GetEntries() []*JournalEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ListTrashedEntriesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListTrashedEntriesResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// RestoreJournalEntryRequest is the request to move an entry out of the trash
+type RestoreJournalEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreJournalEntryRequest) Reset() {
+	*x = RestoreJournalEntryRequest{}
+	mi := &file_journal_v1_journal_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreJournalEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreJournalEntryRequest) ProtoMessage() {}
+
+func (x *RestoreJournalEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_v1_journal_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreJournalEntryRequest.ProtoReflect.Descriptor instead.
+func (*RestoreJournalEntryRequest) Descriptor() ([]byte, []int) {
+	return file_journal_v1_journal_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RestoreJournalEntryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// RestoreJournalEntryResponse is the response containing the restored entry
+type RestoreJournalEntryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *JournalEntry          `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreJournalEntryResponse) Reset() {
+	*x = RestoreJournalEntryResponse{}
+	mi := &file_journal_v1_journal_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreJournalEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreJournalEntryResponse) ProtoMessage() {}
+
+func (x *RestoreJournalEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_v1_journal_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreJournalEntryResponse.ProtoReflect.Descriptor instead.
+func (*RestoreJournalEntryResponse) Descriptor() ([]byte, []int) {
+	return file_journal_v1_journal_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RestoreJournalEntryResponse) GetEntry() *JournalEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+// PurgeTrashRequest is the request to permanently remove entries in the trash
+type PurgeTrashRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// deleted_before limits the purge to entries trashed before this time
+	// (the whole trash if unset)
+	DeletedBefore *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=deleted_before,json=deletedBefore,proto3" json:"deleted_before,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeTrashRequest) Reset() {
+	*x = PurgeTrashRequest{}
+	mi := &file_journal_v1_journal_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeTrashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeTrashRequest) ProtoMessage() {}
+
+func (x *PurgeTrashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_v1_journal_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeTrashRequest.ProtoReflect.Descriptor instead.
+func (*PurgeTrashRequest) Descriptor() ([]byte, []int) {
+	return file_journal_v1_journal_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *PurgeTrashRequest) GetDeletedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedBefore
+	}
+	return nil
+}
+
+// PurgeTrashResponse is the response after purging the trash
+type PurgeTrashResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PurgedCount   int64                  `protobuf:"varint,1,opt,name=purged_count,json=purgedCount,proto3" json:"purged_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeTrashResponse) Reset() {
+	*x = PurgeTrashResponse{}
+	mi := &file_journal_v1_journal_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeTrashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeTrashResponse) ProtoMessage() {}
+
+func (x *PurgeTrashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_v1_journal_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeTrashResponse.ProtoReflect.Descriptor instead.
+func (*PurgeTrashResponse) Descriptor() ([]byte, []int) {
+	return file_journal_v1_journal_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *PurgeTrashResponse) GetPurgedCount() int64 {
+	if x != nil {
+		return x.PurgedCount
+	}
+	return 0
+}
+
+// ListJournalEntryRevisionsRequest is the request to get an entry's previous versions
+type ListJournalEntryRevisionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryId       string                 `protobuf:"bytes,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJournalEntryRevisionsRequest) Reset() {
+	*x = ListJournalEntryRevisionsRequest{}
+	mi := &file_journal_v1_journal_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJournalEntryRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJournalEntryRevisionsRequest) ProtoMessage() {}
+
+func (x *ListJournalEntryRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_v1_journal_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJournalEntryRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListJournalEntryRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_journal_v1_journal_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListJournalEntryRevisionsRequest) GetEntryId() string {
+	if x != nil {
+		return x.EntryId
+	}
+	return ""
+}
+
+func (x *ListJournalEntryRevisionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListJournalEntryRevisionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// ListJournalEntryRevisionsResponse is the response containing paginated
+// revisions, newest first
+type ListJournalEntryRevisionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revisions     []*Revision            `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJournalEntryRevisionsResponse) Reset() {
+	*x = ListJournalEntryRevisionsResponse{}
+	mi := &file_journal_v1_journal_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJournalEntryRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJournalEntryRevisionsResponse) ProtoMessage() {}
+
+func (x *ListJournalEntryRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_v1_journal_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJournalEntryRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListJournalEntryRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_journal_v1_journal_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListJournalEntryRevisionsResponse) GetRevisions() []*Revision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+func (x *ListJournalEntryRevisionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListJournalEntryRevisionsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// SearchJournalEntryRevisionsRequest is the request to search an entry's
+// previous versions
+type SearchJournalEntryRevisionsRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	EntryId string                 `protobuf:"bytes,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	// query has the same syntax as SearchJournalEntriesRequest.query
+	Query         string `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	PageSize      int32  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchJournalEntryRevisionsRequest) Reset() {
+	*x = SearchJournalEntryRevisionsRequest{}
+	mi := &file_journal_v1_journal_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchJournalEntryRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchJournalEntryRevisionsRequest) ProtoMessage() {}
+
+func (x *SearchJournalEntryRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_v1_journal_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchJournalEntryRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*SearchJournalEntryRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_journal_v1_journal_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SearchJournalEntryRevisionsRequest) GetEntryId() string {
+	if x != nil {
+		return x.EntryId
+	}
+	return ""
+}
+
+func (x *SearchJournalEntryRevisionsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchJournalEntryRevisionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchJournalEntryRevisionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// SearchJournalEntryRevisionsResponse is the response containing paginated
+// matching revisions, best match first
+type SearchJournalEntryRevisionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revisions     []*Revision            `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchJournalEntryRevisionsResponse) Reset() {
+	*x = SearchJournalEntryRevisionsResponse{}
+	mi := &file_journal_v1_journal_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchJournalEntryRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchJournalEntryRevisionsResponse) ProtoMessage() {}
+
+func (x *SearchJournalEntryRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_v1_journal_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchJournalEntryRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*SearchJournalEntryRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_journal_v1_journal_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SearchJournalEntryRevisionsResponse) GetRevisions() []*Revision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+func (x *SearchJournalEntryRevisionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *SearchJournalEntryRevisionsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// RestoreJournalEntryRevisionRequest is the request to roll an entry back to a revision
+type RestoreJournalEntryRevisionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryId       string                 `protobuf:"bytes,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	RevisionId    string                 `protobuf:"bytes,2,opt,name=revision_id,json=revisionId,proto3" json:"revision_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreJournalEntryRevisionRequest) Reset() {
+	*x = RestoreJournalEntryRevisionRequest{}
+	mi := &file_journal_v1_journal_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreJournalEntryRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreJournalEntryRevisionRequest) ProtoMessage() {}
+
+func (x *RestoreJournalEntryRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_v1_journal_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreJournalEntryRevisionRequest.ProtoReflect.Descriptor instead.
+func (*RestoreJournalEntryRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_journal_v1_journal_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *RestoreJournalEntryRevisionRequest) GetEntryId() string {
+	if x != nil {
+		return x.EntryId
+	}
+	return ""
+}
+
+func (x *RestoreJournalEntryRevisionRequest) GetRevisionId() string {
+	if x != nil {
+		return x.RevisionId
+	}
+	return ""
+}
+
+// RestoreJournalEntryRevisionResponse is the response containing the restored entry
+type RestoreJournalEntryRevisionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *JournalEntry          `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreJournalEntryRevisionResponse) Reset() {
+	*x = RestoreJournalEntryRevisionResponse{}
+	mi := &file_journal_v1_journal_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreJournalEntryRevisionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreJournalEntryRevisionResponse) ProtoMessage() {}
+
+func (x *RestoreJournalEntryRevisionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_v1_journal_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreJournalEntryRevisionResponse.ProtoReflect.Descriptor instead.
+func (*RestoreJournalEntryRevisionResponse) Descriptor() ([]byte, []int) {
+	return file_journal_v1_journal_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *RestoreJournalEntryRevisionResponse) GetEntry() *JournalEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+// ExportJournalRequest is the request to export every entry
+type ExportJournalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        ExportFormat           `protobuf:"varint,1,opt,name=format,proto3,enum=journal.v1.ExportFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportJournalRequest) Reset() {
+	*x = ExportJournalRequest{}
+	mi := &file_journal_v1_journal_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportJournalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportJournalRequest) ProtoMessage() {}
+
+func (x *ExportJournalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_v1_journal_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportJournalRequest.ProtoReflect.Descriptor instead.
+func (*ExportJournalRequest) Descriptor() ([]byte, []int) {
+	return file_journal_v1_journal_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ExportJournalRequest) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_EXPORT_FORMAT_UNSPECIFIED
+}
+
+// ExportJournalResponse is a piece of an export; data is appended to the
+// file named filename
+type ExportJournalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportJournalResponse) Reset() {
+	*x = ExportJournalResponse{}
+	mi := &file_journal_v1_journal_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportJournalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportJournalResponse) ProtoMessage() {}
+
+func (x *ExportJournalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_v1_journal_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportJournalResponse.ProtoReflect.Descriptor instead.
+func (*ExportJournalResponse) Descriptor() ([]byte, []int) {
+	return file_journal_v1_journal_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ExportJournalResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ExportJournalResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// ImportEntry is an entry from another journal
+type ImportEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// title is suggested from the content if empty
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// content may be left empty if document is set
+	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// created_at is when the entry was originally written
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	Document      *EntryDocument         `protobuf:"bytes,5,opt,name=document,proto3" json:"document,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportEntry) Reset() {
+	*x = ImportEntry{}
+	mi := &file_journal_v1_journal_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportEntry) ProtoMessage() {}
+
+func (x *ImportEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_v1_journal_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportEntry.ProtoReflect.Descriptor instead.
+func (*ImportEntry) Descriptor() ([]byte, []int) {
+	return file_journal_v1_journal_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ImportEntry) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ImportEntry) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ImportEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ImportEntry) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ImportEntry) GetDocument() *EntryDocument {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+// ImportJournalRequest is one batch of entries to import; each batch is
+// inserted in its own transaction
+type ImportJournalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*ImportEntry         `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportJournalRequest) Reset() {
+	*x = ImportJournalRequest{}
+	mi := &file_journal_v1_journal_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportJournalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportJournalRequest) ProtoMessage() {}
+
+func (x *ImportJournalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_v1_journal_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportJournalRequest.ProtoReflect.Descriptor instead.
+func (*ImportJournalRequest) Descriptor() ([]byte, []int) {
+	return file_journal_v1_journal_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ImportJournalRequest) GetEntries() []*ImportEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// ImportJournalResponse is the result of an import
+type ImportJournalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ImportedCount int64                  `protobuf:"varint,1,opt,name=imported_count,json=importedCount,proto3" json:"imported_count,omitempty"`
+	// skipped_count is the number of entries skipped as already imported
+	SkippedCount  int64 `protobuf:"varint,2,opt,name=skipped_count,json=skippedCount,proto3" json:"skipped_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportJournalResponse) Reset() {
+	*x = ImportJournalResponse{}
+	mi := &file_journal_v1_journal_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportJournalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportJournalResponse) ProtoMessage() {}
+
+func (x *ImportJournalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_v1_journal_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportJournalResponse.ProtoReflect.Descriptor instead.
+func (*ImportJournalResponse) Descriptor() ([]byte, []int) {
+	return file_journal_v1_journal_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ImportJournalResponse) GetImportedCount() int64 {
+	if x != nil {
+		return x.ImportedCount
+	}
+	return 0
+}
+
+func (x *ImportJournalResponse) GetSkippedCount() int64 {
+	if x != nil {
+		return x.SkippedCount
+	}
+	return 0
+}
+
+// ImportDayOneRequest is a chunk of the Journal.json file from a Day One
+// JSON export; the chunks are joined in order
+type ImportDayOneRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportDayOneRequest) Reset() {
+	*x = ImportDayOneRequest{}
+	mi := &file_journal_v1_journal_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportDayOneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportDayOneRequest) ProtoMessage() {}
+
+func (x *ImportDayOneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_v1_journal_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *ListJournalEntriesResponse) GetNextPageToken() string {
+// Deprecated: Use ImportDayOneRequest.ProtoReflect.Descriptor instead.
+func (*ImportDayOneRequest) Descriptor() ([]byte, []int) {
+	return file_journal_v1_journal_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ImportDayOneRequest) GetData() []byte {
 	if x != nil {
-		return x.NextPageToken
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-func (x *ListJournalEntriesResponse) GetTotalCount() int32 {
+// ImportDayOneResponse is the result of a Day One import
+type ImportDayOneResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ImportedCount int64                  `protobuf:"varint,1,opt,name=imported_count,json=importedCount,proto3" json:"imported_count,omitempty"`
+	// skipped_count is the number of entries skipped as already imported
+	SkippedCount  int64 `protobuf:"varint,2,opt,name=skipped_count,json=skippedCount,proto3" json:"skipped_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportDayOneResponse) Reset() {
+	*x = ImportDayOneResponse{}
+	mi := &file_journal_v1_journal_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportDayOneResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportDayOneResponse) ProtoMessage() {}
+
+func (x *ImportDayOneResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_v1_journal_proto_msgTypes[53]
 	if x != nil {
-		return x.TotalCount
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportDayOneResponse.ProtoReflect.Descriptor instead.
+func (*ImportDayOneResponse) Descriptor() ([]byte, []int) {
+	return file_journal_v1_journal_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ImportDayOneResponse) GetImportedCount() int64 {
+	if x != nil {
+		return x.ImportedCount
+	}
+	return 0
+}
+
+func (x *ImportDayOneResponse) GetSkippedCount() int64 {
+	if x != nil {
+		return x.SkippedCount
+	}
+	return 0
+}
+
+// VerifyArchiveRequest is the request to verify the entry archive
+type VerifyArchiveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyArchiveRequest) Reset() {
+	*x = VerifyArchiveRequest{}
+	mi := &file_journal_v1_journal_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyArchiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyArchiveRequest) ProtoMessage() {}
+
+func (x *VerifyArchiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_v1_journal_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyArchiveRequest.ProtoReflect.Descriptor instead.
+func (*VerifyArchiveRequest) Descriptor() ([]byte, []int) {
+	return file_journal_v1_journal_proto_rawDescGZIP(), []int{54}
+}
+
+// VerifyArchiveResponse is the result of verifying the entry archive
+type VerifyArchiveResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	RecordCount int64                  `protobuf:"varint,1,opt,name=record_count,json=recordCount,proto3" json:"record_count,omitempty"`
+	// head_hash is the hash of the newest archive record; keep a copy of it
+	// to detect the whole archive being rewritten later
+	HeadHash string `protobuf:"bytes,2,opt,name=head_hash,json=headHash,proto3" json:"head_hash,omitempty"`
+	// valid is true if no problems were found
+	Valid         bool     `protobuf:"varint,3,opt,name=valid,proto3" json:"valid,omitempty"`
+	Problems      []string `protobuf:"bytes,4,rep,name=problems,proto3" json:"problems,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyArchiveResponse) Reset() {
+	*x = VerifyArchiveResponse{}
+	mi := &file_journal_v1_journal_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyArchiveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyArchiveResponse) ProtoMessage() {}
+
+func (x *VerifyArchiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_v1_journal_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyArchiveResponse.ProtoReflect.Descriptor instead.
+func (*VerifyArchiveResponse) Descriptor() ([]byte, []int) {
+	return file_journal_v1_journal_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *VerifyArchiveResponse) GetRecordCount() int64 {
+	if x != nil {
+		return x.RecordCount
 	}
 	return 0
 }
 
+func (x *VerifyArchiveResponse) GetHeadHash() string {
+	if x != nil {
+		return x.HeadHash
+	}
+	return ""
+}
+
+func (x *VerifyArchiveResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *VerifyArchiveResponse) GetProblems() []string {
+	if x != nil {
+		return x.Problems
+	}
+	return nil
+}
+
+// PushBackupRequest is the request to push the journal to its backup remote
+type PushBackupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PushBackupRequest) Reset() {
+	*x = PushBackupRequest{}
+	mi := &file_journal_v1_journal_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushBackupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushBackupRequest) ProtoMessage() {}
+
+func (x *PushBackupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_v1_journal_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushBackupRequest.ProtoReflect.Descriptor instead.
+func (*PushBackupRequest) Descriptor() ([]byte, []int) {
+	return file_journal_v1_journal_proto_rawDescGZIP(), []int{56}
+}
+
+// PushBackupResponse is the result of pushing a backup
+type PushBackupResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// version identifies what was pushed, the git commit hash of HEAD
+	Version       string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PushBackupResponse) Reset() {
+	*x = PushBackupResponse{}
+	mi := &file_journal_v1_journal_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushBackupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushBackupResponse) ProtoMessage() {}
+
+func (x *PushBackupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_v1_journal_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushBackupResponse.ProtoReflect.Descriptor instead.
+func (*PushBackupResponse) Descriptor() ([]byte, []int) {
+	return file_journal_v1_journal_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *PushBackupResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+// SuggestTitleRequest is the request to suggest a title for entry content
+type SuggestTitleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Content       string                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestTitleRequest) Reset() {
+	*x = SuggestTitleRequest{}
+	mi := &file_journal_v1_journal_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestTitleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestTitleRequest) ProtoMessage() {}
+
+func (x *SuggestTitleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_v1_journal_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestTitleRequest.ProtoReflect.Descriptor instead.
+func (*SuggestTitleRequest) Descriptor() ([]byte, []int) {
+	return file_journal_v1_journal_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *SuggestTitleRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+// SuggestTitleResponse is the response containing a suggested title
+type SuggestTitleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestTitleResponse) Reset() {
+	*x = SuggestTitleResponse{}
+	mi := &file_journal_v1_journal_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestTitleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestTitleResponse) ProtoMessage() {}
+
+func (x *SuggestTitleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_journal_v1_journal_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestTitleResponse.ProtoReflect.Descriptor instead.
+func (*SuggestTitleResponse) Descriptor() ([]byte, []int) {
+	return file_journal_v1_journal_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *SuggestTitleResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
 var File_journal_v1_journal_proto protoreflect.FileDescriptor
 
 const file_journal_v1_journal_proto_rawDesc = "" +
 	"\n" +
 	"\x18journal/v1/journal.proto\x12\n" +
-	"journal.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc4\x01\n" +
+	"journal.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd6\x03\n" +
 	"\fJournalEntry\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
@@ -520,36 +3446,241 @@ const file_journal_v1_journal_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"K\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x127\n" +
+	"\treveal_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\brevealAt\x12\x16\n" +
+	"\x06sealed\x18\a \x01(\bR\x06sealed\x125\n" +
+	"\bdocument\x18\b \x01(\v2\x19.journal.v1.EntryDocumentR\bdocument\x12\x12\n" +
+	"\x04tags\x18\t \x03(\tR\x04tags\x129\n" +
+	"\n" +
+	"deleted_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x1f\n" +
+	"\vcommit_hash\x18\v \x01(\tR\n" +
+	"commitHash\x12\x18\n" +
+	"\aversion\x18\f \x01(\x03R\aversion\"\xd7\x01\n" +
+	"\bRevision\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bentry_id\x18\x02 \x01(\tR\aentryId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x125\n" +
+	"\bdocument\x18\x05 \x01(\v2\x19.journal.v1.EntryDocumentR\bdocument\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\":\n" +
+	"\x03Tag\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1f\n" +
+	"\ventry_count\x18\x02 \x01(\x03R\n" +
+	"entryCount\"Z\n" +
+	"\rEntryDocument\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\x12/\n" +
+	"\bsections\x18\x02 \x03(\v2\x13.journal.v1.SectionR\bsections\"\xff\x01\n" +
+	"\aSection\x12\x18\n" +
+	"\aheading\x18\x01 \x01(\tR\aheading\x12-\n" +
+	"\x04text\x18\x02 \x01(\v2\x17.journal.v1.TextSectionH\x00R\x04text\x12<\n" +
+	"\tchecklist\x18\x03 \x01(\v2\x1c.journal.v1.ChecklistSectionH\x00R\tchecklist\x123\n" +
+	"\x06rating\x18\x04 \x01(\v2\x19.journal.v1.RatingSectionH\x00R\x06rating\x120\n" +
+	"\x05photo\x18\x05 \x01(\v2\x18.journal.v1.PhotoSectionH\x00R\x05photoB\x06\n" +
+	"\x04body\"!\n" +
+	"\vTextSection\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\"C\n" +
+	"\x10ChecklistSection\x12/\n" +
+	"\x05items\x18\x01 \x03(\v2\x19.journal.v1.ChecklistItemR\x05items\"=\n" +
+	"\rChecklistItem\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x18\n" +
+	"\achecked\x18\x02 \x01(\bR\achecked\"7\n" +
+	"\rRatingSection\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x05R\x05value\x12\x10\n" +
+	"\x03max\x18\x02 \x01(\x05R\x03max\":\n" +
+	"\fPhotoSection\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x18\n" +
+	"\acaption\x18\x02 \x01(\tR\acaption\"\xcf\x01\n" +
 	"\x19CreateJournalEntryRequest\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12\x18\n" +
-	"\acontent\x18\x02 \x01(\tR\acontent\"L\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x127\n" +
+	"\treveal_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\brevealAt\x125\n" +
+	"\bdocument\x18\x04 \x01(\v2\x19.journal.v1.EntryDocumentR\bdocument\x12\x12\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tags\"L\n" +
 	"\x1aCreateJournalEntryResponse\x12.\n" +
-	"\x05entry\x18\x01 \x01(\v2\x18.journal.v1.JournalEntryR\x05entry\"[\n" +
+	"\x05entry\x18\x01 \x01(\v2\x18.journal.v1.JournalEntryR\x05entry\"(\n" +
+	"\x16GetJournalEntryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"I\n" +
+	"\x17GetJournalEntryResponse\x12.\n" +
+	"\x05entry\x18\x01 \x01(\v2\x18.journal.v1.JournalEntryR\x05entry\"\xd1\x01\n" +
 	"\x19UpdateJournalEntryRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
-	"\acontent\x18\x03 \x01(\tR\acontent\"L\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x125\n" +
+	"\bdocument\x18\x04 \x01(\v2\x19.journal.v1.EntryDocumentR\bdocument\x12\x12\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tags\x12)\n" +
+	"\x10expected_version\x18\x06 \x01(\x03R\x0fexpectedVersion\"L\n" +
 	"\x1aUpdateJournalEntryResponse\x12.\n" +
-	"\x05entry\x18\x01 \x01(\v2\x18.journal.v1.JournalEntryR\x05entry\"+\n" +
+	"\x05entry\x18\x01 \x01(\v2\x18.journal.v1.JournalEntryR\x05entry\"I\n" +
 	"\x19DeleteJournalEntryRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"6\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\tpermanent\x18\x02 \x01(\bR\tpermanent\"6\n" +
 	"\x1aDeleteJournalEntryResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"W\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"k\n" +
+	"\vBatchResult\x12.\n" +
+	"\x05entry\x18\x01 \x01(\v2\x18.journal.v1.JournalEntryR\x05entry\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"e\n" +
+	" BatchCreateJournalEntriesRequest\x12A\n" +
+	"\brequests\x18\x01 \x03(\v2%.journal.v1.CreateJournalEntryRequestR\brequests\"V\n" +
+	"!BatchCreateJournalEntriesResponse\x121\n" +
+	"\aresults\x18\x01 \x03(\v2\x17.journal.v1.BatchResultR\aresults\"1\n" +
+	"\x1dBatchGetJournalEntriesRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\"S\n" +
+	"\x1eBatchGetJournalEntriesResponse\x121\n" +
+	"\aresults\x18\x01 \x03(\v2\x17.journal.v1.BatchResultR\aresults\"R\n" +
+	" BatchDeleteJournalEntriesRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\x12\x1c\n" +
+	"\tpermanent\x18\x02 \x01(\bR\tpermanent\"V\n" +
+	"!BatchDeleteJournalEntriesResponse\x121\n" +
+	"\aresults\x18\x01 \x03(\v2\x17.journal.v1.BatchResultR\aresults\"\xa7\x01\n" +
 	"\x19ListJournalEntriesRequest\x12\x1b\n" +
 	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x02 \x01(\tR\tpageToken\"\x99\x01\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x1f\n" +
+	"\vdate_filter\x18\x03 \x01(\tR\n" +
+	"dateFilter\x12\x1b\n" +
+	"\ttime_zone\x18\x04 \x01(\tR\btimeZone\x12\x10\n" +
+	"\x03tag\x18\x05 \x01(\tR\x03tag\"\x99\x01\n" +
 	"\x1aListJournalEntriesResponse\x122\n" +
 	"\aentries\x18\x01 \x03(\v2\x18.journal.v1.JournalEntryR\aentries\x12&\n" +
 	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
 	"\vtotal_count\x18\x03 \x01(\x05R\n" +
-	"totalCount2\xa4\x03\n" +
+	"totalCount\"o\n" +
+	"\x1bSearchJournalEntriesRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x9b\x01\n" +
+	"\x1cSearchJournalEntriesResponse\x122\n" +
+	"\aentries\x18\x01 \x03(\v2\x18.journal.v1.JournalEntryR\aentries\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"\x11\n" +
+	"\x0fListTagsRequest\"7\n" +
+	"\x10ListTagsResponse\x12#\n" +
+	"\x04tags\x18\x01 \x03(\v2\x0f.journal.v1.TagR\x04tags\"A\n" +
+	"\x10RenameTagRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x19\n" +
+	"\bnew_name\x18\x02 \x01(\tR\anewName\"6\n" +
+	"\x11RenameTagResponse\x12!\n" +
+	"\x03tag\x18\x01 \x01(\v2\x0f.journal.v1.TagR\x03tag\"&\n" +
+	"\x10DeleteTagRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"-\n" +
+	"\x11DeleteTagResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"W\n" +
+	"\x19ListTrashedEntriesRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"\x99\x01\n" +
+	"\x1aListTrashedEntriesResponse\x122\n" +
+	"\aentries\x18\x01 \x03(\v2\x18.journal.v1.JournalEntryR\aentries\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\",\n" +
+	"\x1aRestoreJournalEntryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"M\n" +
+	"\x1bRestoreJournalEntryResponse\x12.\n" +
+	"\x05entry\x18\x01 \x01(\v2\x18.journal.v1.JournalEntryR\x05entry\"V\n" +
+	"\x11PurgeTrashRequest\x12A\n" +
+	"\x0edeleted_before\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\rdeletedBefore\"7\n" +
+	"\x12PurgeTrashResponse\x12!\n" +
+	"\fpurged_count\x18\x01 \x01(\x03R\vpurgedCount\"y\n" +
+	" ListJournalEntryRevisionsRequest\x12\x19\n" +
+	"\bentry_id\x18\x01 \x01(\tR\aentryId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\xa0\x01\n" +
+	"!ListJournalEntryRevisionsResponse\x122\n" +
+	"\trevisions\x18\x01 \x03(\v2\x14.journal.v1.RevisionR\trevisions\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"\x91\x01\n" +
+	"\"SearchJournalEntryRevisionsRequest\x12\x19\n" +
+	"\bentry_id\x18\x01 \x01(\tR\aentryId\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"\xa2\x01\n" +
+	"#SearchJournalEntryRevisionsResponse\x122\n" +
+	"\trevisions\x18\x01 \x03(\v2\x14.journal.v1.RevisionR\trevisions\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"`\n" +
+	"\"RestoreJournalEntryRevisionRequest\x12\x19\n" +
+	"\bentry_id\x18\x01 \x01(\tR\aentryId\x12\x1f\n" +
+	"\vrevision_id\x18\x02 \x01(\tR\n" +
+	"revisionId\"U\n" +
+	"#RestoreJournalEntryRevisionResponse\x12.\n" +
+	"\x05entry\x18\x01 \x01(\v2\x18.journal.v1.JournalEntryR\x05entry\"H\n" +
+	"\x14ExportJournalRequest\x120\n" +
+	"\x06format\x18\x01 \x01(\x0e2\x18.journal.v1.ExportFormatR\x06format\"G\n" +
+	"\x15ExportJournalResponse\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\"\xc3\x01\n" +
+	"\vImportEntry\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x125\n" +
+	"\bdocument\x18\x05 \x01(\v2\x19.journal.v1.EntryDocumentR\bdocument\"I\n" +
+	"\x14ImportJournalRequest\x121\n" +
+	"\aentries\x18\x01 \x03(\v2\x17.journal.v1.ImportEntryR\aentries\"c\n" +
+	"\x15ImportJournalResponse\x12%\n" +
+	"\x0eimported_count\x18\x01 \x01(\x03R\rimportedCount\x12#\n" +
+	"\rskipped_count\x18\x02 \x01(\x03R\fskippedCount\")\n" +
+	"\x13ImportDayOneRequest\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"b\n" +
+	"\x14ImportDayOneResponse\x12%\n" +
+	"\x0eimported_count\x18\x01 \x01(\x03R\rimportedCount\x12#\n" +
+	"\rskipped_count\x18\x02 \x01(\x03R\fskippedCount\"\x16\n" +
+	"\x14VerifyArchiveRequest\"\x89\x01\n" +
+	"\x15VerifyArchiveResponse\x12!\n" +
+	"\frecord_count\x18\x01 \x01(\x03R\vrecordCount\x12\x1b\n" +
+	"\thead_hash\x18\x02 \x01(\tR\bheadHash\x12\x14\n" +
+	"\x05valid\x18\x03 \x01(\bR\x05valid\x12\x1a\n" +
+	"\bproblems\x18\x04 \x03(\tR\bproblems\"\x13\n" +
+	"\x11PushBackupRequest\".\n" +
+	"\x12PushBackupResponse\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\"/\n" +
+	"\x13SuggestTitleRequest\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\tR\acontent\",\n" +
+	"\x14SuggestTitleResponse\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title*~\n" +
+	"\fExportFormat\x12\x1d\n" +
+	"\x19EXPORT_FORMAT_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18EXPORT_FORMAT_JSON_LINES\x10\x01\x12\x1a\n" +
+	"\x16EXPORT_FORMAT_MARKDOWN\x10\x02\x12\x15\n" +
+	"\x11EXPORT_FORMAT_CSV\x10\x032\xba\x12\n" +
 	"\x0eJournalService\x12c\n" +
-	"\x12CreateJournalEntry\x12%.journal.v1.CreateJournalEntryRequest\x1a&.journal.v1.CreateJournalEntryResponse\x12c\n" +
+	"\x12CreateJournalEntry\x12%.journal.v1.CreateJournalEntryRequest\x1a&.journal.v1.CreateJournalEntryResponse\x12Z\n" +
+	"\x0fGetJournalEntry\x12\".journal.v1.GetJournalEntryRequest\x1a#.journal.v1.GetJournalEntryResponse\x12c\n" +
 	"\x12UpdateJournalEntry\x12%.journal.v1.UpdateJournalEntryRequest\x1a&.journal.v1.UpdateJournalEntryResponse\x12c\n" +
-	"\x12DeleteJournalEntry\x12%.journal.v1.DeleteJournalEntryRequest\x1a&.journal.v1.DeleteJournalEntryResponse\x12c\n" +
-	"\x12ListJournalEntries\x12%.journal.v1.ListJournalEntriesRequest\x1a&.journal.v1.ListJournalEntriesResponseBFZDgithub.com/parkernilson/micro-journal/gen/proto/journal/v1;journalv1b\x06proto3"
+	"\x12DeleteJournalEntry\x12%.journal.v1.DeleteJournalEntryRequest\x1a&.journal.v1.DeleteJournalEntryResponse\x12x\n" +
+	"\x19BatchCreateJournalEntries\x12,.journal.v1.BatchCreateJournalEntriesRequest\x1a-.journal.v1.BatchCreateJournalEntriesResponse\x12o\n" +
+	"\x16BatchGetJournalEntries\x12).journal.v1.BatchGetJournalEntriesRequest\x1a*.journal.v1.BatchGetJournalEntriesResponse\x12x\n" +
+	"\x19BatchDeleteJournalEntries\x12,.journal.v1.BatchDeleteJournalEntriesRequest\x1a-.journal.v1.BatchDeleteJournalEntriesResponse\x12c\n" +
+	"\x12ListJournalEntries\x12%.journal.v1.ListJournalEntriesRequest\x1a&.journal.v1.ListJournalEntriesResponse\x12i\n" +
+	"\x14SearchJournalEntries\x12'.journal.v1.SearchJournalEntriesRequest\x1a(.journal.v1.SearchJournalEntriesResponse\x12E\n" +
+	"\bListTags\x12\x1b.journal.v1.ListTagsRequest\x1a\x1c.journal.v1.ListTagsResponse\x12H\n" +
+	"\tRenameTag\x12\x1c.journal.v1.RenameTagRequest\x1a\x1d.journal.v1.RenameTagResponse\x12H\n" +
+	"\tDeleteTag\x12\x1c.journal.v1.DeleteTagRequest\x1a\x1d.journal.v1.DeleteTagResponse\x12c\n" +
+	"\x12ListTrashedEntries\x12%.journal.v1.ListTrashedEntriesRequest\x1a&.journal.v1.ListTrashedEntriesResponse\x12f\n" +
+	"\x13RestoreJournalEntry\x12&.journal.v1.RestoreJournalEntryRequest\x1a'.journal.v1.RestoreJournalEntryResponse\x12K\n" +
+	"\n" +
+	"PurgeTrash\x12\x1d.journal.v1.PurgeTrashRequest\x1a\x1e.journal.v1.PurgeTrashResponse\x12x\n" +
+	"\x19ListJournalEntryRevisions\x12,.journal.v1.ListJournalEntryRevisionsRequest\x1a-.journal.v1.ListJournalEntryRevisionsResponse\x12~\n" +
+	"\x1bSearchJournalEntryRevisions\x12..journal.v1.SearchJournalEntryRevisionsRequest\x1a/.journal.v1.SearchJournalEntryRevisionsResponse\x12~\n" +
+	"\x1bRestoreJournalEntryRevision\x12..journal.v1.RestoreJournalEntryRevisionRequest\x1a/.journal.v1.RestoreJournalEntryRevisionResponse\x12V\n" +
+	"\rExportJournal\x12 .journal.v1.ExportJournalRequest\x1a!.journal.v1.ExportJournalResponse0\x01\x12V\n" +
+	"\rImportJournal\x12 .journal.v1.ImportJournalRequest\x1a!.journal.v1.ImportJournalResponse(\x01\x12S\n" +
+	"\fImportDayOne\x12\x1f.journal.v1.ImportDayOneRequest\x1a .journal.v1.ImportDayOneResponse(\x01\x12T\n" +
+	"\rVerifyArchive\x12 .journal.v1.VerifyArchiveRequest\x1a!.journal.v1.VerifyArchiveResponse\x12K\n" +
+	"\n" +
+	"PushBackup\x12\x1d.journal.v1.PushBackupRequest\x1a\x1e.journal.v1.PushBackupResponse\x12Q\n" +
+	"\fSuggestTitle\x12\x1f.journal.v1.SuggestTitleRequest\x1a .journal.v1.SuggestTitleResponseB@Z>github.com/parkernilson/micro-journal/gen/journal/v1;journalv1b\x06proto3"
 
 var (
 	file_journal_v1_journal_proto_rawDescOnce sync.Once
@@ -563,38 +3694,164 @@ func file_journal_v1_journal_proto_rawDescGZIP() []byte {
 	return file_journal_v1_journal_proto_rawDescData
 }
 
-var file_journal_v1_journal_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_journal_v1_journal_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_journal_v1_journal_proto_msgTypes = make([]protoimpl.MessageInfo, 60)
 var file_journal_v1_journal_proto_goTypes = []any{
-	(*JournalEntry)(nil),               // 0: journal.v1.JournalEntry
-	(*CreateJournalEntryRequest)(nil),  // 1: journal.v1.CreateJournalEntryRequest
-	(*CreateJournalEntryResponse)(nil), // 2: journal.v1.CreateJournalEntryResponse
-	(*UpdateJournalEntryRequest)(nil),  // 3: journal.v1.UpdateJournalEntryRequest
-	(*UpdateJournalEntryResponse)(nil), // 4: journal.v1.UpdateJournalEntryResponse
-	(*DeleteJournalEntryRequest)(nil),  // 5: journal.v1.DeleteJournalEntryRequest
-	(*DeleteJournalEntryResponse)(nil), // 6: journal.v1.DeleteJournalEntryResponse
-	(*ListJournalEntriesRequest)(nil),  // 7: journal.v1.ListJournalEntriesRequest
-	(*ListJournalEntriesResponse)(nil), // 8: journal.v1.ListJournalEntriesResponse
-	(*timestamppb.Timestamp)(nil),      // 9: google.protobuf.Timestamp
+	(ExportFormat)(0),                           // 0: journal.v1.ExportFormat
+	(*JournalEntry)(nil),                        // 1: journal.v1.JournalEntry
+	(*Revision)(nil),                            // 2: journal.v1.Revision
+	(*Tag)(nil),                                 // 3: journal.v1.Tag
+	(*EntryDocument)(nil),                       // 4: journal.v1.EntryDocument
+	(*Section)(nil),                             // 5: journal.v1.Section
+	(*TextSection)(nil),                         // 6: journal.v1.TextSection
+	(*ChecklistSection)(nil),                    // 7: journal.v1.ChecklistSection
+	(*ChecklistItem)(nil),                       // 8: journal.v1.ChecklistItem
+	(*RatingSection)(nil),                       // 9: journal.v1.RatingSection
+	(*PhotoSection)(nil),                        // 10: journal.v1.PhotoSection
+	(*CreateJournalEntryRequest)(nil),           // 11: journal.v1.CreateJournalEntryRequest
+	(*CreateJournalEntryResponse)(nil),          // 12: journal.v1.CreateJournalEntryResponse
+	(*GetJournalEntryRequest)(nil),              // 13: journal.v1.GetJournalEntryRequest
+	(*GetJournalEntryResponse)(nil),             // 14: journal.v1.GetJournalEntryResponse
+	(*UpdateJournalEntryRequest)(nil),           // 15: journal.v1.UpdateJournalEntryRequest
+	(*UpdateJournalEntryResponse)(nil),          // 16: journal.v1.UpdateJournalEntryResponse
+	(*DeleteJournalEntryRequest)(nil),           // 17: journal.v1.DeleteJournalEntryRequest
+	(*DeleteJournalEntryResponse)(nil),          // 18: journal.v1.DeleteJournalEntryResponse
+	(*BatchResult)(nil),                         // 19: journal.v1.BatchResult
+	(*BatchCreateJournalEntriesRequest)(nil),    // 20: journal.v1.BatchCreateJournalEntriesRequest
+	(*BatchCreateJournalEntriesResponse)(nil),   // 21: journal.v1.BatchCreateJournalEntriesResponse
+	(*BatchGetJournalEntriesRequest)(nil),       // 22: journal.v1.BatchGetJournalEntriesRequest
+	(*BatchGetJournalEntriesResponse)(nil),      // 23: journal.v1.BatchGetJournalEntriesResponse
+	(*BatchDeleteJournalEntriesRequest)(nil),    // 24: journal.v1.BatchDeleteJournalEntriesRequest
+	(*BatchDeleteJournalEntriesResponse)(nil),   // 25: journal.v1.BatchDeleteJournalEntriesResponse
+	(*ListJournalEntriesRequest)(nil),           // 26: journal.v1.ListJournalEntriesRequest
+	(*ListJournalEntriesResponse)(nil),          // 27: journal.v1.ListJournalEntriesResponse
+	(*SearchJournalEntriesRequest)(nil),         // 28: journal.v1.SearchJournalEntriesRequest
+	(*SearchJournalEntriesResponse)(nil),        // 29: journal.v1.SearchJournalEntriesResponse
+	(*ListTagsRequest)(nil),                     // 30: journal.v1.ListTagsRequest
+	(*ListTagsResponse)(nil),                    // 31: journal.v1.ListTagsResponse
+	(*RenameTagRequest)(nil),                    // 32: journal.v1.RenameTagRequest
+	(*RenameTagResponse)(nil),                   // 33: journal.v1.RenameTagResponse
+	(*DeleteTagRequest)(nil),                    // 34: journal.v1.DeleteTagRequest
+	(*DeleteTagResponse)(nil),                   // 35: journal.v1.DeleteTagResponse
+	(*ListTrashedEntriesRequest)(nil),           // 36: journal.v1.ListTrashedEntriesRequest
+	(*ListTrashedEntriesResponse)(nil),          // 37: journal.v1.ListTrashedEntriesResponse
+	(*RestoreJournalEntryRequest)(nil),          // 38: journal.v1.RestoreJournalEntryRequest
+	(*RestoreJournalEntryResponse)(nil),         // 39: journal.v1.RestoreJournalEntryResponse
+	(*PurgeTrashRequest)(nil),                   // 40: journal.v1.PurgeTrashRequest
+	(*PurgeTrashResponse)(nil),                  // 41: journal.v1.PurgeTrashResponse
+	(*ListJournalEntryRevisionsRequest)(nil),    // 42: journal.v1.ListJournalEntryRevisionsRequest
+	(*ListJournalEntryRevisionsResponse)(nil),   // 43: journal.v1.ListJournalEntryRevisionsResponse
+	(*SearchJournalEntryRevisionsRequest)(nil),  // 44: journal.v1.SearchJournalEntryRevisionsRequest
+	(*SearchJournalEntryRevisionsResponse)(nil), // 45: journal.v1.SearchJournalEntryRevisionsResponse
+	(*RestoreJournalEntryRevisionRequest)(nil),  // 46: journal.v1.RestoreJournalEntryRevisionRequest
+	(*RestoreJournalEntryRevisionResponse)(nil), // 47: journal.v1.RestoreJournalEntryRevisionResponse
+	(*ExportJournalRequest)(nil),                // 48: journal.v1.ExportJournalRequest
+	(*ExportJournalResponse)(nil),               // 49: journal.v1.ExportJournalResponse
+	(*ImportEntry)(nil),                         // 50: journal.v1.ImportEntry
+	(*ImportJournalRequest)(nil),                // 51: journal.v1.ImportJournalRequest
+	(*ImportJournalResponse)(nil),               // 52: journal.v1.ImportJournalResponse
+	(*ImportDayOneRequest)(nil),                 // 53: journal.v1.ImportDayOneRequest
+	(*ImportDayOneResponse)(nil),                // 54: journal.v1.ImportDayOneResponse
+	(*VerifyArchiveRequest)(nil),                // 55: journal.v1.VerifyArchiveRequest
+	(*VerifyArchiveResponse)(nil),               // 56: journal.v1.VerifyArchiveResponse
+	(*PushBackupRequest)(nil),                   // 57: journal.v1.PushBackupRequest
+	(*PushBackupResponse)(nil),                  // 58: journal.v1.PushBackupResponse
+	(*SuggestTitleRequest)(nil),                 // 59: journal.v1.SuggestTitleRequest
+	(*SuggestTitleResponse)(nil),                // 60: journal.v1.SuggestTitleResponse
+	(*timestamppb.Timestamp)(nil),               // 61: google.protobuf.Timestamp
 }
 var file_journal_v1_journal_proto_depIdxs = []int32{
-	9, // 0: journal.v1.JournalEntry.created_at:type_name -> google.protobuf.Timestamp
-	9, // 1: journal.v1.JournalEntry.updated_at:type_name -> google.protobuf.Timestamp
-	0, // 2: journal.v1.CreateJournalEntryResponse.entry:type_name -> journal.v1.JournalEntry
-	0, // 3: journal.v1.UpdateJournalEntryResponse.entry:type_name -> journal.v1.JournalEntry
-	0, // 4: journal.v1.ListJournalEntriesResponse.entries:type_name -> journal.v1.JournalEntry
-	1, // 5: journal.v1.JournalService.CreateJournalEntry:input_type -> journal.v1.CreateJournalEntryRequest
-	3, // 6: journal.v1.JournalService.UpdateJournalEntry:input_type -> journal.v1.UpdateJournalEntryRequest
-	5, // 7: journal.v1.JournalService.DeleteJournalEntry:input_type -> journal.v1.DeleteJournalEntryRequest
-	7, // 8: journal.v1.JournalService.ListJournalEntries:input_type -> journal.v1.ListJournalEntriesRequest
-	2, // 9: journal.v1.JournalService.CreateJournalEntry:output_type -> journal.v1.CreateJournalEntryResponse
-	4, // 10: journal.v1.JournalService.UpdateJournalEntry:output_type -> journal.v1.UpdateJournalEntryResponse
-	6, // 11: journal.v1.JournalService.DeleteJournalEntry:output_type -> journal.v1.DeleteJournalEntryResponse
-	8, // 12: journal.v1.JournalService.ListJournalEntries:output_type -> journal.v1.ListJournalEntriesResponse
-	9, // [9:13] is the sub-list for method output_type
-	5, // [5:9] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	61, // 0: journal.v1.JournalEntry.created_at:type_name -> google.protobuf.Timestamp
+	61, // 1: journal.v1.JournalEntry.updated_at:type_name -> google.protobuf.Timestamp
+	61, // 2: journal.v1.JournalEntry.reveal_at:type_name -> google.protobuf.Timestamp
+	4,  // 3: journal.v1.JournalEntry.document:type_name -> journal.v1.EntryDocument
+	61, // 4: journal.v1.JournalEntry.deleted_at:type_name -> google.protobuf.Timestamp
+	4,  // 5: journal.v1.Revision.document:type_name -> journal.v1.EntryDocument
+	61, // 6: journal.v1.Revision.created_at:type_name -> google.protobuf.Timestamp
+	5,  // 7: journal.v1.EntryDocument.sections:type_name -> journal.v1.Section
+	6,  // 8: journal.v1.Section.text:type_name -> journal.v1.TextSection
+	7,  // 9: journal.v1.Section.checklist:type_name -> journal.v1.ChecklistSection
+	9,  // 10: journal.v1.Section.rating:type_name -> journal.v1.RatingSection
+	10, // 11: journal.v1.Section.photo:type_name -> journal.v1.PhotoSection
+	8,  // 12: journal.v1.ChecklistSection.items:type_name -> journal.v1.ChecklistItem
+	61, // 13: journal.v1.CreateJournalEntryRequest.reveal_at:type_name -> google.protobuf.Timestamp
+	4,  // 14: journal.v1.CreateJournalEntryRequest.document:type_name -> journal.v1.EntryDocument
+	1,  // 15: journal.v1.CreateJournalEntryResponse.entry:type_name -> journal.v1.JournalEntry
+	1,  // 16: journal.v1.GetJournalEntryResponse.entry:type_name -> journal.v1.JournalEntry
+	4,  // 17: journal.v1.UpdateJournalEntryRequest.document:type_name -> journal.v1.EntryDocument
+	1,  // 18: journal.v1.UpdateJournalEntryResponse.entry:type_name -> journal.v1.JournalEntry
+	1,  // 19: journal.v1.BatchResult.entry:type_name -> journal.v1.JournalEntry
+	11, // 20: journal.v1.BatchCreateJournalEntriesRequest.requests:type_name -> journal.v1.CreateJournalEntryRequest
+	19, // 21: journal.v1.BatchCreateJournalEntriesResponse.results:type_name -> journal.v1.BatchResult
+	19, // 22: journal.v1.BatchGetJournalEntriesResponse.results:type_name -> journal.v1.BatchResult
+	19, // 23: journal.v1.BatchDeleteJournalEntriesResponse.results:type_name -> journal.v1.BatchResult
+	1,  // 24: journal.v1.ListJournalEntriesResponse.entries:type_name -> journal.v1.JournalEntry
+	1,  // 25: journal.v1.SearchJournalEntriesResponse.entries:type_name -> journal.v1.JournalEntry
+	3,  // 26: journal.v1.ListTagsResponse.tags:type_name -> journal.v1.Tag
+	3,  // 27: journal.v1.RenameTagResponse.tag:type_name -> journal.v1.Tag
+	1,  // 28: journal.v1.ListTrashedEntriesResponse.entries:type_name -> journal.v1.JournalEntry
+	1,  // 29: journal.v1.RestoreJournalEntryResponse.entry:type_name -> journal.v1.JournalEntry
+	61, // 30: journal.v1.PurgeTrashRequest.deleted_before:type_name -> google.protobuf.Timestamp
+	2,  // 31: journal.v1.ListJournalEntryRevisionsResponse.revisions:type_name -> journal.v1.Revision
+	2,  // 32: journal.v1.SearchJournalEntryRevisionsResponse.revisions:type_name -> journal.v1.Revision
+	1,  // 33: journal.v1.RestoreJournalEntryRevisionResponse.entry:type_name -> journal.v1.JournalEntry
+	0,  // 34: journal.v1.ExportJournalRequest.format:type_name -> journal.v1.ExportFormat
+	61, // 35: journal.v1.ImportEntry.created_at:type_name -> google.protobuf.Timestamp
+	4,  // 36: journal.v1.ImportEntry.document:type_name -> journal.v1.EntryDocument
+	50, // 37: journal.v1.ImportJournalRequest.entries:type_name -> journal.v1.ImportEntry
+	11, // 38: journal.v1.JournalService.CreateJournalEntry:input_type -> journal.v1.CreateJournalEntryRequest
+	13, // 39: journal.v1.JournalService.GetJournalEntry:input_type -> journal.v1.GetJournalEntryRequest
+	15, // 40: journal.v1.JournalService.UpdateJournalEntry:input_type -> journal.v1.UpdateJournalEntryRequest
+	17, // 41: journal.v1.JournalService.DeleteJournalEntry:input_type -> journal.v1.DeleteJournalEntryRequest
+	20, // 42: journal.v1.JournalService.BatchCreateJournalEntries:input_type -> journal.v1.BatchCreateJournalEntriesRequest
+	22, // 43: journal.v1.JournalService.BatchGetJournalEntries:input_type -> journal.v1.BatchGetJournalEntriesRequest
+	24, // 44: journal.v1.JournalService.BatchDeleteJournalEntries:input_type -> journal.v1.BatchDeleteJournalEntriesRequest
+	26, // 45: journal.v1.JournalService.ListJournalEntries:input_type -> journal.v1.ListJournalEntriesRequest
+	28, // 46: journal.v1.JournalService.SearchJournalEntries:input_type -> journal.v1.SearchJournalEntriesRequest
+	30, // 47: journal.v1.JournalService.ListTags:input_type -> journal.v1.ListTagsRequest
+	32, // 48: journal.v1.JournalService.RenameTag:input_type -> journal.v1.RenameTagRequest
+	34, // 49: journal.v1.JournalService.DeleteTag:input_type -> journal.v1.DeleteTagRequest
+	36, // 50: journal.v1.JournalService.ListTrashedEntries:input_type -> journal.v1.ListTrashedEntriesRequest
+	38, // 51: journal.v1.JournalService.RestoreJournalEntry:input_type -> journal.v1.RestoreJournalEntryRequest
+	40, // 52: journal.v1.JournalService.PurgeTrash:input_type -> journal.v1.PurgeTrashRequest
+	42, // 53: journal.v1.JournalService.ListJournalEntryRevisions:input_type -> journal.v1.ListJournalEntryRevisionsRequest
+	44, // 54: journal.v1.JournalService.SearchJournalEntryRevisions:input_type -> journal.v1.SearchJournalEntryRevisionsRequest
+	46, // 55: journal.v1.JournalService.RestoreJournalEntryRevision:input_type -> journal.v1.RestoreJournalEntryRevisionRequest
+	48, // 56: journal.v1.JournalService.ExportJournal:input_type -> journal.v1.ExportJournalRequest
+	51, // 57: journal.v1.JournalService.ImportJournal:input_type -> journal.v1.ImportJournalRequest
+	53, // 58: journal.v1.JournalService.ImportDayOne:input_type -> journal.v1.ImportDayOneRequest
+	55, // 59: journal.v1.JournalService.VerifyArchive:input_type -> journal.v1.VerifyArchiveRequest
+	57, // 60: journal.v1.JournalService.PushBackup:input_type -> journal.v1.PushBackupRequest
+	59, // 61: journal.v1.JournalService.SuggestTitle:input_type -> journal.v1.SuggestTitleRequest
+	12, // 62: journal.v1.JournalService.CreateJournalEntry:output_type -> journal.v1.CreateJournalEntryResponse
+	14, // 63: journal.v1.JournalService.GetJournalEntry:output_type -> journal.v1.GetJournalEntryResponse
+	16, // 64: journal.v1.JournalService.UpdateJournalEntry:output_type -> journal.v1.UpdateJournalEntryResponse
+	18, // 65: journal.v1.JournalService.DeleteJournalEntry:output_type -> journal.v1.DeleteJournalEntryResponse
+	21, // 66: journal.v1.JournalService.BatchCreateJournalEntries:output_type -> journal.v1.BatchCreateJournalEntriesResponse
+	23, // 67: journal.v1.JournalService.BatchGetJournalEntries:output_type -> journal.v1.BatchGetJournalEntriesResponse
+	25, // 68: journal.v1.JournalService.BatchDeleteJournalEntries:output_type -> journal.v1.BatchDeleteJournalEntriesResponse
+	27, // 69: journal.v1.JournalService.ListJournalEntries:output_type -> journal.v1.ListJournalEntriesResponse
+	29, // 70: journal.v1.JournalService.SearchJournalEntries:output_type -> journal.v1.SearchJournalEntriesResponse
+	31, // 71: journal.v1.JournalService.ListTags:output_type -> journal.v1.ListTagsResponse
+	33, // 72: journal.v1.JournalService.RenameTag:output_type -> journal.v1.RenameTagResponse
+	35, // 73: journal.v1.JournalService.DeleteTag:output_type -> journal.v1.DeleteTagResponse
+	37, // 74: journal.v1.JournalService.ListTrashedEntries:output_type -> journal.v1.ListTrashedEntriesResponse
+	39, // 75: journal.v1.JournalService.RestoreJournalEntry:output_type -> journal.v1.RestoreJournalEntryResponse
+	41, // 76: journal.v1.JournalService.PurgeTrash:output_type -> journal.v1.PurgeTrashResponse
+	43, // 77: journal.v1.JournalService.ListJournalEntryRevisions:output_type -> journal.v1.ListJournalEntryRevisionsResponse
+	45, // 78: journal.v1.JournalService.SearchJournalEntryRevisions:output_type -> journal.v1.SearchJournalEntryRevisionsResponse
+	47, // 79: journal.v1.JournalService.RestoreJournalEntryRevision:output_type -> journal.v1.RestoreJournalEntryRevisionResponse
+	49, // 80: journal.v1.JournalService.ExportJournal:output_type -> journal.v1.ExportJournalResponse
+	52, // 81: journal.v1.JournalService.ImportJournal:output_type -> journal.v1.ImportJournalResponse
+	54, // 82: journal.v1.JournalService.ImportDayOne:output_type -> journal.v1.ImportDayOneResponse
+	56, // 83: journal.v1.JournalService.VerifyArchive:output_type -> journal.v1.VerifyArchiveResponse
+	58, // 84: journal.v1.JournalService.PushBackup:output_type -> journal.v1.PushBackupResponse
+	60, // 85: journal.v1.JournalService.SuggestTitle:output_type -> journal.v1.SuggestTitleResponse
+	62, // [62:86] is the sub-list for method output_type
+	38, // [38:62] is the sub-list for method input_type
+	38, // [38:38] is the sub-list for extension type_name
+	38, // [38:38] is the sub-list for extension extendee
+	0,  // [0:38] is the sub-list for field type_name
 }
 
 func init() { file_journal_v1_journal_proto_init() }
@@ -602,18 +3859,25 @@ func file_journal_v1_journal_proto_init() {
 	if File_journal_v1_journal_proto != nil {
 		return
 	}
+	file_journal_v1_journal_proto_msgTypes[4].OneofWrappers = []any{
+		(*Section_Text)(nil),
+		(*Section_Checklist)(nil),
+		(*Section_Rating)(nil),
+		(*Section_Photo)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_journal_v1_journal_proto_rawDesc), len(file_journal_v1_journal_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   9,
+			NumEnums:      1,
+			NumMessages:   60,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_journal_v1_journal_proto_goTypes,
 		DependencyIndexes: file_journal_v1_journal_proto_depIdxs,
+		EnumInfos:         file_journal_v1_journal_proto_enumTypes,
 		MessageInfos:      file_journal_v1_journal_proto_msgTypes,
 	}.Build()
 	File_journal_v1_journal_proto = out.File
@@ -19,26 +19,100 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	JournalService_CreateJournalEntry_FullMethodName = "/journal.v1.JournalService/CreateJournalEntry"
-	JournalService_UpdateJournalEntry_FullMethodName = "/journal.v1.JournalService/UpdateJournalEntry"
-	JournalService_DeleteJournalEntry_FullMethodName = "/journal.v1.JournalService/DeleteJournalEntry"
-	JournalService_ListJournalEntries_FullMethodName = "/journal.v1.JournalService/ListJournalEntries"
+	JournalService_CreateJournalEntry_FullMethodName          = "/journal.v1.JournalService/CreateJournalEntry"
+	JournalService_GetJournalEntry_FullMethodName             = "/journal.v1.JournalService/GetJournalEntry"
+	JournalService_UpdateJournalEntry_FullMethodName          = "/journal.v1.JournalService/UpdateJournalEntry"
+	JournalService_DeleteJournalEntry_FullMethodName          = "/journal.v1.JournalService/DeleteJournalEntry"
+	JournalService_BatchCreateJournalEntries_FullMethodName   = "/journal.v1.JournalService/BatchCreateJournalEntries"
+	JournalService_BatchGetJournalEntries_FullMethodName      = "/journal.v1.JournalService/BatchGetJournalEntries"
+	JournalService_BatchDeleteJournalEntries_FullMethodName   = "/journal.v1.JournalService/BatchDeleteJournalEntries"
+	JournalService_ListJournalEntries_FullMethodName          = "/journal.v1.JournalService/ListJournalEntries"
+	JournalService_SearchJournalEntries_FullMethodName        = "/journal.v1.JournalService/SearchJournalEntries"
+	JournalService_ListTags_FullMethodName                    = "/journal.v1.JournalService/ListTags"
+	JournalService_RenameTag_FullMethodName                   = "/journal.v1.JournalService/RenameTag"
+	JournalService_DeleteTag_FullMethodName                   = "/journal.v1.JournalService/DeleteTag"
+	JournalService_ListTrashedEntries_FullMethodName          = "/journal.v1.JournalService/ListTrashedEntries"
+	JournalService_RestoreJournalEntry_FullMethodName         = "/journal.v1.JournalService/RestoreJournalEntry"
+	JournalService_PurgeTrash_FullMethodName                  = "/journal.v1.JournalService/PurgeTrash"
+	JournalService_ListJournalEntryRevisions_FullMethodName   = "/journal.v1.JournalService/ListJournalEntryRevisions"
+	JournalService_SearchJournalEntryRevisions_FullMethodName = "/journal.v1.JournalService/SearchJournalEntryRevisions"
+	JournalService_RestoreJournalEntryRevision_FullMethodName = "/journal.v1.JournalService/RestoreJournalEntryRevision"
+	JournalService_ExportJournal_FullMethodName               = "/journal.v1.JournalService/ExportJournal"
+	JournalService_ImportJournal_FullMethodName               = "/journal.v1.JournalService/ImportJournal"
+	JournalService_ImportDayOne_FullMethodName                = "/journal.v1.JournalService/ImportDayOne"
+	JournalService_VerifyArchive_FullMethodName               = "/journal.v1.JournalService/VerifyArchive"
+	JournalService_PushBackup_FullMethodName                  = "/journal.v1.JournalService/PushBackup"
+	JournalService_SuggestTitle_FullMethodName                = "/journal.v1.JournalService/SuggestTitle"
 )
 
 // JournalServiceClient is the client API for JournalService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 //
-// JournalService provides operations for managing journal entries
+// JournalService provides operations for managing journal entries.
+// Writes are read-after-write consistent: an entry returned by
+// CreateJournalEntry or UpdateJournalEntry is visible to every read that
+// starts after the write returns.
 type JournalServiceClient interface {
 	// CreateJournalEntry creates a new journal entry
 	CreateJournalEntry(ctx context.Context, in *CreateJournalEntryRequest, opts ...grpc.CallOption) (*CreateJournalEntryResponse, error)
+	// GetJournalEntry returns a single journal entry by ID
+	GetJournalEntry(ctx context.Context, in *GetJournalEntryRequest, opts ...grpc.CallOption) (*GetJournalEntryResponse, error)
 	// UpdateJournalEntry updates an existing journal entry
 	UpdateJournalEntry(ctx context.Context, in *UpdateJournalEntryRequest, opts ...grpc.CallOption) (*UpdateJournalEntryResponse, error)
-	// DeleteJournalEntry deletes a journal entry
+	// DeleteJournalEntry moves a journal entry to the trash, or removes it
+	// permanently if requested
 	DeleteJournalEntry(ctx context.Context, in *DeleteJournalEntryRequest, opts ...grpc.CallOption) (*DeleteJournalEntryResponse, error)
+	// BatchCreateJournalEntries creates up to 500 entries in one transaction.
+	// An invalid entry fails on its own; the others are still created
+	BatchCreateJournalEntries(ctx context.Context, in *BatchCreateJournalEntriesRequest, opts ...grpc.CallOption) (*BatchCreateJournalEntriesResponse, error)
+	// BatchGetJournalEntries returns up to 500 entries by ID, read from one
+	// snapshot of the journal
+	BatchGetJournalEntries(ctx context.Context, in *BatchGetJournalEntriesRequest, opts ...grpc.CallOption) (*BatchGetJournalEntriesResponse, error)
+	// BatchDeleteJournalEntries deletes up to 500 entries in one transaction.
+	// A missing entry fails on its own; the others are still deleted
+	BatchDeleteJournalEntries(ctx context.Context, in *BatchDeleteJournalEntriesRequest, opts ...grpc.CallOption) (*BatchDeleteJournalEntriesResponse, error)
 	// ListJournalEntries returns paginated journal entries sorted by date descending
 	ListJournalEntries(ctx context.Context, in *ListJournalEntriesRequest, opts ...grpc.CallOption) (*ListJournalEntriesResponse, error)
+	// SearchJournalEntries returns entries matching a full-text query, best match first.
+	// Sealed entries are not searchable until they are revealed.
+	SearchJournalEntries(ctx context.Context, in *SearchJournalEntriesRequest, opts ...grpc.CallOption) (*SearchJournalEntriesResponse, error)
+	// ListTags returns every tag with the number of entries that use it
+	ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error)
+	// RenameTag renames a tag on every entry, merging it into new_name if that tag exists
+	RenameTag(ctx context.Context, in *RenameTagRequest, opts ...grpc.CallOption) (*RenameTagResponse, error)
+	// DeleteTag removes a tag from every entry
+	DeleteTag(ctx context.Context, in *DeleteTagRequest, opts ...grpc.CallOption) (*DeleteTagResponse, error)
+	// ListTrashedEntries returns paginated entries in the trash, most recently deleted first
+	ListTrashedEntries(ctx context.Context, in *ListTrashedEntriesRequest, opts ...grpc.CallOption) (*ListTrashedEntriesResponse, error)
+	// RestoreJournalEntry moves a journal entry out of the trash
+	RestoreJournalEntry(ctx context.Context, in *RestoreJournalEntryRequest, opts ...grpc.CallOption) (*RestoreJournalEntryResponse, error)
+	// PurgeTrash permanently removes entries in the trash
+	PurgeTrash(ctx context.Context, in *PurgeTrashRequest, opts ...grpc.CallOption) (*PurgeTrashResponse, error)
+	// ListJournalEntryRevisions returns the previous versions of an entry, newest first
+	ListJournalEntryRevisions(ctx context.Context, in *ListJournalEntryRevisionsRequest, opts ...grpc.CallOption) (*ListJournalEntryRevisionsResponse, error)
+	// SearchJournalEntryRevisions searches the previous versions of an entry,
+	// best match first
+	SearchJournalEntryRevisions(ctx context.Context, in *SearchJournalEntryRevisionsRequest, opts ...grpc.CallOption) (*SearchJournalEntryRevisionsResponse, error)
+	// RestoreJournalEntryRevision rolls an entry back to a revision; the
+	// replaced version becomes a new revision
+	RestoreJournalEntryRevision(ctx context.Context, in *RestoreJournalEntryRevisionRequest, opts ...grpc.CallOption) (*RestoreJournalEntryRevisionResponse, error)
+	// ExportJournal streams every entry not in the trash, oldest first, in the
+	// chosen format
+	ExportJournal(ctx context.Context, in *ExportJournalRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportJournalResponse], error)
+	// ImportJournal inserts batches of entries from another journal, keeping
+	// their original timestamps and skipping entries already imported
+	ImportJournal(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportJournalRequest, ImportJournalResponse], error)
+	// ImportDayOne imports the entries of a Day One JSON export, skipping
+	// entries already imported
+	ImportDayOne(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportDayOneRequest, ImportDayOneResponse], error)
+	// VerifyArchive checks the hash-chained entry archive and that archived
+	// entries have not been altered outside of the API
+	VerifyArchive(ctx context.Context, in *VerifyArchiveRequest, opts ...grpc.CallOption) (*VerifyArchiveResponse, error)
+	// PushBackup pushes the journal's git repository to the configured remote
+	PushBackup(ctx context.Context, in *PushBackupRequest, opts ...grpc.CallOption) (*PushBackupResponse, error)
+	// SuggestTitle suggests a title derived from entry content
+	SuggestTitle(ctx context.Context, in *SuggestTitleRequest, opts ...grpc.CallOption) (*SuggestTitleResponse, error)
 }
 
 type journalServiceClient struct {
@@ -59,6 +133,16 @@ func (c *journalServiceClient) CreateJournalEntry(ctx context.Context, in *Creat
 	return out, nil
 }
 
+func (c *journalServiceClient) GetJournalEntry(ctx context.Context, in *GetJournalEntryRequest, opts ...grpc.CallOption) (*GetJournalEntryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetJournalEntryResponse)
+	err := c.cc.Invoke(ctx, JournalService_GetJournalEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *journalServiceClient) UpdateJournalEntry(ctx context.Context, in *UpdateJournalEntryRequest, opts ...grpc.CallOption) (*UpdateJournalEntryResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateJournalEntryResponse)
@@ -79,6 +163,36 @@ func (c *journalServiceClient) DeleteJournalEntry(ctx context.Context, in *Delet
 	return out, nil
 }
 
+func (c *journalServiceClient) BatchCreateJournalEntries(ctx context.Context, in *BatchCreateJournalEntriesRequest, opts ...grpc.CallOption) (*BatchCreateJournalEntriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCreateJournalEntriesResponse)
+	err := c.cc.Invoke(ctx, JournalService_BatchCreateJournalEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journalServiceClient) BatchGetJournalEntries(ctx context.Context, in *BatchGetJournalEntriesRequest, opts ...grpc.CallOption) (*BatchGetJournalEntriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetJournalEntriesResponse)
+	err := c.cc.Invoke(ctx, JournalService_BatchGetJournalEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journalServiceClient) BatchDeleteJournalEntries(ctx context.Context, in *BatchDeleteJournalEntriesRequest, opts ...grpc.CallOption) (*BatchDeleteJournalEntriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchDeleteJournalEntriesResponse)
+	err := c.cc.Invoke(ctx, JournalService_BatchDeleteJournalEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *journalServiceClient) ListJournalEntries(ctx context.Context, in *ListJournalEntriesRequest, opts ...grpc.CallOption) (*ListJournalEntriesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListJournalEntriesResponse)
@@ -89,20 +203,249 @@ func (c *journalServiceClient) ListJournalEntries(ctx context.Context, in *ListJ
 	return out, nil
 }
 
+func (c *journalServiceClient) SearchJournalEntries(ctx context.Context, in *SearchJournalEntriesRequest, opts ...grpc.CallOption) (*SearchJournalEntriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchJournalEntriesResponse)
+	err := c.cc.Invoke(ctx, JournalService_SearchJournalEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journalServiceClient) ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTagsResponse)
+	err := c.cc.Invoke(ctx, JournalService_ListTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journalServiceClient) RenameTag(ctx context.Context, in *RenameTagRequest, opts ...grpc.CallOption) (*RenameTagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenameTagResponse)
+	err := c.cc.Invoke(ctx, JournalService_RenameTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journalServiceClient) DeleteTag(ctx context.Context, in *DeleteTagRequest, opts ...grpc.CallOption) (*DeleteTagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTagResponse)
+	err := c.cc.Invoke(ctx, JournalService_DeleteTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journalServiceClient) ListTrashedEntries(ctx context.Context, in *ListTrashedEntriesRequest, opts ...grpc.CallOption) (*ListTrashedEntriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTrashedEntriesResponse)
+	err := c.cc.Invoke(ctx, JournalService_ListTrashedEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journalServiceClient) RestoreJournalEntry(ctx context.Context, in *RestoreJournalEntryRequest, opts ...grpc.CallOption) (*RestoreJournalEntryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreJournalEntryResponse)
+	err := c.cc.Invoke(ctx, JournalService_RestoreJournalEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journalServiceClient) PurgeTrash(ctx context.Context, in *PurgeTrashRequest, opts ...grpc.CallOption) (*PurgeTrashResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeTrashResponse)
+	err := c.cc.Invoke(ctx, JournalService_PurgeTrash_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journalServiceClient) ListJournalEntryRevisions(ctx context.Context, in *ListJournalEntryRevisionsRequest, opts ...grpc.CallOption) (*ListJournalEntryRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListJournalEntryRevisionsResponse)
+	err := c.cc.Invoke(ctx, JournalService_ListJournalEntryRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journalServiceClient) SearchJournalEntryRevisions(ctx context.Context, in *SearchJournalEntryRevisionsRequest, opts ...grpc.CallOption) (*SearchJournalEntryRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchJournalEntryRevisionsResponse)
+	err := c.cc.Invoke(ctx, JournalService_SearchJournalEntryRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journalServiceClient) RestoreJournalEntryRevision(ctx context.Context, in *RestoreJournalEntryRevisionRequest, opts ...grpc.CallOption) (*RestoreJournalEntryRevisionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreJournalEntryRevisionResponse)
+	err := c.cc.Invoke(ctx, JournalService_RestoreJournalEntryRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journalServiceClient) ExportJournal(ctx context.Context, in *ExportJournalRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportJournalResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &JournalService_ServiceDesc.Streams[0], JournalService_ExportJournal_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportJournalRequest, ExportJournalResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type JournalService_ExportJournalClient = grpc.ServerStreamingClient[ExportJournalResponse]
+
+func (c *journalServiceClient) ImportJournal(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportJournalRequest, ImportJournalResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &JournalService_ServiceDesc.Streams[1], JournalService_ImportJournal_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportJournalRequest, ImportJournalResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type JournalService_ImportJournalClient = grpc.ClientStreamingClient[ImportJournalRequest, ImportJournalResponse]
+
+func (c *journalServiceClient) ImportDayOne(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportDayOneRequest, ImportDayOneResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &JournalService_ServiceDesc.Streams[2], JournalService_ImportDayOne_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportDayOneRequest, ImportDayOneResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type JournalService_ImportDayOneClient = grpc.ClientStreamingClient[ImportDayOneRequest, ImportDayOneResponse]
+
+func (c *journalServiceClient) VerifyArchive(ctx context.Context, in *VerifyArchiveRequest, opts ...grpc.CallOption) (*VerifyArchiveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyArchiveResponse)
+	err := c.cc.Invoke(ctx, JournalService_VerifyArchive_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journalServiceClient) PushBackup(ctx context.Context, in *PushBackupRequest, opts ...grpc.CallOption) (*PushBackupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PushBackupResponse)
+	err := c.cc.Invoke(ctx, JournalService_PushBackup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journalServiceClient) SuggestTitle(ctx context.Context, in *SuggestTitleRequest, opts ...grpc.CallOption) (*SuggestTitleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestTitleResponse)
+	err := c.cc.Invoke(ctx, JournalService_SuggestTitle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // JournalServiceServer is the server API for JournalService service.
 // All implementations must embed UnimplementedJournalServiceServer
 // for forward compatibility.
 //
-// JournalService provides operations for managing journal entries
+// JournalService provides operations for managing journal entries.
+// Writes are read-after-write consistent: an entry returned by
+// CreateJournalEntry or UpdateJournalEntry is visible to every read that
+// starts after the write returns.
 type JournalServiceServer interface {
 	// CreateJournalEntry creates a new journal entry
 	CreateJournalEntry(context.Context, *CreateJournalEntryRequest) (*CreateJournalEntryResponse, error)
+	// GetJournalEntry returns a single journal entry by ID
+	GetJournalEntry(context.Context, *GetJournalEntryRequest) (*GetJournalEntryResponse, error)
 	// UpdateJournalEntry updates an existing journal entry
 	UpdateJournalEntry(context.Context, *UpdateJournalEntryRequest) (*UpdateJournalEntryResponse, error)
-	// DeleteJournalEntry deletes a journal entry
+	// DeleteJournalEntry moves a journal entry to the trash, or removes it
+	// permanently if requested
 	DeleteJournalEntry(context.Context, *DeleteJournalEntryRequest) (*DeleteJournalEntryResponse, error)
+	// BatchCreateJournalEntries creates up to 500 entries in one transaction.
+	// An invalid entry fails on its own; the others are still created
+	BatchCreateJournalEntries(context.Context, *BatchCreateJournalEntriesRequest) (*BatchCreateJournalEntriesResponse, error)
+	// BatchGetJournalEntries returns up to 500 entries by ID, read from one
+	// snapshot of the journal
+	BatchGetJournalEntries(context.Context, *BatchGetJournalEntriesRequest) (*BatchGetJournalEntriesResponse, error)
+	// BatchDeleteJournalEntries deletes up to 500 entries in one transaction.
+	// A missing entry fails on its own; the others are still deleted
+	BatchDeleteJournalEntries(context.Context, *BatchDeleteJournalEntriesRequest) (*BatchDeleteJournalEntriesResponse, error)
 	// ListJournalEntries returns paginated journal entries sorted by date descending
 	ListJournalEntries(context.Context, *ListJournalEntriesRequest) (*ListJournalEntriesResponse, error)
+	// SearchJournalEntries returns entries matching a full-text query, best match first.
+	// Sealed entries are not searchable until they are revealed.
+	SearchJournalEntries(context.Context, *SearchJournalEntriesRequest) (*SearchJournalEntriesResponse, error)
+	// ListTags returns every tag with the number of entries that use it
+	ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error)
+	// RenameTag renames a tag on every entry, merging it into new_name if that tag exists
+	RenameTag(context.Context, *RenameTagRequest) (*RenameTagResponse, error)
+	// DeleteTag removes a tag from every entry
+	DeleteTag(context.Context, *DeleteTagRequest) (*DeleteTagResponse, error)
+	// ListTrashedEntries returns paginated entries in the trash, most recently deleted first
+	ListTrashedEntries(context.Context, *ListTrashedEntriesRequest) (*ListTrashedEntriesResponse, error)
+	// RestoreJournalEntry moves a journal entry out of the trash
+	RestoreJournalEntry(context.Context, *RestoreJournalEntryRequest) (*RestoreJournalEntryResponse, error)
+	// PurgeTrash permanently removes entries in the trash
+	PurgeTrash(context.Context, *PurgeTrashRequest) (*PurgeTrashResponse, error)
+	// ListJournalEntryRevisions returns the previous versions of an entry, newest first
+	ListJournalEntryRevisions(context.Context, *ListJournalEntryRevisionsRequest) (*ListJournalEntryRevisionsResponse, error)
+	// SearchJournalEntryRevisions searches the previous versions of an entry,
+	// best match first
+	SearchJournalEntryRevisions(context.Context, *SearchJournalEntryRevisionsRequest) (*SearchJournalEntryRevisionsResponse, error)
+	// RestoreJournalEntryRevision rolls an entry back to a revision; the
+	// replaced version becomes a new revision
+	RestoreJournalEntryRevision(context.Context, *RestoreJournalEntryRevisionRequest) (*RestoreJournalEntryRevisionResponse, error)
+	// ExportJournal streams every entry not in the trash, oldest first, in the
+	// chosen format
+	ExportJournal(*ExportJournalRequest, grpc.ServerStreamingServer[ExportJournalResponse]) error
+	// ImportJournal inserts batches of entries from another journal, keeping
+	// their original timestamps and skipping entries already imported
+	ImportJournal(grpc.ClientStreamingServer[ImportJournalRequest, ImportJournalResponse]) error
+	// ImportDayOne imports the entries of a Day One JSON export, skipping
+	// entries already imported
+	ImportDayOne(grpc.ClientStreamingServer[ImportDayOneRequest, ImportDayOneResponse]) error
+	// VerifyArchive checks the hash-chained entry archive and that archived
+	// entries have not been altered outside of the API
+	VerifyArchive(context.Context, *VerifyArchiveRequest) (*VerifyArchiveResponse, error)
+	// PushBackup pushes the journal's git repository to the configured remote
+	PushBackup(context.Context, *PushBackupRequest) (*PushBackupResponse, error)
+	// SuggestTitle suggests a title derived from entry content
+	SuggestTitle(context.Context, *SuggestTitleRequest) (*SuggestTitleResponse, error)
 	mustEmbedUnimplementedJournalServiceServer()
 }
 
@@ -116,15 +459,75 @@ type UnimplementedJournalServiceServer struct{}
 func (UnimplementedJournalServiceServer) CreateJournalEntry(context.Context, *CreateJournalEntryRequest) (*CreateJournalEntryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateJournalEntry not implemented")
 }
+func (UnimplementedJournalServiceServer) GetJournalEntry(context.Context, *GetJournalEntryRequest) (*GetJournalEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJournalEntry not implemented")
+}
 func (UnimplementedJournalServiceServer) UpdateJournalEntry(context.Context, *UpdateJournalEntryRequest) (*UpdateJournalEntryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateJournalEntry not implemented")
 }
 func (UnimplementedJournalServiceServer) DeleteJournalEntry(context.Context, *DeleteJournalEntryRequest) (*DeleteJournalEntryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteJournalEntry not implemented")
 }
+func (UnimplementedJournalServiceServer) BatchCreateJournalEntries(context.Context, *BatchCreateJournalEntriesRequest) (*BatchCreateJournalEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateJournalEntries not implemented")
+}
+func (UnimplementedJournalServiceServer) BatchGetJournalEntries(context.Context, *BatchGetJournalEntriesRequest) (*BatchGetJournalEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetJournalEntries not implemented")
+}
+func (UnimplementedJournalServiceServer) BatchDeleteJournalEntries(context.Context, *BatchDeleteJournalEntriesRequest) (*BatchDeleteJournalEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteJournalEntries not implemented")
+}
 func (UnimplementedJournalServiceServer) ListJournalEntries(context.Context, *ListJournalEntriesRequest) (*ListJournalEntriesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListJournalEntries not implemented")
 }
+func (UnimplementedJournalServiceServer) SearchJournalEntries(context.Context, *SearchJournalEntriesRequest) (*SearchJournalEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchJournalEntries not implemented")
+}
+func (UnimplementedJournalServiceServer) ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTags not implemented")
+}
+func (UnimplementedJournalServiceServer) RenameTag(context.Context, *RenameTagRequest) (*RenameTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameTag not implemented")
+}
+func (UnimplementedJournalServiceServer) DeleteTag(context.Context, *DeleteTagRequest) (*DeleteTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTag not implemented")
+}
+func (UnimplementedJournalServiceServer) ListTrashedEntries(context.Context, *ListTrashedEntriesRequest) (*ListTrashedEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrashedEntries not implemented")
+}
+func (UnimplementedJournalServiceServer) RestoreJournalEntry(context.Context, *RestoreJournalEntryRequest) (*RestoreJournalEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreJournalEntry not implemented")
+}
+func (UnimplementedJournalServiceServer) PurgeTrash(context.Context, *PurgeTrashRequest) (*PurgeTrashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeTrash not implemented")
+}
+func (UnimplementedJournalServiceServer) ListJournalEntryRevisions(context.Context, *ListJournalEntryRevisionsRequest) (*ListJournalEntryRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJournalEntryRevisions not implemented")
+}
+func (UnimplementedJournalServiceServer) SearchJournalEntryRevisions(context.Context, *SearchJournalEntryRevisionsRequest) (*SearchJournalEntryRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchJournalEntryRevisions not implemented")
+}
+func (UnimplementedJournalServiceServer) RestoreJournalEntryRevision(context.Context, *RestoreJournalEntryRevisionRequest) (*RestoreJournalEntryRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreJournalEntryRevision not implemented")
+}
+func (UnimplementedJournalServiceServer) ExportJournal(*ExportJournalRequest, grpc.ServerStreamingServer[ExportJournalResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportJournal not implemented")
+}
+func (UnimplementedJournalServiceServer) ImportJournal(grpc.ClientStreamingServer[ImportJournalRequest, ImportJournalResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportJournal not implemented")
+}
+func (UnimplementedJournalServiceServer) ImportDayOne(grpc.ClientStreamingServer[ImportDayOneRequest, ImportDayOneResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportDayOne not implemented")
+}
+func (UnimplementedJournalServiceServer) VerifyArchive(context.Context, *VerifyArchiveRequest) (*VerifyArchiveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyArchive not implemented")
+}
+func (UnimplementedJournalServiceServer) PushBackup(context.Context, *PushBackupRequest) (*PushBackupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushBackup not implemented")
+}
+func (UnimplementedJournalServiceServer) SuggestTitle(context.Context, *SuggestTitleRequest) (*SuggestTitleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestTitle not implemented")
+}
 func (UnimplementedJournalServiceServer) mustEmbedUnimplementedJournalServiceServer() {}
 func (UnimplementedJournalServiceServer) testEmbeddedByValue()                        {}
 
@@ -164,6 +567,24 @@ func _JournalService_CreateJournalEntry_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _JournalService_GetJournalEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJournalEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JournalServiceServer).GetJournalEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JournalService_GetJournalEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JournalServiceServer).GetJournalEntry(ctx, req.(*GetJournalEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _JournalService_UpdateJournalEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateJournalEntryRequest)
 	if err := dec(in); err != nil {
@@ -200,6 +621,60 @@ func _JournalService_DeleteJournalEntry_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _JournalService_BatchCreateJournalEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateJournalEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JournalServiceServer).BatchCreateJournalEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JournalService_BatchCreateJournalEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JournalServiceServer).BatchCreateJournalEntries(ctx, req.(*BatchCreateJournalEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JournalService_BatchGetJournalEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetJournalEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JournalServiceServer).BatchGetJournalEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JournalService_BatchGetJournalEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JournalServiceServer).BatchGetJournalEntries(ctx, req.(*BatchGetJournalEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JournalService_BatchDeleteJournalEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteJournalEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JournalServiceServer).BatchDeleteJournalEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JournalService_BatchDeleteJournalEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JournalServiceServer).BatchDeleteJournalEntries(ctx, req.(*BatchDeleteJournalEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _JournalService_ListJournalEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListJournalEntriesRequest)
 	if err := dec(in); err != nil {
@@ -218,6 +693,265 @@ func _JournalService_ListJournalEntries_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _JournalService_SearchJournalEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchJournalEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JournalServiceServer).SearchJournalEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JournalService_SearchJournalEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JournalServiceServer).SearchJournalEntries(ctx, req.(*SearchJournalEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JournalService_ListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JournalServiceServer).ListTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JournalService_ListTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JournalServiceServer).ListTags(ctx, req.(*ListTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JournalService_RenameTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JournalServiceServer).RenameTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JournalService_RenameTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JournalServiceServer).RenameTag(ctx, req.(*RenameTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JournalService_DeleteTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JournalServiceServer).DeleteTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JournalService_DeleteTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JournalServiceServer).DeleteTag(ctx, req.(*DeleteTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JournalService_ListTrashedEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrashedEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JournalServiceServer).ListTrashedEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JournalService_ListTrashedEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JournalServiceServer).ListTrashedEntries(ctx, req.(*ListTrashedEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JournalService_RestoreJournalEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreJournalEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JournalServiceServer).RestoreJournalEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JournalService_RestoreJournalEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JournalServiceServer).RestoreJournalEntry(ctx, req.(*RestoreJournalEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JournalService_PurgeTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JournalServiceServer).PurgeTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JournalService_PurgeTrash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JournalServiceServer).PurgeTrash(ctx, req.(*PurgeTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JournalService_ListJournalEntryRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJournalEntryRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JournalServiceServer).ListJournalEntryRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JournalService_ListJournalEntryRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JournalServiceServer).ListJournalEntryRevisions(ctx, req.(*ListJournalEntryRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JournalService_SearchJournalEntryRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchJournalEntryRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JournalServiceServer).SearchJournalEntryRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JournalService_SearchJournalEntryRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JournalServiceServer).SearchJournalEntryRevisions(ctx, req.(*SearchJournalEntryRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JournalService_RestoreJournalEntryRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreJournalEntryRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JournalServiceServer).RestoreJournalEntryRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JournalService_RestoreJournalEntryRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JournalServiceServer).RestoreJournalEntryRevision(ctx, req.(*RestoreJournalEntryRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JournalService_ExportJournal_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportJournalRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(JournalServiceServer).ExportJournal(m, &grpc.GenericServerStream[ExportJournalRequest, ExportJournalResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type JournalService_ExportJournalServer = grpc.ServerStreamingServer[ExportJournalResponse]
+
+func _JournalService_ImportJournal_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(JournalServiceServer).ImportJournal(&grpc.GenericServerStream[ImportJournalRequest, ImportJournalResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type JournalService_ImportJournalServer = grpc.ClientStreamingServer[ImportJournalRequest, ImportJournalResponse]
+
+func _JournalService_ImportDayOne_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(JournalServiceServer).ImportDayOne(&grpc.GenericServerStream[ImportDayOneRequest, ImportDayOneResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type JournalService_ImportDayOneServer = grpc.ClientStreamingServer[ImportDayOneRequest, ImportDayOneResponse]
+
+func _JournalService_VerifyArchive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyArchiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JournalServiceServer).VerifyArchive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JournalService_VerifyArchive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JournalServiceServer).VerifyArchive(ctx, req.(*VerifyArchiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JournalService_PushBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushBackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JournalServiceServer).PushBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JournalService_PushBackup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JournalServiceServer).PushBackup(ctx, req.(*PushBackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JournalService_SuggestTitle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestTitleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JournalServiceServer).SuggestTitle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JournalService_SuggestTitle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JournalServiceServer).SuggestTitle(ctx, req.(*SuggestTitleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // JournalService_ServiceDesc is the grpc.ServiceDesc for JournalService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -229,6 +963,10 @@ var JournalService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateJournalEntry",
 			Handler:    _JournalService_CreateJournalEntry_Handler,
 		},
+		{
+			MethodName: "GetJournalEntry",
+			Handler:    _JournalService_GetJournalEntry_Handler,
+		},
 		{
 			MethodName: "UpdateJournalEntry",
 			Handler:    _JournalService_UpdateJournalEntry_Handler,
@@ -237,11 +975,91 @@ var JournalService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteJournalEntry",
 			Handler:    _JournalService_DeleteJournalEntry_Handler,
 		},
+		{
+			MethodName: "BatchCreateJournalEntries",
+			Handler:    _JournalService_BatchCreateJournalEntries_Handler,
+		},
+		{
+			MethodName: "BatchGetJournalEntries",
+			Handler:    _JournalService_BatchGetJournalEntries_Handler,
+		},
+		{
+			MethodName: "BatchDeleteJournalEntries",
+			Handler:    _JournalService_BatchDeleteJournalEntries_Handler,
+		},
 		{
 			MethodName: "ListJournalEntries",
 			Handler:    _JournalService_ListJournalEntries_Handler,
 		},
+		{
+			MethodName: "SearchJournalEntries",
+			Handler:    _JournalService_SearchJournalEntries_Handler,
+		},
+		{
+			MethodName: "ListTags",
+			Handler:    _JournalService_ListTags_Handler,
+		},
+		{
+			MethodName: "RenameTag",
+			Handler:    _JournalService_RenameTag_Handler,
+		},
+		{
+			MethodName: "DeleteTag",
+			Handler:    _JournalService_DeleteTag_Handler,
+		},
+		{
+			MethodName: "ListTrashedEntries",
+			Handler:    _JournalService_ListTrashedEntries_Handler,
+		},
+		{
+			MethodName: "RestoreJournalEntry",
+			Handler:    _JournalService_RestoreJournalEntry_Handler,
+		},
+		{
+			MethodName: "PurgeTrash",
+			Handler:    _JournalService_PurgeTrash_Handler,
+		},
+		{
+			MethodName: "ListJournalEntryRevisions",
+			Handler:    _JournalService_ListJournalEntryRevisions_Handler,
+		},
+		{
+			MethodName: "SearchJournalEntryRevisions",
+			Handler:    _JournalService_SearchJournalEntryRevisions_Handler,
+		},
+		{
+			MethodName: "RestoreJournalEntryRevision",
+			Handler:    _JournalService_RestoreJournalEntryRevision_Handler,
+		},
+		{
+			MethodName: "VerifyArchive",
+			Handler:    _JournalService_VerifyArchive_Handler,
+		},
+		{
+			MethodName: "PushBackup",
+			Handler:    _JournalService_PushBackup_Handler,
+		},
+		{
+			MethodName: "SuggestTitle",
+			Handler:    _JournalService_SuggestTitle_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportJournal",
+			Handler:       _JournalService_ExportJournal_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportJournal",
+			Handler:       _JournalService_ImportJournal_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ImportDayOne",
+			Handler:       _JournalService_ImportDayOne_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "journal/v1/journal.proto",
 }
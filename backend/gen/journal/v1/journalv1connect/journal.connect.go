@@ -0,0 +1,929 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: journal/v1/journal.proto
+
+package journalv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/parkernilson/micro-journal/gen/journal/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// JournalServiceName is the fully-qualified name of the JournalService service.
+	JournalServiceName = "journal.v1.JournalService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// JournalServiceCreateJournalEntryProcedure is the fully-qualified name of the JournalService's
+	// CreateJournalEntry RPC.
+	JournalServiceCreateJournalEntryProcedure = "/journal.v1.JournalService/CreateJournalEntry"
+	// JournalServiceGetJournalEntryProcedure is the fully-qualified name of the JournalService's
+	// GetJournalEntry RPC.
+	JournalServiceGetJournalEntryProcedure = "/journal.v1.JournalService/GetJournalEntry"
+	// JournalServiceUpdateJournalEntryProcedure is the fully-qualified name of the JournalService's
+	// UpdateJournalEntry RPC.
+	JournalServiceUpdateJournalEntryProcedure = "/journal.v1.JournalService/UpdateJournalEntry"
+	// JournalServiceDeleteJournalEntryProcedure is the fully-qualified name of the JournalService's
+	// DeleteJournalEntry RPC.
+	JournalServiceDeleteJournalEntryProcedure = "/journal.v1.JournalService/DeleteJournalEntry"
+	// JournalServiceBatchCreateJournalEntriesProcedure is the fully-qualified name of the
+	// JournalService's BatchCreateJournalEntries RPC.
+	JournalServiceBatchCreateJournalEntriesProcedure = "/journal.v1.JournalService/BatchCreateJournalEntries"
+	// JournalServiceBatchGetJournalEntriesProcedure is the fully-qualified name of the JournalService's
+	// BatchGetJournalEntries RPC.
+	JournalServiceBatchGetJournalEntriesProcedure = "/journal.v1.JournalService/BatchGetJournalEntries"
+	// JournalServiceBatchDeleteJournalEntriesProcedure is the fully-qualified name of the
+	// JournalService's BatchDeleteJournalEntries RPC.
+	JournalServiceBatchDeleteJournalEntriesProcedure = "/journal.v1.JournalService/BatchDeleteJournalEntries"
+	// JournalServiceListJournalEntriesProcedure is the fully-qualified name of the JournalService's
+	// ListJournalEntries RPC.
+	JournalServiceListJournalEntriesProcedure = "/journal.v1.JournalService/ListJournalEntries"
+	// JournalServiceSearchJournalEntriesProcedure is the fully-qualified name of the JournalService's
+	// SearchJournalEntries RPC.
+	JournalServiceSearchJournalEntriesProcedure = "/journal.v1.JournalService/SearchJournalEntries"
+	// JournalServiceListTagsProcedure is the fully-qualified name of the JournalService's ListTags RPC.
+	JournalServiceListTagsProcedure = "/journal.v1.JournalService/ListTags"
+	// JournalServiceRenameTagProcedure is the fully-qualified name of the JournalService's RenameTag
+	// RPC.
+	JournalServiceRenameTagProcedure = "/journal.v1.JournalService/RenameTag"
+	// JournalServiceDeleteTagProcedure is the fully-qualified name of the JournalService's DeleteTag
+	// RPC.
+	JournalServiceDeleteTagProcedure = "/journal.v1.JournalService/DeleteTag"
+	// JournalServiceListTrashedEntriesProcedure is the fully-qualified name of the JournalService's
+	// ListTrashedEntries RPC.
+	JournalServiceListTrashedEntriesProcedure = "/journal.v1.JournalService/ListTrashedEntries"
+	// JournalServiceRestoreJournalEntryProcedure is the fully-qualified name of the JournalService's
+	// RestoreJournalEntry RPC.
+	JournalServiceRestoreJournalEntryProcedure = "/journal.v1.JournalService/RestoreJournalEntry"
+	// JournalServicePurgeTrashProcedure is the fully-qualified name of the JournalService's PurgeTrash
+	// RPC.
+	JournalServicePurgeTrashProcedure = "/journal.v1.JournalService/PurgeTrash"
+	// JournalServiceListJournalEntryRevisionsProcedure is the fully-qualified name of the
+	// JournalService's ListJournalEntryRevisions RPC.
+	JournalServiceListJournalEntryRevisionsProcedure = "/journal.v1.JournalService/ListJournalEntryRevisions"
+	// JournalServiceSearchJournalEntryRevisionsProcedure is the fully-qualified name of the
+	// JournalService's SearchJournalEntryRevisions RPC.
+	JournalServiceSearchJournalEntryRevisionsProcedure = "/journal.v1.JournalService/SearchJournalEntryRevisions"
+	// JournalServiceRestoreJournalEntryRevisionProcedure is the fully-qualified name of the
+	// JournalService's RestoreJournalEntryRevision RPC.
+	JournalServiceRestoreJournalEntryRevisionProcedure = "/journal.v1.JournalService/RestoreJournalEntryRevision"
+	// JournalServiceExportJournalProcedure is the fully-qualified name of the JournalService's
+	// ExportJournal RPC.
+	JournalServiceExportJournalProcedure = "/journal.v1.JournalService/ExportJournal"
+	// JournalServiceImportJournalProcedure is the fully-qualified name of the JournalService's
+	// ImportJournal RPC.
+	JournalServiceImportJournalProcedure = "/journal.v1.JournalService/ImportJournal"
+	// JournalServiceImportDayOneProcedure is the fully-qualified name of the JournalService's
+	// ImportDayOne RPC.
+	JournalServiceImportDayOneProcedure = "/journal.v1.JournalService/ImportDayOne"
+	// JournalServiceVerifyArchiveProcedure is the fully-qualified name of the JournalService's
+	// VerifyArchive RPC.
+	JournalServiceVerifyArchiveProcedure = "/journal.v1.JournalService/VerifyArchive"
+	// JournalServicePushBackupProcedure is the fully-qualified name of the JournalService's PushBackup
+	// RPC.
+	JournalServicePushBackupProcedure = "/journal.v1.JournalService/PushBackup"
+	// JournalServiceSuggestTitleProcedure is the fully-qualified name of the JournalService's
+	// SuggestTitle RPC.
+	JournalServiceSuggestTitleProcedure = "/journal.v1.JournalService/SuggestTitle"
+)
+
+// JournalServiceClient is a client for the journal.v1.JournalService service.
+type JournalServiceClient interface {
+	// CreateJournalEntry creates a new journal entry
+	CreateJournalEntry(context.Context, *v1.CreateJournalEntryRequest) (*v1.CreateJournalEntryResponse, error)
+	// GetJournalEntry returns a single journal entry by ID
+	GetJournalEntry(context.Context, *v1.GetJournalEntryRequest) (*v1.GetJournalEntryResponse, error)
+	// UpdateJournalEntry updates an existing journal entry
+	UpdateJournalEntry(context.Context, *v1.UpdateJournalEntryRequest) (*v1.UpdateJournalEntryResponse, error)
+	// DeleteJournalEntry moves a journal entry to the trash, or removes it
+	// permanently if requested
+	DeleteJournalEntry(context.Context, *v1.DeleteJournalEntryRequest) (*v1.DeleteJournalEntryResponse, error)
+	// BatchCreateJournalEntries creates up to 500 entries in one transaction.
+	// An invalid entry fails on its own; the others are still created
+	BatchCreateJournalEntries(context.Context, *v1.BatchCreateJournalEntriesRequest) (*v1.BatchCreateJournalEntriesResponse, error)
+	// BatchGetJournalEntries returns up to 500 entries by ID, read from one
+	// snapshot of the journal
+	BatchGetJournalEntries(context.Context, *v1.BatchGetJournalEntriesRequest) (*v1.BatchGetJournalEntriesResponse, error)
+	// BatchDeleteJournalEntries deletes up to 500 entries in one transaction.
+	// A missing entry fails on its own; the others are still deleted
+	BatchDeleteJournalEntries(context.Context, *v1.BatchDeleteJournalEntriesRequest) (*v1.BatchDeleteJournalEntriesResponse, error)
+	// ListJournalEntries returns paginated journal entries sorted by date descending
+	ListJournalEntries(context.Context, *v1.ListJournalEntriesRequest) (*v1.ListJournalEntriesResponse, error)
+	// SearchJournalEntries returns entries matching a full-text query, best match first.
+	// Sealed entries are not searchable until they are revealed.
+	SearchJournalEntries(context.Context, *v1.SearchJournalEntriesRequest) (*v1.SearchJournalEntriesResponse, error)
+	// ListTags returns every tag with the number of entries that use it
+	ListTags(context.Context, *v1.ListTagsRequest) (*v1.ListTagsResponse, error)
+	// RenameTag renames a tag on every entry, merging it into new_name if that tag exists
+	RenameTag(context.Context, *v1.RenameTagRequest) (*v1.RenameTagResponse, error)
+	// DeleteTag removes a tag from every entry
+	DeleteTag(context.Context, *v1.DeleteTagRequest) (*v1.DeleteTagResponse, error)
+	// ListTrashedEntries returns paginated entries in the trash, most recently deleted first
+	ListTrashedEntries(context.Context, *v1.ListTrashedEntriesRequest) (*v1.ListTrashedEntriesResponse, error)
+	// RestoreJournalEntry moves a journal entry out of the trash
+	RestoreJournalEntry(context.Context, *v1.RestoreJournalEntryRequest) (*v1.RestoreJournalEntryResponse, error)
+	// PurgeTrash permanently removes entries in the trash
+	PurgeTrash(context.Context, *v1.PurgeTrashRequest) (*v1.PurgeTrashResponse, error)
+	// ListJournalEntryRevisions returns the previous versions of an entry, newest first
+	ListJournalEntryRevisions(context.Context, *v1.ListJournalEntryRevisionsRequest) (*v1.ListJournalEntryRevisionsResponse, error)
+	// SearchJournalEntryRevisions searches the previous versions of an entry,
+	// best match first
+	SearchJournalEntryRevisions(context.Context, *v1.SearchJournalEntryRevisionsRequest) (*v1.SearchJournalEntryRevisionsResponse, error)
+	// RestoreJournalEntryRevision rolls an entry back to a revision; the
+	// replaced version becomes a new revision
+	RestoreJournalEntryRevision(context.Context, *v1.RestoreJournalEntryRevisionRequest) (*v1.RestoreJournalEntryRevisionResponse, error)
+	// ExportJournal streams every entry not in the trash, oldest first, in the
+	// chosen format
+	ExportJournal(context.Context, *v1.ExportJournalRequest) (*connect.ServerStreamForClient[v1.ExportJournalResponse], error)
+	// ImportJournal inserts batches of entries from another journal, keeping
+	// their original timestamps and skipping entries already imported
+	ImportJournal(context.Context) (*connect.ClientStreamForClientSimple[v1.ImportJournalRequest, v1.ImportJournalResponse], error)
+	// ImportDayOne imports the entries of a Day One JSON export, skipping
+	// entries already imported
+	ImportDayOne(context.Context) (*connect.ClientStreamForClientSimple[v1.ImportDayOneRequest, v1.ImportDayOneResponse], error)
+	// VerifyArchive checks the hash-chained entry archive and that archived
+	// entries have not been altered outside of the API
+	VerifyArchive(context.Context, *v1.VerifyArchiveRequest) (*v1.VerifyArchiveResponse, error)
+	// PushBackup pushes the journal's git repository to the configured remote
+	PushBackup(context.Context, *v1.PushBackupRequest) (*v1.PushBackupResponse, error)
+	// SuggestTitle suggests a title derived from entry content
+	SuggestTitle(context.Context, *v1.SuggestTitleRequest) (*v1.SuggestTitleResponse, error)
+}
+
+// NewJournalServiceClient constructs a client for the journal.v1.JournalService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewJournalServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) JournalServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	journalServiceMethods := v1.File_journal_v1_journal_proto.Services().ByName("JournalService").Methods()
+	return &journalServiceClient{
+		createJournalEntry: connect.NewClient[v1.CreateJournalEntryRequest, v1.CreateJournalEntryResponse](
+			httpClient,
+			baseURL+JournalServiceCreateJournalEntryProcedure,
+			connect.WithSchema(journalServiceMethods.ByName("CreateJournalEntry")),
+			connect.WithClientOptions(opts...),
+		),
+		getJournalEntry: connect.NewClient[v1.GetJournalEntryRequest, v1.GetJournalEntryResponse](
+			httpClient,
+			baseURL+JournalServiceGetJournalEntryProcedure,
+			connect.WithSchema(journalServiceMethods.ByName("GetJournalEntry")),
+			connect.WithClientOptions(opts...),
+		),
+		updateJournalEntry: connect.NewClient[v1.UpdateJournalEntryRequest, v1.UpdateJournalEntryResponse](
+			httpClient,
+			baseURL+JournalServiceUpdateJournalEntryProcedure,
+			connect.WithSchema(journalServiceMethods.ByName("UpdateJournalEntry")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteJournalEntry: connect.NewClient[v1.DeleteJournalEntryRequest, v1.DeleteJournalEntryResponse](
+			httpClient,
+			baseURL+JournalServiceDeleteJournalEntryProcedure,
+			connect.WithSchema(journalServiceMethods.ByName("DeleteJournalEntry")),
+			connect.WithClientOptions(opts...),
+		),
+		batchCreateJournalEntries: connect.NewClient[v1.BatchCreateJournalEntriesRequest, v1.BatchCreateJournalEntriesResponse](
+			httpClient,
+			baseURL+JournalServiceBatchCreateJournalEntriesProcedure,
+			connect.WithSchema(journalServiceMethods.ByName("BatchCreateJournalEntries")),
+			connect.WithClientOptions(opts...),
+		),
+		batchGetJournalEntries: connect.NewClient[v1.BatchGetJournalEntriesRequest, v1.BatchGetJournalEntriesResponse](
+			httpClient,
+			baseURL+JournalServiceBatchGetJournalEntriesProcedure,
+			connect.WithSchema(journalServiceMethods.ByName("BatchGetJournalEntries")),
+			connect.WithClientOptions(opts...),
+		),
+		batchDeleteJournalEntries: connect.NewClient[v1.BatchDeleteJournalEntriesRequest, v1.BatchDeleteJournalEntriesResponse](
+			httpClient,
+			baseURL+JournalServiceBatchDeleteJournalEntriesProcedure,
+			connect.WithSchema(journalServiceMethods.ByName("BatchDeleteJournalEntries")),
+			connect.WithClientOptions(opts...),
+		),
+		listJournalEntries: connect.NewClient[v1.ListJournalEntriesRequest, v1.ListJournalEntriesResponse](
+			httpClient,
+			baseURL+JournalServiceListJournalEntriesProcedure,
+			connect.WithSchema(journalServiceMethods.ByName("ListJournalEntries")),
+			connect.WithClientOptions(opts...),
+		),
+		searchJournalEntries: connect.NewClient[v1.SearchJournalEntriesRequest, v1.SearchJournalEntriesResponse](
+			httpClient,
+			baseURL+JournalServiceSearchJournalEntriesProcedure,
+			connect.WithSchema(journalServiceMethods.ByName("SearchJournalEntries")),
+			connect.WithClientOptions(opts...),
+		),
+		listTags: connect.NewClient[v1.ListTagsRequest, v1.ListTagsResponse](
+			httpClient,
+			baseURL+JournalServiceListTagsProcedure,
+			connect.WithSchema(journalServiceMethods.ByName("ListTags")),
+			connect.WithClientOptions(opts...),
+		),
+		renameTag: connect.NewClient[v1.RenameTagRequest, v1.RenameTagResponse](
+			httpClient,
+			baseURL+JournalServiceRenameTagProcedure,
+			connect.WithSchema(journalServiceMethods.ByName("RenameTag")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteTag: connect.NewClient[v1.DeleteTagRequest, v1.DeleteTagResponse](
+			httpClient,
+			baseURL+JournalServiceDeleteTagProcedure,
+			connect.WithSchema(journalServiceMethods.ByName("DeleteTag")),
+			connect.WithClientOptions(opts...),
+		),
+		listTrashedEntries: connect.NewClient[v1.ListTrashedEntriesRequest, v1.ListTrashedEntriesResponse](
+			httpClient,
+			baseURL+JournalServiceListTrashedEntriesProcedure,
+			connect.WithSchema(journalServiceMethods.ByName("ListTrashedEntries")),
+			connect.WithClientOptions(opts...),
+		),
+		restoreJournalEntry: connect.NewClient[v1.RestoreJournalEntryRequest, v1.RestoreJournalEntryResponse](
+			httpClient,
+			baseURL+JournalServiceRestoreJournalEntryProcedure,
+			connect.WithSchema(journalServiceMethods.ByName("RestoreJournalEntry")),
+			connect.WithClientOptions(opts...),
+		),
+		purgeTrash: connect.NewClient[v1.PurgeTrashRequest, v1.PurgeTrashResponse](
+			httpClient,
+			baseURL+JournalServicePurgeTrashProcedure,
+			connect.WithSchema(journalServiceMethods.ByName("PurgeTrash")),
+			connect.WithClientOptions(opts...),
+		),
+		listJournalEntryRevisions: connect.NewClient[v1.ListJournalEntryRevisionsRequest, v1.ListJournalEntryRevisionsResponse](
+			httpClient,
+			baseURL+JournalServiceListJournalEntryRevisionsProcedure,
+			connect.WithSchema(journalServiceMethods.ByName("ListJournalEntryRevisions")),
+			connect.WithClientOptions(opts...),
+		),
+		searchJournalEntryRevisions: connect.NewClient[v1.SearchJournalEntryRevisionsRequest, v1.SearchJournalEntryRevisionsResponse](
+			httpClient,
+			baseURL+JournalServiceSearchJournalEntryRevisionsProcedure,
+			connect.WithSchema(journalServiceMethods.ByName("SearchJournalEntryRevisions")),
+			connect.WithClientOptions(opts...),
+		),
+		restoreJournalEntryRevision: connect.NewClient[v1.RestoreJournalEntryRevisionRequest, v1.RestoreJournalEntryRevisionResponse](
+			httpClient,
+			baseURL+JournalServiceRestoreJournalEntryRevisionProcedure,
+			connect.WithSchema(journalServiceMethods.ByName("RestoreJournalEntryRevision")),
+			connect.WithClientOptions(opts...),
+		),
+		exportJournal: connect.NewClient[v1.ExportJournalRequest, v1.ExportJournalResponse](
+			httpClient,
+			baseURL+JournalServiceExportJournalProcedure,
+			connect.WithSchema(journalServiceMethods.ByName("ExportJournal")),
+			connect.WithClientOptions(opts...),
+		),
+		importJournal: connect.NewClient[v1.ImportJournalRequest, v1.ImportJournalResponse](
+			httpClient,
+			baseURL+JournalServiceImportJournalProcedure,
+			connect.WithSchema(journalServiceMethods.ByName("ImportJournal")),
+			connect.WithClientOptions(opts...),
+		),
+		importDayOne: connect.NewClient[v1.ImportDayOneRequest, v1.ImportDayOneResponse](
+			httpClient,
+			baseURL+JournalServiceImportDayOneProcedure,
+			connect.WithSchema(journalServiceMethods.ByName("ImportDayOne")),
+			connect.WithClientOptions(opts...),
+		),
+		verifyArchive: connect.NewClient[v1.VerifyArchiveRequest, v1.VerifyArchiveResponse](
+			httpClient,
+			baseURL+JournalServiceVerifyArchiveProcedure,
+			connect.WithSchema(journalServiceMethods.ByName("VerifyArchive")),
+			connect.WithClientOptions(opts...),
+		),
+		pushBackup: connect.NewClient[v1.PushBackupRequest, v1.PushBackupResponse](
+			httpClient,
+			baseURL+JournalServicePushBackupProcedure,
+			connect.WithSchema(journalServiceMethods.ByName("PushBackup")),
+			connect.WithClientOptions(opts...),
+		),
+		suggestTitle: connect.NewClient[v1.SuggestTitleRequest, v1.SuggestTitleResponse](
+			httpClient,
+			baseURL+JournalServiceSuggestTitleProcedure,
+			connect.WithSchema(journalServiceMethods.ByName("SuggestTitle")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// journalServiceClient implements JournalServiceClient.
+type journalServiceClient struct {
+	createJournalEntry          *connect.Client[v1.CreateJournalEntryRequest, v1.CreateJournalEntryResponse]
+	getJournalEntry             *connect.Client[v1.GetJournalEntryRequest, v1.GetJournalEntryResponse]
+	updateJournalEntry          *connect.Client[v1.UpdateJournalEntryRequest, v1.UpdateJournalEntryResponse]
+	deleteJournalEntry          *connect.Client[v1.DeleteJournalEntryRequest, v1.DeleteJournalEntryResponse]
+	batchCreateJournalEntries   *connect.Client[v1.BatchCreateJournalEntriesRequest, v1.BatchCreateJournalEntriesResponse]
+	batchGetJournalEntries      *connect.Client[v1.BatchGetJournalEntriesRequest, v1.BatchGetJournalEntriesResponse]
+	batchDeleteJournalEntries   *connect.Client[v1.BatchDeleteJournalEntriesRequest, v1.BatchDeleteJournalEntriesResponse]
+	listJournalEntries          *connect.Client[v1.ListJournalEntriesRequest, v1.ListJournalEntriesResponse]
+	searchJournalEntries        *connect.Client[v1.SearchJournalEntriesRequest, v1.SearchJournalEntriesResponse]
+	listTags                    *connect.Client[v1.ListTagsRequest, v1.ListTagsResponse]
+	renameTag                   *connect.Client[v1.RenameTagRequest, v1.RenameTagResponse]
+	deleteTag                   *connect.Client[v1.DeleteTagRequest, v1.DeleteTagResponse]
+	listTrashedEntries          *connect.Client[v1.ListTrashedEntriesRequest, v1.ListTrashedEntriesResponse]
+	restoreJournalEntry         *connect.Client[v1.RestoreJournalEntryRequest, v1.RestoreJournalEntryResponse]
+	purgeTrash                  *connect.Client[v1.PurgeTrashRequest, v1.PurgeTrashResponse]
+	listJournalEntryRevisions   *connect.Client[v1.ListJournalEntryRevisionsRequest, v1.ListJournalEntryRevisionsResponse]
+	searchJournalEntryRevisions *connect.Client[v1.SearchJournalEntryRevisionsRequest, v1.SearchJournalEntryRevisionsResponse]
+	restoreJournalEntryRevision *connect.Client[v1.RestoreJournalEntryRevisionRequest, v1.RestoreJournalEntryRevisionResponse]
+	exportJournal               *connect.Client[v1.ExportJournalRequest, v1.ExportJournalResponse]
+	importJournal               *connect.Client[v1.ImportJournalRequest, v1.ImportJournalResponse]
+	importDayOne                *connect.Client[v1.ImportDayOneRequest, v1.ImportDayOneResponse]
+	verifyArchive               *connect.Client[v1.VerifyArchiveRequest, v1.VerifyArchiveResponse]
+	pushBackup                  *connect.Client[v1.PushBackupRequest, v1.PushBackupResponse]
+	suggestTitle                *connect.Client[v1.SuggestTitleRequest, v1.SuggestTitleResponse]
+}
+
+// CreateJournalEntry calls journal.v1.JournalService.CreateJournalEntry.
+func (c *journalServiceClient) CreateJournalEntry(ctx context.Context, req *v1.CreateJournalEntryRequest) (*v1.CreateJournalEntryResponse, error) {
+	response, err := c.createJournalEntry.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetJournalEntry calls journal.v1.JournalService.GetJournalEntry.
+func (c *journalServiceClient) GetJournalEntry(ctx context.Context, req *v1.GetJournalEntryRequest) (*v1.GetJournalEntryResponse, error) {
+	response, err := c.getJournalEntry.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// UpdateJournalEntry calls journal.v1.JournalService.UpdateJournalEntry.
+func (c *journalServiceClient) UpdateJournalEntry(ctx context.Context, req *v1.UpdateJournalEntryRequest) (*v1.UpdateJournalEntryResponse, error) {
+	response, err := c.updateJournalEntry.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteJournalEntry calls journal.v1.JournalService.DeleteJournalEntry.
+func (c *journalServiceClient) DeleteJournalEntry(ctx context.Context, req *v1.DeleteJournalEntryRequest) (*v1.DeleteJournalEntryResponse, error) {
+	response, err := c.deleteJournalEntry.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// BatchCreateJournalEntries calls journal.v1.JournalService.BatchCreateJournalEntries.
+func (c *journalServiceClient) BatchCreateJournalEntries(ctx context.Context, req *v1.BatchCreateJournalEntriesRequest) (*v1.BatchCreateJournalEntriesResponse, error) {
+	response, err := c.batchCreateJournalEntries.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// BatchGetJournalEntries calls journal.v1.JournalService.BatchGetJournalEntries.
+func (c *journalServiceClient) BatchGetJournalEntries(ctx context.Context, req *v1.BatchGetJournalEntriesRequest) (*v1.BatchGetJournalEntriesResponse, error) {
+	response, err := c.batchGetJournalEntries.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// BatchDeleteJournalEntries calls journal.v1.JournalService.BatchDeleteJournalEntries.
+func (c *journalServiceClient) BatchDeleteJournalEntries(ctx context.Context, req *v1.BatchDeleteJournalEntriesRequest) (*v1.BatchDeleteJournalEntriesResponse, error) {
+	response, err := c.batchDeleteJournalEntries.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListJournalEntries calls journal.v1.JournalService.ListJournalEntries.
+func (c *journalServiceClient) ListJournalEntries(ctx context.Context, req *v1.ListJournalEntriesRequest) (*v1.ListJournalEntriesResponse, error) {
+	response, err := c.listJournalEntries.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// SearchJournalEntries calls journal.v1.JournalService.SearchJournalEntries.
+func (c *journalServiceClient) SearchJournalEntries(ctx context.Context, req *v1.SearchJournalEntriesRequest) (*v1.SearchJournalEntriesResponse, error) {
+	response, err := c.searchJournalEntries.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListTags calls journal.v1.JournalService.ListTags.
+func (c *journalServiceClient) ListTags(ctx context.Context, req *v1.ListTagsRequest) (*v1.ListTagsResponse, error) {
+	response, err := c.listTags.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// RenameTag calls journal.v1.JournalService.RenameTag.
+func (c *journalServiceClient) RenameTag(ctx context.Context, req *v1.RenameTagRequest) (*v1.RenameTagResponse, error) {
+	response, err := c.renameTag.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteTag calls journal.v1.JournalService.DeleteTag.
+func (c *journalServiceClient) DeleteTag(ctx context.Context, req *v1.DeleteTagRequest) (*v1.DeleteTagResponse, error) {
+	response, err := c.deleteTag.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListTrashedEntries calls journal.v1.JournalService.ListTrashedEntries.
+func (c *journalServiceClient) ListTrashedEntries(ctx context.Context, req *v1.ListTrashedEntriesRequest) (*v1.ListTrashedEntriesResponse, error) {
+	response, err := c.listTrashedEntries.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// RestoreJournalEntry calls journal.v1.JournalService.RestoreJournalEntry.
+func (c *journalServiceClient) RestoreJournalEntry(ctx context.Context, req *v1.RestoreJournalEntryRequest) (*v1.RestoreJournalEntryResponse, error) {
+	response, err := c.restoreJournalEntry.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// PurgeTrash calls journal.v1.JournalService.PurgeTrash.
+func (c *journalServiceClient) PurgeTrash(ctx context.Context, req *v1.PurgeTrashRequest) (*v1.PurgeTrashResponse, error) {
+	response, err := c.purgeTrash.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListJournalEntryRevisions calls journal.v1.JournalService.ListJournalEntryRevisions.
+func (c *journalServiceClient) ListJournalEntryRevisions(ctx context.Context, req *v1.ListJournalEntryRevisionsRequest) (*v1.ListJournalEntryRevisionsResponse, error) {
+	response, err := c.listJournalEntryRevisions.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// SearchJournalEntryRevisions calls journal.v1.JournalService.SearchJournalEntryRevisions.
+func (c *journalServiceClient) SearchJournalEntryRevisions(ctx context.Context, req *v1.SearchJournalEntryRevisionsRequest) (*v1.SearchJournalEntryRevisionsResponse, error) {
+	response, err := c.searchJournalEntryRevisions.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// RestoreJournalEntryRevision calls journal.v1.JournalService.RestoreJournalEntryRevision.
+func (c *journalServiceClient) RestoreJournalEntryRevision(ctx context.Context, req *v1.RestoreJournalEntryRevisionRequest) (*v1.RestoreJournalEntryRevisionResponse, error) {
+	response, err := c.restoreJournalEntryRevision.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ExportJournal calls journal.v1.JournalService.ExportJournal.
+func (c *journalServiceClient) ExportJournal(ctx context.Context, req *v1.ExportJournalRequest) (*connect.ServerStreamForClient[v1.ExportJournalResponse], error) {
+	return c.exportJournal.CallServerStream(ctx, connect.NewRequest(req))
+}
+
+// ImportJournal calls journal.v1.JournalService.ImportJournal.
+func (c *journalServiceClient) ImportJournal(ctx context.Context) (*connect.ClientStreamForClientSimple[v1.ImportJournalRequest, v1.ImportJournalResponse], error) {
+	return c.importJournal.CallClientStreamSimple(ctx)
+}
+
+// ImportDayOne calls journal.v1.JournalService.ImportDayOne.
+func (c *journalServiceClient) ImportDayOne(ctx context.Context) (*connect.ClientStreamForClientSimple[v1.ImportDayOneRequest, v1.ImportDayOneResponse], error) {
+	return c.importDayOne.CallClientStreamSimple(ctx)
+}
+
+// VerifyArchive calls journal.v1.JournalService.VerifyArchive.
+func (c *journalServiceClient) VerifyArchive(ctx context.Context, req *v1.VerifyArchiveRequest) (*v1.VerifyArchiveResponse, error) {
+	response, err := c.verifyArchive.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// PushBackup calls journal.v1.JournalService.PushBackup.
+func (c *journalServiceClient) PushBackup(ctx context.Context, req *v1.PushBackupRequest) (*v1.PushBackupResponse, error) {
+	response, err := c.pushBackup.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// SuggestTitle calls journal.v1.JournalService.SuggestTitle.
+func (c *journalServiceClient) SuggestTitle(ctx context.Context, req *v1.SuggestTitleRequest) (*v1.SuggestTitleResponse, error) {
+	response, err := c.suggestTitle.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// JournalServiceHandler is an implementation of the journal.v1.JournalService service.
+type JournalServiceHandler interface {
+	// CreateJournalEntry creates a new journal entry
+	CreateJournalEntry(context.Context, *v1.CreateJournalEntryRequest) (*v1.CreateJournalEntryResponse, error)
+	// GetJournalEntry returns a single journal entry by ID
+	GetJournalEntry(context.Context, *v1.GetJournalEntryRequest) (*v1.GetJournalEntryResponse, error)
+	// UpdateJournalEntry updates an existing journal entry
+	UpdateJournalEntry(context.Context, *v1.UpdateJournalEntryRequest) (*v1.UpdateJournalEntryResponse, error)
+	// DeleteJournalEntry moves a journal entry to the trash, or removes it
+	// permanently if requested
+	DeleteJournalEntry(context.Context, *v1.DeleteJournalEntryRequest) (*v1.DeleteJournalEntryResponse, error)
+	// BatchCreateJournalEntries creates up to 500 entries in one transaction.
+	// An invalid entry fails on its own; the others are still created
+	BatchCreateJournalEntries(context.Context, *v1.BatchCreateJournalEntriesRequest) (*v1.BatchCreateJournalEntriesResponse, error)
+	// BatchGetJournalEntries returns up to 500 entries by ID, read from one
+	// snapshot of the journal
+	BatchGetJournalEntries(context.Context, *v1.BatchGetJournalEntriesRequest) (*v1.BatchGetJournalEntriesResponse, error)
+	// BatchDeleteJournalEntries deletes up to 500 entries in one transaction.
+	// A missing entry fails on its own; the others are still deleted
+	BatchDeleteJournalEntries(context.Context, *v1.BatchDeleteJournalEntriesRequest) (*v1.BatchDeleteJournalEntriesResponse, error)
+	// ListJournalEntries returns paginated journal entries sorted by date descending
+	ListJournalEntries(context.Context, *v1.ListJournalEntriesRequest) (*v1.ListJournalEntriesResponse, error)
+	// SearchJournalEntries returns entries matching a full-text query, best match first.
+	// Sealed entries are not searchable until they are revealed.
+	SearchJournalEntries(context.Context, *v1.SearchJournalEntriesRequest) (*v1.SearchJournalEntriesResponse, error)
+	// ListTags returns every tag with the number of entries that use it
+	ListTags(context.Context, *v1.ListTagsRequest) (*v1.ListTagsResponse, error)
+	// RenameTag renames a tag on every entry, merging it into new_name if that tag exists
+	RenameTag(context.Context, *v1.RenameTagRequest) (*v1.RenameTagResponse, error)
+	// DeleteTag removes a tag from every entry
+	DeleteTag(context.Context, *v1.DeleteTagRequest) (*v1.DeleteTagResponse, error)
+	// ListTrashedEntries returns paginated entries in the trash, most recently deleted first
+	ListTrashedEntries(context.Context, *v1.ListTrashedEntriesRequest) (*v1.ListTrashedEntriesResponse, error)
+	// RestoreJournalEntry moves a journal entry out of the trash
+	RestoreJournalEntry(context.Context, *v1.RestoreJournalEntryRequest) (*v1.RestoreJournalEntryResponse, error)
+	// PurgeTrash permanently removes entries in the trash
+	PurgeTrash(context.Context, *v1.PurgeTrashRequest) (*v1.PurgeTrashResponse, error)
+	// ListJournalEntryRevisions returns the previous versions of an entry, newest first
+	ListJournalEntryRevisions(context.Context, *v1.ListJournalEntryRevisionsRequest) (*v1.ListJournalEntryRevisionsResponse, error)
+	// SearchJournalEntryRevisions searches the previous versions of an entry,
+	// best match first
+	SearchJournalEntryRevisions(context.Context, *v1.SearchJournalEntryRevisionsRequest) (*v1.SearchJournalEntryRevisionsResponse, error)
+	// RestoreJournalEntryRevision rolls an entry back to a revision; the
+	// replaced version becomes a new revision
+	RestoreJournalEntryRevision(context.Context, *v1.RestoreJournalEntryRevisionRequest) (*v1.RestoreJournalEntryRevisionResponse, error)
+	// ExportJournal streams every entry not in the trash, oldest first, in the
+	// chosen format
+	ExportJournal(context.Context, *v1.ExportJournalRequest, *connect.ServerStream[v1.ExportJournalResponse]) error
+	// ImportJournal inserts batches of entries from another journal, keeping
+	// their original timestamps and skipping entries already imported
+	ImportJournal(context.Context, *connect.ClientStream[v1.ImportJournalRequest]) (*v1.ImportJournalResponse, error)
+	// ImportDayOne imports the entries of a Day One JSON export, skipping
+	// entries already imported
+	ImportDayOne(context.Context, *connect.ClientStream[v1.ImportDayOneRequest]) (*v1.ImportDayOneResponse, error)
+	// VerifyArchive checks the hash-chained entry archive and that archived
+	// entries have not been altered outside of the API
+	VerifyArchive(context.Context, *v1.VerifyArchiveRequest) (*v1.VerifyArchiveResponse, error)
+	// PushBackup pushes the journal's git repository to the configured remote
+	PushBackup(context.Context, *v1.PushBackupRequest) (*v1.PushBackupResponse, error)
+	// SuggestTitle suggests a title derived from entry content
+	SuggestTitle(context.Context, *v1.SuggestTitleRequest) (*v1.SuggestTitleResponse, error)
+}
+
+// NewJournalServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewJournalServiceHandler(svc JournalServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	journalServiceMethods := v1.File_journal_v1_journal_proto.Services().ByName("JournalService").Methods()
+	journalServiceCreateJournalEntryHandler := connect.NewUnaryHandlerSimple(
+		JournalServiceCreateJournalEntryProcedure,
+		svc.CreateJournalEntry,
+		connect.WithSchema(journalServiceMethods.ByName("CreateJournalEntry")),
+		connect.WithHandlerOptions(opts...),
+	)
+	journalServiceGetJournalEntryHandler := connect.NewUnaryHandlerSimple(
+		JournalServiceGetJournalEntryProcedure,
+		svc.GetJournalEntry,
+		connect.WithSchema(journalServiceMethods.ByName("GetJournalEntry")),
+		connect.WithHandlerOptions(opts...),
+	)
+	journalServiceUpdateJournalEntryHandler := connect.NewUnaryHandlerSimple(
+		JournalServiceUpdateJournalEntryProcedure,
+		svc.UpdateJournalEntry,
+		connect.WithSchema(journalServiceMethods.ByName("UpdateJournalEntry")),
+		connect.WithHandlerOptions(opts...),
+	)
+	journalServiceDeleteJournalEntryHandler := connect.NewUnaryHandlerSimple(
+		JournalServiceDeleteJournalEntryProcedure,
+		svc.DeleteJournalEntry,
+		connect.WithSchema(journalServiceMethods.ByName("DeleteJournalEntry")),
+		connect.WithHandlerOptions(opts...),
+	)
+	journalServiceBatchCreateJournalEntriesHandler := connect.NewUnaryHandlerSimple(
+		JournalServiceBatchCreateJournalEntriesProcedure,
+		svc.BatchCreateJournalEntries,
+		connect.WithSchema(journalServiceMethods.ByName("BatchCreateJournalEntries")),
+		connect.WithHandlerOptions(opts...),
+	)
+	journalServiceBatchGetJournalEntriesHandler := connect.NewUnaryHandlerSimple(
+		JournalServiceBatchGetJournalEntriesProcedure,
+		svc.BatchGetJournalEntries,
+		connect.WithSchema(journalServiceMethods.ByName("BatchGetJournalEntries")),
+		connect.WithHandlerOptions(opts...),
+	)
+	journalServiceBatchDeleteJournalEntriesHandler := connect.NewUnaryHandlerSimple(
+		JournalServiceBatchDeleteJournalEntriesProcedure,
+		svc.BatchDeleteJournalEntries,
+		connect.WithSchema(journalServiceMethods.ByName("BatchDeleteJournalEntries")),
+		connect.WithHandlerOptions(opts...),
+	)
+	journalServiceListJournalEntriesHandler := connect.NewUnaryHandlerSimple(
+		JournalServiceListJournalEntriesProcedure,
+		svc.ListJournalEntries,
+		connect.WithSchema(journalServiceMethods.ByName("ListJournalEntries")),
+		connect.WithHandlerOptions(opts...),
+	)
+	journalServiceSearchJournalEntriesHandler := connect.NewUnaryHandlerSimple(
+		JournalServiceSearchJournalEntriesProcedure,
+		svc.SearchJournalEntries,
+		connect.WithSchema(journalServiceMethods.ByName("SearchJournalEntries")),
+		connect.WithHandlerOptions(opts...),
+	)
+	journalServiceListTagsHandler := connect.NewUnaryHandlerSimple(
+		JournalServiceListTagsProcedure,
+		svc.ListTags,
+		connect.WithSchema(journalServiceMethods.ByName("ListTags")),
+		connect.WithHandlerOptions(opts...),
+	)
+	journalServiceRenameTagHandler := connect.NewUnaryHandlerSimple(
+		JournalServiceRenameTagProcedure,
+		svc.RenameTag,
+		connect.WithSchema(journalServiceMethods.ByName("RenameTag")),
+		connect.WithHandlerOptions(opts...),
+	)
+	journalServiceDeleteTagHandler := connect.NewUnaryHandlerSimple(
+		JournalServiceDeleteTagProcedure,
+		svc.DeleteTag,
+		connect.WithSchema(journalServiceMethods.ByName("DeleteTag")),
+		connect.WithHandlerOptions(opts...),
+	)
+	journalServiceListTrashedEntriesHandler := connect.NewUnaryHandlerSimple(
+		JournalServiceListTrashedEntriesProcedure,
+		svc.ListTrashedEntries,
+		connect.WithSchema(journalServiceMethods.ByName("ListTrashedEntries")),
+		connect.WithHandlerOptions(opts...),
+	)
+	journalServiceRestoreJournalEntryHandler := connect.NewUnaryHandlerSimple(
+		JournalServiceRestoreJournalEntryProcedure,
+		svc.RestoreJournalEntry,
+		connect.WithSchema(journalServiceMethods.ByName("RestoreJournalEntry")),
+		connect.WithHandlerOptions(opts...),
+	)
+	journalServicePurgeTrashHandler := connect.NewUnaryHandlerSimple(
+		JournalServicePurgeTrashProcedure,
+		svc.PurgeTrash,
+		connect.WithSchema(journalServiceMethods.ByName("PurgeTrash")),
+		connect.WithHandlerOptions(opts...),
+	)
+	journalServiceListJournalEntryRevisionsHandler := connect.NewUnaryHandlerSimple(
+		JournalServiceListJournalEntryRevisionsProcedure,
+		svc.ListJournalEntryRevisions,
+		connect.WithSchema(journalServiceMethods.ByName("ListJournalEntryRevisions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	journalServiceSearchJournalEntryRevisionsHandler := connect.NewUnaryHandlerSimple(
+		JournalServiceSearchJournalEntryRevisionsProcedure,
+		svc.SearchJournalEntryRevisions,
+		connect.WithSchema(journalServiceMethods.ByName("SearchJournalEntryRevisions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	journalServiceRestoreJournalEntryRevisionHandler := connect.NewUnaryHandlerSimple(
+		JournalServiceRestoreJournalEntryRevisionProcedure,
+		svc.RestoreJournalEntryRevision,
+		connect.WithSchema(journalServiceMethods.ByName("RestoreJournalEntryRevision")),
+		connect.WithHandlerOptions(opts...),
+	)
+	journalServiceExportJournalHandler := connect.NewServerStreamHandlerSimple(
+		JournalServiceExportJournalProcedure,
+		svc.ExportJournal,
+		connect.WithSchema(journalServiceMethods.ByName("ExportJournal")),
+		connect.WithHandlerOptions(opts...),
+	)
+	journalServiceImportJournalHandler := connect.NewClientStreamHandlerSimple(
+		JournalServiceImportJournalProcedure,
+		svc.ImportJournal,
+		connect.WithSchema(journalServiceMethods.ByName("ImportJournal")),
+		connect.WithHandlerOptions(opts...),
+	)
+	journalServiceImportDayOneHandler := connect.NewClientStreamHandlerSimple(
+		JournalServiceImportDayOneProcedure,
+		svc.ImportDayOne,
+		connect.WithSchema(journalServiceMethods.ByName("ImportDayOne")),
+		connect.WithHandlerOptions(opts...),
+	)
+	journalServiceVerifyArchiveHandler := connect.NewUnaryHandlerSimple(
+		JournalServiceVerifyArchiveProcedure,
+		svc.VerifyArchive,
+		connect.WithSchema(journalServiceMethods.ByName("VerifyArchive")),
+		connect.WithHandlerOptions(opts...),
+	)
+	journalServicePushBackupHandler := connect.NewUnaryHandlerSimple(
+		JournalServicePushBackupProcedure,
+		svc.PushBackup,
+		connect.WithSchema(journalServiceMethods.ByName("PushBackup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	journalServiceSuggestTitleHandler := connect.NewUnaryHandlerSimple(
+		JournalServiceSuggestTitleProcedure,
+		svc.SuggestTitle,
+		connect.WithSchema(journalServiceMethods.ByName("SuggestTitle")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/journal.v1.JournalService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case JournalServiceCreateJournalEntryProcedure:
+			journalServiceCreateJournalEntryHandler.ServeHTTP(w, r)
+		case JournalServiceGetJournalEntryProcedure:
+			journalServiceGetJournalEntryHandler.ServeHTTP(w, r)
+		case JournalServiceUpdateJournalEntryProcedure:
+			journalServiceUpdateJournalEntryHandler.ServeHTTP(w, r)
+		case JournalServiceDeleteJournalEntryProcedure:
+			journalServiceDeleteJournalEntryHandler.ServeHTTP(w, r)
+		case JournalServiceBatchCreateJournalEntriesProcedure:
+			journalServiceBatchCreateJournalEntriesHandler.ServeHTTP(w, r)
+		case JournalServiceBatchGetJournalEntriesProcedure:
+			journalServiceBatchGetJournalEntriesHandler.ServeHTTP(w, r)
+		case JournalServiceBatchDeleteJournalEntriesProcedure:
+			journalServiceBatchDeleteJournalEntriesHandler.ServeHTTP(w, r)
+		case JournalServiceListJournalEntriesProcedure:
+			journalServiceListJournalEntriesHandler.ServeHTTP(w, r)
+		case JournalServiceSearchJournalEntriesProcedure:
+			journalServiceSearchJournalEntriesHandler.ServeHTTP(w, r)
+		case JournalServiceListTagsProcedure:
+			journalServiceListTagsHandler.ServeHTTP(w, r)
+		case JournalServiceRenameTagProcedure:
+			journalServiceRenameTagHandler.ServeHTTP(w, r)
+		case JournalServiceDeleteTagProcedure:
+			journalServiceDeleteTagHandler.ServeHTTP(w, r)
+		case JournalServiceListTrashedEntriesProcedure:
+			journalServiceListTrashedEntriesHandler.ServeHTTP(w, r)
+		case JournalServiceRestoreJournalEntryProcedure:
+			journalServiceRestoreJournalEntryHandler.ServeHTTP(w, r)
+		case JournalServicePurgeTrashProcedure:
+			journalServicePurgeTrashHandler.ServeHTTP(w, r)
+		case JournalServiceListJournalEntryRevisionsProcedure:
+			journalServiceListJournalEntryRevisionsHandler.ServeHTTP(w, r)
+		case JournalServiceSearchJournalEntryRevisionsProcedure:
+			journalServiceSearchJournalEntryRevisionsHandler.ServeHTTP(w, r)
+		case JournalServiceRestoreJournalEntryRevisionProcedure:
+			journalServiceRestoreJournalEntryRevisionHandler.ServeHTTP(w, r)
+		case JournalServiceExportJournalProcedure:
+			journalServiceExportJournalHandler.ServeHTTP(w, r)
+		case JournalServiceImportJournalProcedure:
+			journalServiceImportJournalHandler.ServeHTTP(w, r)
+		case JournalServiceImportDayOneProcedure:
+			journalServiceImportDayOneHandler.ServeHTTP(w, r)
+		case JournalServiceVerifyArchiveProcedure:
+			journalServiceVerifyArchiveHandler.ServeHTTP(w, r)
+		case JournalServicePushBackupProcedure:
+			journalServicePushBackupHandler.ServeHTTP(w, r)
+		case JournalServiceSuggestTitleProcedure:
+			journalServiceSuggestTitleHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedJournalServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedJournalServiceHandler struct{}
+
+func (UnimplementedJournalServiceHandler) CreateJournalEntry(context.Context, *v1.CreateJournalEntryRequest) (*v1.CreateJournalEntryResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("journal.v1.JournalService.CreateJournalEntry is not implemented"))
+}
+
+func (UnimplementedJournalServiceHandler) GetJournalEntry(context.Context, *v1.GetJournalEntryRequest) (*v1.GetJournalEntryResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("journal.v1.JournalService.GetJournalEntry is not implemented"))
+}
+
+func (UnimplementedJournalServiceHandler) UpdateJournalEntry(context.Context, *v1.UpdateJournalEntryRequest) (*v1.UpdateJournalEntryResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("journal.v1.JournalService.UpdateJournalEntry is not implemented"))
+}
+
+func (UnimplementedJournalServiceHandler) DeleteJournalEntry(context.Context, *v1.DeleteJournalEntryRequest) (*v1.DeleteJournalEntryResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("journal.v1.JournalService.DeleteJournalEntry is not implemented"))
+}
+
+func (UnimplementedJournalServiceHandler) BatchCreateJournalEntries(context.Context, *v1.BatchCreateJournalEntriesRequest) (*v1.BatchCreateJournalEntriesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("journal.v1.JournalService.BatchCreateJournalEntries is not implemented"))
+}
+
+func (UnimplementedJournalServiceHandler) BatchGetJournalEntries(context.Context, *v1.BatchGetJournalEntriesRequest) (*v1.BatchGetJournalEntriesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("journal.v1.JournalService.BatchGetJournalEntries is not implemented"))
+}
+
+func (UnimplementedJournalServiceHandler) BatchDeleteJournalEntries(context.Context, *v1.BatchDeleteJournalEntriesRequest) (*v1.BatchDeleteJournalEntriesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("journal.v1.JournalService.BatchDeleteJournalEntries is not implemented"))
+}
+
+func (UnimplementedJournalServiceHandler) ListJournalEntries(context.Context, *v1.ListJournalEntriesRequest) (*v1.ListJournalEntriesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("journal.v1.JournalService.ListJournalEntries is not implemented"))
+}
+
+func (UnimplementedJournalServiceHandler) SearchJournalEntries(context.Context, *v1.SearchJournalEntriesRequest) (*v1.SearchJournalEntriesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("journal.v1.JournalService.SearchJournalEntries is not implemented"))
+}
+
+func (UnimplementedJournalServiceHandler) ListTags(context.Context, *v1.ListTagsRequest) (*v1.ListTagsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("journal.v1.JournalService.ListTags is not implemented"))
+}
+
+func (UnimplementedJournalServiceHandler) RenameTag(context.Context, *v1.RenameTagRequest) (*v1.RenameTagResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("journal.v1.JournalService.RenameTag is not implemented"))
+}
+
+func (UnimplementedJournalServiceHandler) DeleteTag(context.Context, *v1.DeleteTagRequest) (*v1.DeleteTagResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("journal.v1.JournalService.DeleteTag is not implemented"))
+}
+
+func (UnimplementedJournalServiceHandler) ListTrashedEntries(context.Context, *v1.ListTrashedEntriesRequest) (*v1.ListTrashedEntriesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("journal.v1.JournalService.ListTrashedEntries is not implemented"))
+}
+
+func (UnimplementedJournalServiceHandler) RestoreJournalEntry(context.Context, *v1.RestoreJournalEntryRequest) (*v1.RestoreJournalEntryResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("journal.v1.JournalService.RestoreJournalEntry is not implemented"))
+}
+
+func (UnimplementedJournalServiceHandler) PurgeTrash(context.Context, *v1.PurgeTrashRequest) (*v1.PurgeTrashResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("journal.v1.JournalService.PurgeTrash is not implemented"))
+}
+
+func (UnimplementedJournalServiceHandler) ListJournalEntryRevisions(context.Context, *v1.ListJournalEntryRevisionsRequest) (*v1.ListJournalEntryRevisionsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("journal.v1.JournalService.ListJournalEntryRevisions is not implemented"))
+}
+
+func (UnimplementedJournalServiceHandler) SearchJournalEntryRevisions(context.Context, *v1.SearchJournalEntryRevisionsRequest) (*v1.SearchJournalEntryRevisionsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("journal.v1.JournalService.SearchJournalEntryRevisions is not implemented"))
+}
+
+func (UnimplementedJournalServiceHandler) RestoreJournalEntryRevision(context.Context, *v1.RestoreJournalEntryRevisionRequest) (*v1.RestoreJournalEntryRevisionResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("journal.v1.JournalService.RestoreJournalEntryRevision is not implemented"))
+}
+
+func (UnimplementedJournalServiceHandler) ExportJournal(context.Context, *v1.ExportJournalRequest, *connect.ServerStream[v1.ExportJournalResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("journal.v1.JournalService.ExportJournal is not implemented"))
+}
+
+func (UnimplementedJournalServiceHandler) ImportJournal(context.Context, *connect.ClientStream[v1.ImportJournalRequest]) (*v1.ImportJournalResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("journal.v1.JournalService.ImportJournal is not implemented"))
+}
+
+func (UnimplementedJournalServiceHandler) ImportDayOne(context.Context, *connect.ClientStream[v1.ImportDayOneRequest]) (*v1.ImportDayOneResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("journal.v1.JournalService.ImportDayOne is not implemented"))
+}
+
+func (UnimplementedJournalServiceHandler) VerifyArchive(context.Context, *v1.VerifyArchiveRequest) (*v1.VerifyArchiveResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("journal.v1.JournalService.VerifyArchive is not implemented"))
+}
+
+func (UnimplementedJournalServiceHandler) PushBackup(context.Context, *v1.PushBackupRequest) (*v1.PushBackupResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("journal.v1.JournalService.PushBackup is not implemented"))
+}
+
+func (UnimplementedJournalServiceHandler) SuggestTitle(context.Context, *v1.SuggestTitleRequest) (*v1.SuggestTitleResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("journal.v1.JournalService.SuggestTitle is not implemented"))
+}
@@ -0,0 +1,344 @@
+package config
+
+import (
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/parkernilson/micro-journal/internal/logging"
+	"github.com/parkernilson/micro-journal/internal/maintenance"
+)
+
+// Config holds the settings the server is started with.
+type Config struct {
+	// ListenAddr is the gRPC listen address, used when the server is not
+	// started with a systemd socket.
+	ListenAddr string
+	// TLSCert and TLSKey are the PEM certificate and key files the gRPC,
+	// REST, and Connect listeners serve TLS with.
+	TLSCert string
+	TLSKey  string
+	// TLSClientCA enables mutual TLS, requiring clients to present a
+	// certificate signed by a CA in this PEM file.
+	TLSClientCA string
+	// Plaintext allows serving the APIs and the feed without TLS on an
+	// address reachable from other machines.
+	Plaintext bool
+	// DBDriver selects the storage backend: DriverSQLite, DriverPostgres,
+	// DriverMarkdown, or DriverMemory.
+	DBDriver string
+	// DBPath is the path of the SQLite database file.
+	DBPath string
+	// DBDSN is the PostgreSQL connection string, used with DriverPostgres.
+	DBDSN string
+	// DBWait is how long startup keeps retrying to reach PostgreSQL.
+	DBWait time.Duration
+	// MarkdownDir is the directory of entry files, used with DriverMarkdown.
+	MarkdownDir string
+	// MarkdownGit commits every change to the entry files to a git
+	// repository in MarkdownDir.
+	MarkdownGit bool
+	// GitRemote is the git remote backups are pushed to.
+	GitRemote string
+	// SearchTokenizer is the SQLite FTS5 tokenizer search indexes entries
+	// with, which decides how words are stemmed.
+	SearchTokenizer string
+	// BusyTimeout is how long a query waits for a locked database.
+	BusyTimeout time.Duration
+	// AutoMigrate applies pending migrations on startup.
+	AutoMigrate bool
+	// Archive appends every entry version to the hash-chained entry archive.
+	Archive bool
+	// MaintenanceInterval is how often SQLite maintenance runs.
+	MaintenanceInterval time.Duration
+	// MaintenanceWindow limits SQLite maintenance to a time of day. The zero
+	// value allows it at any time.
+	MaintenanceWindow maintenance.Window
+
+	// FeedAddr is the HTTP listen address for the iCalendar feed.
+	FeedAddr string
+	// FeedToken enables the iCalendar feed and is required to read it.
+	FeedToken string
+	// FeedLinkBase is prefixed to entry IDs to link feed events to entries.
+	FeedLinkBase string
+
+	// RESTAddr enables the REST/JSON API on this HTTP listen address.
+	RESTAddr string
+	// ConnectAddr enables the Connect API on this HTTP listen address.
+	ConnectAddr string
+
+	// InboxDir enables ingesting files dropped into this directory.
+	InboxDir string
+
+	// LogLevel is the lowest level of log records written.
+	LogLevel slog.Level
+	// LogFormat is logging.FormatText or logging.FormatJSON.
+	LogFormat string
+	// OTLPEndpoint enables tracing, exporting spans to this OTLP gRPC
+	// collector URL.
+	OTLPEndpoint string
+}
+
+// Storage backends selectable with DBDriver.
+const (
+	DriverSQLite   = "sqlite"
+	DriverPostgres = "postgres"
+	DriverMarkdown = "markdown"
+	// DriverMemory keeps entries in memory only, for demos
+	DriverMemory = "memory"
+)
+
+// defaultSearchTokenizer stems English words. It is the tokenizer the
+// migrations create the search indexes with, so it never causes a reindex.
+const defaultSearchTokenizer = "porter unicode61"
+
+// Default returns the configuration used when nothing is overridden.
+func Default() *Config {
+	return &Config{
+		ListenAddr:          "localhost:50051",
+		DBDriver:            DriverSQLite,
+		DBPath:              "data/micro_journal.db",
+		MarkdownDir:         "data/journal",
+		GitRemote:           "origin",
+		DBWait:              30 * time.Second,
+		BusyTimeout:         5 * time.Second,
+		SearchTokenizer:     defaultSearchTokenizer,
+		MaintenanceInterval: 6 * time.Hour,
+		FeedAddr:            "localhost:8080",
+		LogLevel:            slog.LevelInfo,
+		LogFormat:           logging.FormatText,
+	}
+}
+
+// Load builds a Config from the defaults, then environment variables, then
+// command-line flags, each overriding the last. getenv is usually os.Getenv.
+func Load(args []string, getenv func(string) string) (*Config, error) {
+	cfg := Default()
+
+	if v := getenv("MJ_PORT"); v != "" {
+		cfg.ListenAddr = v
+	}
+	cfg.TLSCert = getenv("MJ_TLS_CERT")
+	cfg.TLSKey = getenv("MJ_TLS_KEY")
+	cfg.TLSClientCA = getenv("MJ_TLS_CLIENT_CA")
+	if v := getenv("MJ_PLAINTEXT"); v != "" {
+		plaintext, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid MJ_PLAINTEXT %q: %w", v, err)
+		}
+		cfg.Plaintext = plaintext
+	}
+	if v := getenv("MJ_DB_DRIVER"); v != "" {
+		cfg.DBDriver = v
+	}
+	if v := getenv("MJ_DB_PATH"); v != "" {
+		cfg.DBPath = v
+	}
+	if v := getenv("MJ_MARKDOWN_DIR"); v != "" {
+		cfg.MarkdownDir = v
+	}
+	if v := getenv("MJ_MARKDOWN_GIT"); v != "" {
+		markdownGit, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid MJ_MARKDOWN_GIT %q: %w", v, err)
+		}
+		cfg.MarkdownGit = markdownGit
+	}
+	if v := getenv("MJ_GIT_REMOTE"); v != "" {
+		cfg.GitRemote = v
+	}
+	dsn, err := secret("MJ_DB_DSN", getenv)
+	if err != nil {
+		return nil, err
+	}
+	cfg.DBDSN = dsn
+	if v := getenv("MJ_DB_WAIT"); v != "" {
+		dbWait, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid MJ_DB_WAIT %q: %w", v, err)
+		}
+		cfg.DBWait = dbWait
+	}
+	if v := getenv("MJ_SEARCH_TOKENIZER"); v != "" {
+		cfg.SearchTokenizer = v
+	}
+	if v := getenv("MJ_BUSY_TIMEOUT"); v != "" {
+		busyTimeout, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid MJ_BUSY_TIMEOUT %q: %w", v, err)
+		}
+		cfg.BusyTimeout = busyTimeout
+	}
+	if v := getenv("MJ_AUTO_MIGRATE"); v != "" {
+		autoMigrate, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid MJ_AUTO_MIGRATE %q: %w", v, err)
+		}
+		cfg.AutoMigrate = autoMigrate
+	}
+	if v := getenv("MJ_ARCHIVE"); v != "" {
+		archive, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid MJ_ARCHIVE %q: %w", v, err)
+		}
+		cfg.Archive = archive
+	}
+	if v := getenv("MJ_MAINTENANCE_INTERVAL"); v != "" {
+		interval, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid MJ_MAINTENANCE_INTERVAL %q: %w", v, err)
+		}
+		cfg.MaintenanceInterval = interval
+	}
+	if v := getenv("MJ_MAINTENANCE_WINDOW"); v != "" {
+		if err := cfg.MaintenanceWindow.UnmarshalText([]byte(v)); err != nil {
+			return nil, fmt.Errorf("invalid MJ_MAINTENANCE_WINDOW %q: %w", v, err)
+		}
+	}
+	if v := getenv("MJ_FEED_PORT"); v != "" {
+		cfg.FeedAddr = v
+	}
+	feedToken, err := secret("MJ_FEED_TOKEN", getenv)
+	if err != nil {
+		return nil, err
+	}
+	cfg.FeedToken = feedToken
+	cfg.FeedLinkBase = getenv("MJ_FEED_LINK_BASE")
+	cfg.RESTAddr = getenv("MJ_REST_PORT")
+	cfg.ConnectAddr = getenv("MJ_CONNECT_PORT")
+	cfg.InboxDir = getenv("MJ_INBOX_DIR")
+	if v := getenv("MJ_LOG_LEVEL"); v != "" {
+		if err := cfg.LogLevel.UnmarshalText([]byte(v)); err != nil {
+			return nil, fmt.Errorf("invalid MJ_LOG_LEVEL %q: %w", v, err)
+		}
+	}
+	if v := getenv("MJ_LOG_FORMAT"); v != "" {
+		cfg.LogFormat = v
+	}
+	cfg.OTLPEndpoint = getenv("MJ_OTLP_ENDPOINT")
+
+	fs := flag.NewFlagSet("server", flag.ContinueOnError)
+	fs.StringVar(&cfg.ListenAddr, "port", cfg.ListenAddr, "gRPC listen port or address (MJ_PORT)")
+	fs.StringVar(&cfg.TLSCert, "tls-cert", cfg.TLSCert, "PEM certificate file to serve the APIs and feed over TLS with (MJ_TLS_CERT)")
+	fs.StringVar(&cfg.TLSKey, "tls-key", cfg.TLSKey, "PEM private key file of the TLS certificate (MJ_TLS_KEY)")
+	fs.StringVar(&cfg.TLSClientCA, "tls-client-ca", cfg.TLSClientCA, "PEM file of CAs client certificates must be signed by (MJ_TLS_CLIENT_CA)")
+	fs.BoolVar(&cfg.Plaintext, "plaintext", cfg.Plaintext, "allow serving the APIs and feed without TLS on a non-loopback address (MJ_PLAINTEXT)")
+	fs.StringVar(&cfg.DBDriver, "db-driver", cfg.DBDriver, "storage backend, sqlite, postgres, markdown, or memory (MJ_DB_DRIVER)")
+	fs.StringVar(&cfg.DBPath, "db-path", cfg.DBPath, "path of the SQLite database file (MJ_DB_PATH)")
+	fs.StringVar(&cfg.DBDSN, "db-dsn", cfg.DBDSN, "PostgreSQL connection string (MJ_DB_DSN)")
+	fs.DurationVar(&cfg.DBWait, "db-wait", cfg.DBWait, "how long to keep retrying to reach PostgreSQL on startup (MJ_DB_WAIT)")
+	fs.StringVar(&cfg.MarkdownDir, "markdown-dir", cfg.MarkdownDir, "directory of Markdown entry files (MJ_MARKDOWN_DIR)")
+	fs.BoolVar(&cfg.MarkdownGit, "markdown-git", cfg.MarkdownGit, "commit every change to a git repository in the Markdown directory (MJ_MARKDOWN_GIT)")
+	fs.StringVar(&cfg.GitRemote, "git-remote", cfg.GitRemote, "git remote backups are pushed to (MJ_GIT_REMOTE)")
+	fs.StringVar(&cfg.SearchTokenizer, "search-tokenizer", cfg.SearchTokenizer, "SQLite FTS5 tokenizer for search, such as 'unicode61 remove_diacritics 2' (MJ_SEARCH_TOKENIZER)")
+	fs.DurationVar(&cfg.BusyTimeout, "busy-timeout", cfg.BusyTimeout, "how long to wait for a locked database (MJ_BUSY_TIMEOUT)")
+	fs.BoolVar(&cfg.AutoMigrate, "auto-migrate", cfg.AutoMigrate, "apply pending migrations on startup (MJ_AUTO_MIGRATE)")
+	fs.BoolVar(&cfg.Archive, "archive", cfg.Archive, "append every entry version to the tamper-evident archive (MJ_ARCHIVE)")
+	fs.DurationVar(&cfg.MaintenanceInterval, "maintenance-interval", cfg.MaintenanceInterval, "how often SQLite maintenance runs (MJ_MAINTENANCE_INTERVAL)")
+	fs.TextVar(&cfg.MaintenanceWindow, "maintenance-window", cfg.MaintenanceWindow, "local time of day SQLite maintenance may run in, such as 02:00-05:00 (MJ_MAINTENANCE_WINDOW)")
+	fs.StringVar(&cfg.FeedAddr, "feed-port", cfg.FeedAddr, "iCalendar feed listen port or address (MJ_FEED_PORT)")
+	fs.StringVar(&cfg.RESTAddr, "rest-port", cfg.RESTAddr, "REST/JSON API listen port or address (MJ_REST_PORT)")
+	fs.StringVar(&cfg.ConnectAddr, "connect-port", cfg.ConnectAddr, "Connect API listen port or address (MJ_CONNECT_PORT)")
+	fs.StringVar(&cfg.InboxDir, "inbox-dir", cfg.InboxDir, "directory to ingest entries from (MJ_INBOX_DIR)")
+	fs.TextVar(&cfg.LogLevel, "log-level", cfg.LogLevel, "lowest level logged, debug, info, warn, or error (MJ_LOG_LEVEL)")
+	fs.StringVar(&cfg.LogFormat, "log-format", cfg.LogFormat, "log format, text or json (MJ_LOG_FORMAT)")
+	fs.StringVar(&cfg.OTLPEndpoint, "otlp-endpoint", cfg.OTLPEndpoint, "OTLP gRPC collector URL to export traces to (MJ_OTLP_ENDPOINT)")
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if fs.NArg() > 0 {
+		return nil, fmt.Errorf("unexpected arguments: %v", fs.Args())
+	}
+
+	cfg.ListenAddr = listenAddr(cfg.ListenAddr)
+	cfg.FeedAddr = listenAddr(cfg.FeedAddr)
+	cfg.RESTAddr = listenAddr(cfg.RESTAddr)
+	cfg.ConnectAddr = listenAddr(cfg.ConnectAddr)
+	if (cfg.TLSCert == "") != (cfg.TLSKey == "") {
+		return nil, fmt.Errorf("the TLS certificate and key must be set together")
+	}
+	if cfg.TLSClientCA != "" && cfg.TLSCert == "" {
+		return nil, fmt.Errorf("client certificate verification requires a TLS certificate and key")
+	}
+	if cfg.Plaintext && cfg.TLSCert != "" {
+		return nil, fmt.Errorf("plaintext cannot be enabled together with a TLS certificate")
+	}
+	switch cfg.DBDriver {
+	case DriverSQLite:
+		if cfg.DBPath == "" {
+			return nil, fmt.Errorf("database path cannot be empty")
+		}
+	case DriverPostgres:
+		if cfg.DBDSN == "" {
+			return nil, fmt.Errorf("database DSN is required for the postgres driver")
+		}
+	case DriverMarkdown:
+		if cfg.MarkdownDir == "" {
+			return nil, fmt.Errorf("markdown directory cannot be empty")
+		}
+	case DriverMemory:
+	default:
+		return nil, fmt.Errorf("unknown database driver %q", cfg.DBDriver)
+	}
+	if cfg.Archive && cfg.DBDriver != DriverSQLite {
+		return nil, fmt.Errorf("the entry archive requires the sqlite driver")
+	}
+	if cfg.SearchTokenizer == "" {
+		return nil, fmt.Errorf("search tokenizer cannot be empty")
+	}
+	if cfg.SearchTokenizer != defaultSearchTokenizer && cfg.DBDriver != DriverSQLite {
+		return nil, fmt.Errorf("the search tokenizer can only be changed with the sqlite driver")
+	}
+	if cfg.MarkdownGit && cfg.DBDriver != DriverMarkdown {
+		return nil, fmt.Errorf("git history requires the markdown driver")
+	}
+	if cfg.LogFormat != logging.FormatText && cfg.LogFormat != logging.FormatJSON {
+		return nil, fmt.Errorf("unknown log format %q", cfg.LogFormat)
+	}
+	if cfg.BusyTimeout < 0 {
+		return nil, fmt.Errorf("busy timeout cannot be negative")
+	}
+	if cfg.DBWait < 0 {
+		return nil, fmt.Errorf("database wait cannot be negative")
+	}
+	if cfg.MaintenanceInterval <= 0 {
+		return nil, fmt.Errorf("maintenance interval must be positive")
+	}
+	if cfg.MaintenanceWindow.Length() < maintenance.MinWindow {
+		return nil, fmt.Errorf("maintenance window must be at least %s long", maintenance.MinWindow)
+	}
+
+	return cfg, nil
+}
+
+// secret reads a sensitive setting from the environment variable name, or
+// from the file named by name+"_FILE" (as mounted by Docker or Kubernetes
+// secrets) so the value itself does not have to live in the environment.
+// Setting both is an error.
+func secret(name string, getenv func(string) string) (string, error) {
+	value, path := getenv(name), getenv(name+"_FILE")
+	if path == "" {
+		return value, nil
+	}
+	if value != "" {
+		return "", fmt.Errorf("only one of %s and %s_FILE can be set", name, name)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s_FILE: %w", name, err)
+	}
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
+
+// listenAddr accepts either a bare port or a host:port address.
+func listenAddr(addr string) string {
+	if addr != "" && !strings.Contains(addr, ":") {
+		return ":" + addr
+	}
+	return addr
+}
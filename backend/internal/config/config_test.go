@@ -0,0 +1,201 @@
+package config
+
+import (
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/parkernilson/micro-journal/internal/maintenance"
+)
+
+func TestLoad(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		cfg, err := Load(nil, func(string) string { return "" })
+		if err != nil {
+			t.Fatalf("Load failed: %v", err)
+		}
+		if *cfg != *Default() {
+			t.Errorf("Expected defaults %+v, got %+v", Default(), cfg)
+		}
+	})
+
+	t.Run("environment overrides defaults", func(t *testing.T) {
+		env := map[string]string{
+			"MJ_PORT":                 "6000",
+			"MJ_DB_PATH":              "/var/lib/mj/journal.db",
+			"MJ_AUTO_MIGRATE":         "true",
+			"MJ_ARCHIVE":              "1",
+			"MJ_FEED_TOKEN":           "secret",
+			"MJ_INBOX_DIR":            "/inbox",
+			"MJ_REST_PORT":            "8081",
+			"MJ_CONNECT_PORT":         "8082",
+			"MJ_BUSY_TIMEOUT":         "30s",
+			"MJ_LOG_LEVEL":            "debug",
+			"MJ_SEARCH_TOKENIZER":     "unicode61",
+			"MJ_OTLP_ENDPOINT":        "http://collector:4317",
+			"MJ_LOG_FORMAT":           "json",
+			"MJ_MAINTENANCE_INTERVAL": "1h",
+			"MJ_MAINTENANCE_WINDOW":   "02:00-05:00",
+		}
+		cfg, err := Load(nil, func(key string) string { return env[key] })
+		if err != nil {
+			t.Fatalf("Load failed: %v", err)
+		}
+		if cfg.ListenAddr != ":6000" {
+			t.Errorf("Expected listen address ':6000', got '%s'", cfg.ListenAddr)
+		}
+		if cfg.DBPath != "/var/lib/mj/journal.db" {
+			t.Errorf("Expected db path from environment, got '%s'", cfg.DBPath)
+		}
+		if cfg.RESTAddr != ":8081" || cfg.ConnectAddr != ":8082" {
+			t.Errorf("Expected REST and Connect addresses, got '%s' and '%s'", cfg.RESTAddr, cfg.ConnectAddr)
+		}
+		if cfg.BusyTimeout != 30*time.Second {
+			t.Errorf("Expected busy timeout 30s, got %v", cfg.BusyTimeout)
+		}
+		if cfg.MaintenanceInterval != time.Hour || cfg.MaintenanceWindow != (maintenance.Window{Start: 2 * time.Hour, End: 5 * time.Hour}) {
+			t.Errorf("Expected maintenance every hour from 02:00 to 05:00, got %v and %+v", cfg.MaintenanceInterval, cfg.MaintenanceWindow)
+		}
+		if cfg.OTLPEndpoint != "http://collector:4317" {
+			t.Errorf("Expected OTLP endpoint from environment, got '%s'", cfg.OTLPEndpoint)
+		}
+		if cfg.SearchTokenizer != "unicode61" {
+			t.Errorf("Expected search tokenizer from environment, got '%s'", cfg.SearchTokenizer)
+		}
+		if cfg.LogLevel != slog.LevelDebug || cfg.LogFormat != "json" {
+			t.Errorf("Expected debug JSON logging, got %v and '%s'", cfg.LogLevel, cfg.LogFormat)
+		}
+		if !cfg.AutoMigrate || !cfg.Archive || cfg.FeedToken != "secret" || cfg.InboxDir != "/inbox" {
+			t.Errorf("Expected environment settings, got %+v", cfg)
+		}
+	})
+
+	t.Run("flags override environment", func(t *testing.T) {
+		env := map[string]string{"MJ_PORT": "6000", "MJ_DB_PATH": "env.db"}
+		args := []string{"-port", "127.0.0.1:7000", "-db-path", "flag.db"}
+		cfg, err := Load(args, func(key string) string { return env[key] })
+		if err != nil {
+			t.Fatalf("Load failed: %v", err)
+		}
+		if cfg.ListenAddr != "127.0.0.1:7000" {
+			t.Errorf("Expected listen address from flag, got '%s'", cfg.ListenAddr)
+		}
+		if cfg.DBPath != "flag.db" {
+			t.Errorf("Expected db path from flag, got '%s'", cfg.DBPath)
+		}
+	})
+
+	t.Run("postgres driver", func(t *testing.T) {
+		env := map[string]string{"MJ_DB_DRIVER": "postgres", "MJ_DB_DSN": "postgres://localhost/journal", "MJ_DB_WAIT": "2m"}
+		cfg, err := Load(nil, func(key string) string { return env[key] })
+		if err != nil {
+			t.Fatalf("Load failed: %v", err)
+		}
+		if cfg.DBDriver != DriverPostgres || cfg.DBDSN != "postgres://localhost/journal" {
+			t.Errorf("Expected postgres driver and DSN, got '%s' and '%s'", cfg.DBDriver, cfg.DBDSN)
+		}
+		if cfg.DBWait != 2*time.Minute {
+			t.Errorf("Expected database wait 2m, got %v", cfg.DBWait)
+		}
+	})
+
+	t.Run("markdown driver with git", func(t *testing.T) {
+		env := map[string]string{"MJ_DB_DRIVER": "markdown", "MJ_MARKDOWN_GIT": "true"}
+		cfg, err := Load([]string{"-git-remote", "backup"}, func(key string) string { return env[key] })
+		if err != nil {
+			t.Fatalf("Load failed: %v", err)
+		}
+		if !cfg.MarkdownGit || cfg.GitRemote != "backup" {
+			t.Errorf("Expected git enabled with remote 'backup', got %v and '%s'", cfg.MarkdownGit, cfg.GitRemote)
+		}
+	})
+
+	t.Run("mutual TLS", func(t *testing.T) {
+		env := map[string]string{"MJ_TLS_CERT": "server.crt", "MJ_TLS_KEY": "server.key"}
+		cfg, err := Load([]string{"-tls-client-ca", "ca.crt"}, func(key string) string { return env[key] })
+		if err != nil {
+			t.Fatalf("Load failed: %v", err)
+		}
+		if cfg.TLSCert != "server.crt" || cfg.TLSKey != "server.key" || cfg.TLSClientCA != "ca.crt" {
+			t.Errorf("Expected TLS files from environment and flag, got %+v", cfg)
+		}
+	})
+
+	t.Run("invalid settings", func(t *testing.T) {
+		tests := []struct {
+			name string
+			args []string
+			env  map[string]string
+		}{
+			{"bad bool", nil, map[string]string{"MJ_AUTO_MIGRATE": "maybe"}},
+			{"bad plaintext", nil, map[string]string{"MJ_PLAINTEXT": "maybe"}},
+			{"TLS certificate without key", []string{"-tls-cert", "server.crt"}, nil},
+			{"client CA without certificate", []string{"-tls-client-ca", "ca.crt"}, nil},
+			{"plaintext with TLS", []string{"-plaintext", "-tls-cert", "server.crt", "-tls-key", "server.key"}, nil},
+			{"bad duration", nil, map[string]string{"MJ_BUSY_TIMEOUT": "soon"}},
+			{"negative busy timeout", []string{"-busy-timeout", "-1s"}, nil},
+			{"negative database wait", []string{"-db-wait", "-1s"}, nil},
+			{"bad database wait", nil, map[string]string{"MJ_DB_WAIT": "forever"}},
+			{"zero maintenance interval", []string{"-maintenance-interval", "0s"}, nil},
+			{"bad maintenance window", nil, map[string]string{"MJ_MAINTENANCE_WINDOW": "night"}},
+			{"bad maintenance window flag", []string{"-maintenance-window", "02:00"}, nil},
+			{"short maintenance window", []string{"-maintenance-window", "02:00-02:05"}, nil},
+			{"unknown driver", []string{"-db-driver", "mysql"}, nil},
+			{"postgres without DSN", []string{"-db-driver", "postgres"}, nil},
+			{"markdown without directory", []string{"-db-driver", "markdown", "-markdown-dir", ""}, nil},
+			{"memory with archive", []string{"-db-driver", "memory", "-archive"}, nil},
+			{"sqlite with git", []string{"-markdown-git"}, nil},
+			{"empty search tokenizer", []string{"-search-tokenizer", ""}, nil},
+			{"postgres with search tokenizer", []string{"-db-driver", "postgres", "-db-dsn", "postgres://localhost/journal", "-search-tokenizer", "trigram"}, nil},
+			{"bad log level", nil, map[string]string{"MJ_LOG_LEVEL": "loud"}},
+			{"unknown log format", []string{"-log-format", "xml"}, nil},
+			{"postgres with archive", []string{"-db-driver", "postgres", "-db-dsn", "postgres://localhost/journal", "-archive"}, nil},
+			{"unknown flag", []string{"-nope"}, nil},
+			{"empty db path", []string{"-db-path", ""}, nil},
+			{"extra arguments", []string{"extra"}, nil},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := Load(tt.args, func(key string) string { return tt.env[key] })
+				if err == nil {
+					t.Error("Expected error, got nil")
+				}
+			})
+		}
+	})
+}
+
+func TestLoad_SecretFiles(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "feed_token")
+	if err := os.WriteFile(path, []byte("from-file\n"), 0o600); err != nil {
+		t.Fatalf("failed to write secret file: %v", err)
+	}
+
+	t.Run("reads secret from file", func(t *testing.T) {
+		env := map[string]string{"MJ_FEED_TOKEN_FILE": path}
+		cfg, err := Load(nil, func(key string) string { return env[key] })
+		if err != nil {
+			t.Fatalf("Load failed: %v", err)
+		}
+		if cfg.FeedToken != "from-file" {
+			t.Errorf("Expected feed token 'from-file', got '%s'", cfg.FeedToken)
+		}
+	})
+
+	t.Run("rejects both sources", func(t *testing.T) {
+		env := map[string]string{"MJ_FEED_TOKEN": "inline", "MJ_FEED_TOKEN_FILE": path}
+		if _, err := Load(nil, func(key string) string { return env[key] }); err == nil {
+			t.Error("Expected error when both sources are set, got nil")
+		}
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		env := map[string]string{"MJ_FEED_TOKEN_FILE": filepath.Join(t.TempDir(), "missing")}
+		if _, err := Load(nil, func(key string) string { return env[key] }); err == nil {
+			t.Error("Expected error for missing secret file, got nil")
+		}
+	})
+}
@@ -0,0 +1,240 @@
+// Package dateparse turns human date phrases such as "last week",
+// "two summers ago", or "March 2023" into concrete time ranges.
+package dateparse
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Range is a half-open time range [Start, End).
+type Range struct {
+	Start time.Time
+	End   time.Time
+}
+
+// numberWords maps spelled-out counts to their values.
+var numberWords = map[string]int{
+	"a": 1, "an": 1, "one": 1, "two": 2, "three": 3, "four": 4, "five": 5,
+	"six": 6, "seven": 7, "eight": 8, "nine": 9, "ten": 10, "eleven": 11,
+	"twelve": 12,
+}
+
+// ordinalWords maps "last"-style words to how many periods back they mean.
+var ordinalWords = map[string]int{
+	"this": 0, "current": 0, "last": 1, "previous": 1, "past": 1,
+}
+
+// seasonStartMonths maps seasons to the month they start in (northern
+// hemisphere meteorological seasons, each three months long).
+var seasonStartMonths = map[string]time.Month{
+	"spring": time.March,
+	"summer": time.June,
+	"autumn": time.September,
+	"fall":   time.September,
+	"winter": time.December,
+}
+
+// Parse converts phrase into a time range relative to now, interpreting
+// calendar boundaries in now's location. Supported phrases include:
+//
+//	today, yesterday
+//	this/last week, month, year
+//	this/last <season>, <n> <season>s ago
+//	<n> days/weeks/months/years ago
+//	last/past <n> days/weeks/months/years
+//	<month>, <month> <year>, <year>, YYYY-MM-DD
+//	since <any of the above>
+func Parse(phrase string, now time.Time) (Range, error) {
+	words := strings.Fields(strings.ToLower(strings.TrimSpace(phrase)))
+	if len(words) == 0 {
+		return Range{}, fmt.Errorf("date phrase cannot be empty")
+	}
+
+	if words[0] == "since" {
+		r, err := parseWords(words[1:], now)
+		if err != nil {
+			return Range{}, err
+		}
+		return Range{Start: r.Start, End: now}, nil
+	}
+
+	return parseWords(words, now)
+}
+
+func parseWords(words []string, now time.Time) (Range, error) {
+	phrase := strings.Join(words, " ")
+	today := startOfDay(now)
+
+	switch len(words) {
+	case 0:
+		return Range{}, fmt.Errorf("date phrase cannot be empty")
+	case 1:
+		switch words[0] {
+		case "today":
+			return Range{Start: today, End: today.AddDate(0, 0, 1)}, nil
+		case "yesterday":
+			return Range{Start: today.AddDate(0, 0, -1), End: today}, nil
+		}
+		if month, ok := parseMonth(words[0]); ok {
+			year := now.Year()
+			if month > now.Month() {
+				year--
+			}
+			return monthRange(year, month, now.Location()), nil
+		}
+		if year, ok := parseYear(words[0]); ok {
+			return yearRange(year, now.Location()), nil
+		}
+		if day, err := time.ParseInLocation("2006-01-02", words[0], now.Location()); err == nil {
+			return Range{Start: day, End: day.AddDate(0, 0, 1)}, nil
+		}
+	case 2:
+		if back, ok := ordinalWords[words[0]]; ok {
+			if r, ok := periodRange(words[1], back, now); ok {
+				return r, nil
+			}
+		}
+		if month, ok := parseMonth(words[0]); ok {
+			if year, ok := parseYear(words[1]); ok {
+				return monthRange(year, month, now.Location()), nil
+			}
+		}
+	case 3:
+		// "<n> <unit>s ago"
+		if words[2] == "ago" {
+			if n, ok := parseCount(words[0]); ok {
+				if r, ok := periodRange(strings.TrimSuffix(words[1], "s"), n, now); ok {
+					return r, nil
+				}
+			}
+		}
+		// "last <n> <unit>s"
+		if _, ok := ordinalWords[words[0]]; ok && words[0] != "this" {
+			if n, ok := parseCount(words[1]); ok {
+				if start, ok := subtractUnits(today, strings.TrimSuffix(words[2], "s"), n); ok {
+					return Range{Start: start, End: now}, nil
+				}
+			}
+		}
+	}
+
+	return Range{}, fmt.Errorf("unrecognized date phrase %q", phrase)
+}
+
+// periodRange returns the calendar period (day, week, month, year, or
+// season) that is back periods before the one containing now.
+func periodRange(unit string, back int, now time.Time) (Range, bool) {
+	today := startOfDay(now)
+	loc := now.Location()
+
+	switch unit {
+	case "day":
+		start := today.AddDate(0, 0, -back)
+		return Range{Start: start, End: start.AddDate(0, 0, 1)}, true
+	case "week":
+		// Weeks start on Monday
+		offset := (int(today.Weekday()) + 6) % 7
+		start := today.AddDate(0, 0, -offset-7*back)
+		return Range{Start: start, End: start.AddDate(0, 0, 7)}, true
+	case "month":
+		first := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, loc).AddDate(0, -back, 0)
+		return monthRange(first.Year(), first.Month(), loc), true
+	case "year":
+		return yearRange(now.Year()-back, loc), true
+	}
+
+	if startMonth, ok := seasonStartMonths[unit]; ok {
+		return seasonRange(startMonth, back, now), true
+	}
+
+	return Range{}, false
+}
+
+// seasonRange returns the season starting in startMonth that is back
+// occurrences before the current one. The current occurrence is the most
+// recent one that has started. When now is past that season, "last" refers
+// to it rather than the one before, matching how people speak: in October,
+// "last summer" is the summer that just ended.
+func seasonRange(startMonth time.Month, back int, now time.Time) Range {
+	loc := now.Location()
+	start := time.Date(now.Year(), startMonth, 1, 0, 0, 0, 0, loc)
+	if start.After(now) {
+		start = start.AddDate(-1, 0, 0)
+	}
+	end := start.AddDate(0, 3, 0)
+
+	if back > 0 && !now.Before(end) {
+		// The most recent occurrence has already ended, so it is "last"
+		back--
+	}
+
+	start = start.AddDate(-back, 0, 0)
+	return Range{Start: start, End: start.AddDate(0, 3, 0)}
+}
+
+// subtractUnits moves t back n days, weeks, months, or years.
+func subtractUnits(t time.Time, unit string, n int) (time.Time, bool) {
+	switch unit {
+	case "day":
+		return t.AddDate(0, 0, -n), true
+	case "week":
+		return t.AddDate(0, 0, -7*n), true
+	case "month":
+		return t.AddDate(0, -n, 0), true
+	case "year":
+		return t.AddDate(-n, 0, 0), true
+	}
+	return time.Time{}, false
+}
+
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+func monthRange(year int, month time.Month, loc *time.Location) Range {
+	start := time.Date(year, month, 1, 0, 0, 0, 0, loc)
+	return Range{Start: start, End: start.AddDate(0, 1, 0)}
+}
+
+func yearRange(year int, loc *time.Location) Range {
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, loc)
+	return Range{Start: start, End: start.AddDate(1, 0, 0)}
+}
+
+// parseCount parses a count written as digits or as a word.
+func parseCount(s string) (int, bool) {
+	if n, ok := numberWords[s]; ok {
+		return n, true
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 0 {
+		return 0, false
+	}
+	return n, true
+}
+
+// parseMonth parses a full or three-letter English month name.
+func parseMonth(s string) (time.Month, bool) {
+	for m := time.January; m <= time.December; m++ {
+		name := strings.ToLower(m.String())
+		if s == name || s == name[:3] {
+			return m, true
+		}
+	}
+	return 0, false
+}
+
+// parseYear parses a four-digit year.
+func parseYear(s string) (int, bool) {
+	if len(s) != 4 {
+		return 0, false
+	}
+	year, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, false
+	}
+	return year, true
+}
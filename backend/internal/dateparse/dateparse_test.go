@@ -0,0 +1,83 @@
+package dateparse
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	// Wednesday, October 16, 2024 at 15:30 UTC
+	now := time.Date(2024, 10, 16, 15, 30, 0, 0, time.UTC)
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		phrase string
+		want   Range
+	}{
+		{"today", Range{date(2024, 10, 16), date(2024, 10, 17)}},
+		{"Yesterday", Range{date(2024, 10, 15), date(2024, 10, 16)}},
+		{"this week", Range{date(2024, 10, 14), date(2024, 10, 21)}},
+		{"last week", Range{date(2024, 10, 7), date(2024, 10, 14)}},
+		{"this month", Range{date(2024, 10, 1), date(2024, 11, 1)}},
+		{"last month", Range{date(2024, 9, 1), date(2024, 10, 1)}},
+		{"last year", Range{date(2023, 1, 1), date(2024, 1, 1)}},
+		{"3 days ago", Range{date(2024, 10, 13), date(2024, 10, 14)}},
+		{"two months ago", Range{date(2024, 8, 1), date(2024, 9, 1)}},
+		{"last 7 days", Range{date(2024, 10, 9), now}},
+		{"past two weeks", Range{date(2024, 10, 2), now}},
+		{"last summer", Range{date(2024, 6, 1), date(2024, 9, 1)}},
+		{"two summers ago", Range{date(2023, 6, 1), date(2023, 9, 1)}},
+		{"this fall", Range{date(2024, 9, 1), date(2024, 12, 1)}},
+		{"last autumn", Range{date(2023, 9, 1), date(2023, 12, 1)}},
+		{"last winter", Range{date(2023, 12, 1), date(2024, 3, 1)}},
+		{"March 2023", Range{date(2023, 3, 1), date(2023, 4, 1)}},
+		{"mar 2023", Range{date(2023, 3, 1), date(2023, 4, 1)}},
+		{"march", Range{date(2024, 3, 1), date(2024, 4, 1)}},
+		{"december", Range{date(2023, 12, 1), date(2024, 1, 1)}},
+		{"2022", Range{date(2022, 1, 1), date(2023, 1, 1)}},
+		{"2024-02-29", Range{date(2024, 2, 29), date(2024, 3, 1)}},
+		{"since last month", Range{date(2024, 9, 1), now}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.phrase, func(t *testing.T) {
+			got, err := Parse(tt.phrase, now)
+			if err != nil {
+				t.Fatalf("Parse failed: %v", err)
+			}
+			if !got.Start.Equal(tt.want.Start) || !got.End.Equal(tt.want.End) {
+				t.Errorf("Expected [%v, %v), got [%v, %v)", tt.want.Start, tt.want.End, got.Start, got.End)
+			}
+		})
+	}
+}
+
+func TestParse_Location(t *testing.T) {
+	loc := time.FixedZone("UTC-8", -8*60*60)
+	// 02:00 UTC on October 17 is still October 16 at UTC-8
+	now := time.Date(2024, 10, 17, 2, 0, 0, 0, time.UTC).In(loc)
+
+	got, err := Parse("today", now)
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	want := time.Date(2024, 10, 16, 0, 0, 0, 0, loc)
+	if !got.Start.Equal(want) {
+		t.Errorf("Expected start %v, got %v", want, got.Start)
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	now := time.Date(2024, 10, 16, 15, 30, 0, 0, time.UTC)
+
+	for _, phrase := range []string{"", "   ", "next tuesday", "last fortnight", "13 2024", "since"} {
+		t.Run(phrase, func(t *testing.T) {
+			if _, err := Parse(phrase, now); err == nil {
+				t.Errorf("Expected error for %q, got nil", phrase)
+			}
+		})
+	}
+}
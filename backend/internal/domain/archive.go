@@ -0,0 +1,14 @@
+package domain
+
+// ArchiveReport is the result of verifying the entry archive.
+type ArchiveReport struct {
+	// RecordCount is the number of records in the archive.
+	RecordCount int64
+	// HeadHash is the hash of the newest record, or empty if the archive is
+	// empty. Keeping a copy of it elsewhere lets a later verification detect
+	// the whole chain being rewritten.
+	HeadHash string
+	// Problems describes every inconsistency found. The archive verified
+	// cleanly if it is empty.
+	Problems []string
+}
@@ -0,0 +1,91 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DocumentVersion is the current version of the structured document schema.
+const DocumentVersion = 1
+
+// SectionType identifies the kind of content a Section holds.
+type SectionType string
+
+const (
+	SectionText      SectionType = "text"
+	SectionChecklist SectionType = "checklist"
+	SectionRating    SectionType = "rating"
+	SectionPhoto     SectionType = "photo"
+)
+
+// Document is the structured content of an entry: an ordered list of typed
+// sections. It is persisted as versioned JSON alongside the plain-text
+// Content, which holds a readable rendering of the document.
+type Document struct {
+	Version  int       `json:"version"`
+	Sections []Section `json:"sections"`
+}
+
+// Section is a single typed block of a Document. Which fields are used
+// depends on Type.
+type Section struct {
+	Type    SectionType `json:"type"`
+	Heading string      `json:"heading,omitempty"`
+
+	// Text holds the body of a text section.
+	Text string `json:"text,omitempty"`
+
+	// Items holds the entries of a checklist section.
+	Items []ChecklistItem `json:"items,omitempty"`
+
+	// Rating and MaxRating hold the score of a rating section.
+	Rating    int `json:"rating,omitempty"`
+	MaxRating int `json:"max_rating,omitempty"`
+
+	// PhotoURL and Caption describe a photo section.
+	PhotoURL string `json:"photo_url,omitempty"`
+	Caption  string `json:"caption,omitempty"`
+}
+
+// ChecklistItem is a single item of a checklist section.
+type ChecklistItem struct {
+	Text    string `json:"text"`
+	Checked bool   `json:"checked,omitempty"`
+}
+
+// PlainText renders the document as readable plain text, used as the
+// entry's Content so listing, search, and feeds work without understanding
+// sections.
+func (d *Document) PlainText() string {
+	blocks := make([]string, 0, len(d.Sections))
+	for _, section := range d.Sections {
+		var lines []string
+		if section.Heading != "" {
+			lines = append(lines, section.Heading)
+		}
+
+		switch section.Type {
+		case SectionText:
+			lines = append(lines, section.Text)
+		case SectionChecklist:
+			for _, item := range section.Items {
+				mark := " "
+				if item.Checked {
+					mark = "x"
+				}
+				lines = append(lines, fmt.Sprintf("- [%s] %s", mark, item.Text))
+			}
+		case SectionRating:
+			lines = append(lines, fmt.Sprintf("%d/%d", section.Rating, section.MaxRating))
+		case SectionPhoto:
+			if section.Caption != "" {
+				lines = append(lines, section.Caption)
+			}
+			lines = append(lines, section.PhotoURL)
+		}
+
+		blocks = append(blocks, strings.Join(lines, "\n"))
+	}
+
+	return strings.Join(blocks, "\n\n")
+}
@@ -0,0 +1,18 @@
+package domain
+
+import "errors"
+
+// Kinds of errors returned by stores and the manager. They are wrapped with
+// details, so check for them with errors.Is.
+var (
+	// ErrNotFound means the entry, revision, or tag does not exist.
+	ErrNotFound = errors.New("not found")
+	// ErrValidation means the input is invalid, such as an empty title.
+	ErrValidation = errors.New("invalid input")
+	// ErrFailedPrecondition means the input is valid but the entry is not
+	// in a state that allows the operation, such as being sealed.
+	ErrFailedPrecondition = errors.New("failed precondition")
+	// ErrConflict means the entry changed since the version the caller
+	// expected, so the caller should read it again before retrying.
+	ErrConflict = errors.New("version conflict")
+)
@@ -10,4 +10,47 @@ type JournalEntry struct {
 	Content   string
 	CreatedAt time.Time
 	UpdatedAt time.Time
+
+	// Version starts at 1 and is incremented each time the entry's content
+	// changes, so a client can tell whether the entry changed since it read it.
+	Version int64
+
+	// RevealAt is when a sealed entry becomes readable. The zero value means
+	// the entry is never sealed.
+	RevealAt time.Time
+
+	// Document is the structured content of the entry, or nil for a
+	// plain-text entry. Content always holds a plain-text rendering.
+	Document *Document
+
+	// Tags are the entry's normalized tag names, sorted.
+	Tags []string
+
+	// DeletedAt is when the entry was moved to the trash. The zero value
+	// means the entry is not in the trash.
+	DeletedAt time.Time
+
+	// CommitHash is the git commit that last changed the entry, set only by
+	// stores that keep the journal in a git repository.
+	CommitHash string
+
+	// Sealed is set by the manager when Content and Document are withheld because RevealAt
+	// has not been reached yet.
+	Sealed bool
+}
+
+// EntryFilter restricts which entries a listing returns.
+// Zero-valued fields do not filter.
+type EntryFilter struct {
+	// CreatedFrom is the inclusive lower bound on CreatedAt.
+	CreatedFrom time.Time
+	// CreatedUntil is the exclusive upper bound on CreatedAt.
+	CreatedUntil time.Time
+	// Tag restricts results to entries with this tag.
+	Tag string
+}
+
+// IsSealedAt reports whether the entry is still sealed at time t.
+func (e *JournalEntry) IsSealedAt(t time.Time) bool {
+	return !e.RevealAt.IsZero() && t.Before(e.RevealAt)
 }
@@ -0,0 +1,19 @@
+package domain
+
+import "time"
+
+// Revision is a previous version of a journal entry, saved when the entry was
+// updated.
+type Revision struct {
+	ID      int64
+	EntryID int64
+	Title   string
+	Content string
+
+	// Document is the structured content of this version, or nil if it was
+	// plain text.
+	Document *Document
+
+	// CreatedAt is when this version was written.
+	CreatedAt time.Time
+}
@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// JournalStore is the storage backend for journal entries. The manager
+// depends only on this interface, so backends can be swapped by
+// configuration and the manager can be tested with a mock store.
+//
+// Implementations must be read-after-write consistent: the entry returned by
+// Create or Update reflects the committed row, and any GetByID or List call
+// that starts after the write returns must observe it.
+//
+// Update only applies when expectedVersion is 0 or matches the entry's
+// Version, and otherwise fails with ErrConflict. The check and the write are
+// atomic.
+//
+// Search queries are passed as space-separated quoted terms that must all
+// match, where a term followed by '*' matches as a prefix, for example
+// `"coffee" "morn"*`.
+type JournalStore interface {
+	Create(ctx context.Context, title, content string, revealAt time.Time, doc *Document, tags []string) (*JournalEntry, error)
+	GetByID(ctx context.Context, id int64) (*JournalEntry, error)
+	Update(ctx context.Context, id int64, title, content string, doc *Document, tags []string, expectedVersion int64) (*JournalEntry, error)
+	Delete(ctx context.Context, id int64) error
+	Purge(ctx context.Context, id int64) error
+	List(ctx context.Context, filter EntryFilter, limit, offset int) ([]*JournalEntry, int64, error)
+	Search(ctx context.Context, query string, limit, offset int) ([]*JournalEntry, int64, error)
+	ListTags(ctx context.Context) ([]*Tag, error)
+	RenameTag(ctx context.Context, name, newName string) (*Tag, error)
+	DeleteTag(ctx context.Context, name string) error
+	ListTrash(ctx context.Context, limit, offset int) ([]*JournalEntry, int64, error)
+	Restore(ctx context.Context, id int64) (*JournalEntry, error)
+	PurgeTrash(ctx context.Context, deletedBefore time.Time) (int64, error)
+	ListRevisions(ctx context.Context, entryID int64, limit, offset int) ([]*Revision, int64, error)
+	SearchRevisions(ctx context.Context, entryID int64, query string, limit, offset int) ([]*Revision, int64, error)
+	RestoreRevision(ctx context.Context, entryID, revisionID int64) (*JournalEntry, error)
+	VerifyArchive(ctx context.Context) (*ArchiveReport, error)
+	Export(ctx context.Context, fn func(entry *JournalEntry) error) error
+	Import(ctx context.Context, entries []*JournalEntry) (int64, error)
+
+	// WithTx runs fn in one transaction: its writes are committed together if
+	// fn returns nil and discarded if it returns an error. fn must use only tx,
+	// not the store, until it returns.
+	WithTx(ctx context.Context, fn func(tx JournalTx) error) error
+}
+
+// JournalTx is the part of JournalStore available inside WithTx. Its methods
+// behave like the JournalStore methods of the same name.
+type JournalTx interface {
+	Create(ctx context.Context, title, content string, revealAt time.Time, doc *Document, tags []string) (*JournalEntry, error)
+	GetByID(ctx context.Context, id int64) (*JournalEntry, error)
+	Delete(ctx context.Context, id int64) error
+	Purge(ctx context.Context, id int64) error
+}
+
+// BackupPusher is implemented by stores that can push a copy of the journal
+// to a remote. PushBackup returns an identifier of the version pushed.
+type BackupPusher interface {
+	PushBackup(ctx context.Context) (string, error)
+}
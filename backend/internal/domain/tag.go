@@ -0,0 +1,8 @@
+package domain
+
+// Tag is a label shared by one or more journal entries.
+type Tag struct {
+	Name string
+	// EntryCount is the number of entries with this tag.
+	EntryCount int64
+}
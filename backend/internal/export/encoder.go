@@ -0,0 +1,190 @@
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/parkernilson/micro-journal/internal/domain"
+)
+
+// Format is a journal export format.
+type Format int
+
+const (
+	// FormatJSONLines writes one JSON object per entry to journal.jsonl.
+	FormatJSONLines Format = iota
+	// FormatMarkdown writes one Markdown file with front matter per entry.
+	FormatMarkdown
+	// FormatCSV writes one row per entry to journal.csv.
+	FormatCSV
+)
+
+// maxFilenameTitle is the maximum number of title characters used in a
+// Markdown file name.
+const maxFilenameTitle = 60
+
+// Chunk is a piece of exported output: Data is appended to the file Filename.
+type Chunk struct {
+	Filename string
+	Data     []byte
+}
+
+// Encoder converts entries to chunks of an export, one entry at a time.
+type Encoder interface {
+	Encode(entry *domain.JournalEntry) (Chunk, error)
+}
+
+// NewEncoder creates an Encoder for format.
+func NewEncoder(format Format) (Encoder, error) {
+	switch format {
+	case FormatJSONLines:
+		return &jsonLinesEncoder{}, nil
+	case FormatMarkdown:
+		return &markdownEncoder{names: map[string]int{}}, nil
+	case FormatCSV:
+		return &csvEncoder{}, nil
+	default:
+		return nil, fmt.Errorf("unknown export format: %d", format)
+	}
+}
+
+// exportedEntry is the JSON Lines form of an entry.
+type exportedEntry struct {
+	ID        int64            `json:"id"`
+	Title     string           `json:"title"`
+	Content   string           `json:"content"`
+	Document  *domain.Document `json:"document,omitempty"`
+	Tags      []string         `json:"tags"`
+	CreatedAt time.Time        `json:"created_at"`
+	UpdatedAt time.Time        `json:"updated_at"`
+	RevealAt  *time.Time       `json:"reveal_at,omitempty"`
+	Sealed    bool             `json:"sealed,omitempty"`
+}
+
+type jsonLinesEncoder struct{}
+
+func (e *jsonLinesEncoder) Encode(entry *domain.JournalEntry) (Chunk, error) {
+	exported := exportedEntry{
+		ID:        entry.ID,
+		Title:     entry.Title,
+		Content:   entry.Content,
+		Document:  entry.Document,
+		Tags:      entry.Tags,
+		CreatedAt: entry.CreatedAt.UTC(),
+		UpdatedAt: entry.UpdatedAt.UTC(),
+		Sealed:    entry.Sealed,
+	}
+	if exported.Tags == nil {
+		exported.Tags = []string{}
+	}
+	if !entry.RevealAt.IsZero() {
+		revealAt := entry.RevealAt.UTC()
+		exported.RevealAt = &revealAt
+	}
+
+	data, err := json.Marshal(exported)
+	if err != nil {
+		return Chunk{}, fmt.Errorf("failed to encode entry %d: %w", entry.ID, err)
+	}
+	return Chunk{Filename: "journal.jsonl", Data: append(data, '\n')}, nil
+}
+
+type csvEncoder struct {
+	wroteHeader bool
+}
+
+func (e *csvEncoder) Encode(entry *domain.JournalEntry) (Chunk, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	if !e.wroteHeader {
+		w.Write([]string{"id", "title", "content", "tags", "created_at", "updated_at", "reveal_at", "sealed"})
+		e.wroteHeader = true
+	}
+
+	w.Write([]string{
+		strconv.FormatInt(entry.ID, 10),
+		entry.Title,
+		entry.Content,
+		strings.Join(entry.Tags, ";"),
+		entry.CreatedAt.UTC().Format(time.RFC3339),
+		entry.UpdatedAt.UTC().Format(time.RFC3339),
+		formatOptional(entry.RevealAt),
+		strconv.FormatBool(entry.Sealed),
+	})
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return Chunk{}, fmt.Errorf("failed to encode entry %d: %w", entry.ID, err)
+	}
+
+	return Chunk{Filename: "journal.csv", Data: buf.Bytes()}, nil
+}
+
+// markdownEncoder writes each entry as its own file, named after its date and
+// title, with front matter that the inbox can read back in.
+type markdownEncoder struct {
+	// names counts how often each file name has been used.
+	names map[string]int
+}
+
+func (e *markdownEncoder) Encode(entry *domain.JournalEntry) (Chunk, error) {
+	var buf bytes.Buffer
+	buf.WriteString("---\n")
+	fmt.Fprintf(&buf, "title: %s\n", strconv.Quote(entry.Title))
+	fmt.Fprintf(&buf, "created_at: %s\n", entry.CreatedAt.UTC().Format(time.RFC3339))
+	if !entry.RevealAt.IsZero() {
+		fmt.Fprintf(&buf, "reveal_at: %s\n", entry.RevealAt.UTC().Format(time.RFC3339))
+	}
+	if len(entry.Tags) > 0 {
+		fmt.Fprintf(&buf, "tags: [%s]\n", strings.Join(entry.Tags, ", "))
+	}
+	if entry.Sealed {
+		buf.WriteString("sealed: true\n")
+	}
+	buf.WriteString("---\n\n")
+	if entry.Content != "" {
+		buf.WriteString(entry.Content)
+		buf.WriteString("\n")
+	}
+
+	return Chunk{Filename: e.filename(entry), Data: buf.Bytes()}, nil
+}
+
+// filename returns a unique "YYYY-MM-DD Title.md" name for entry, with
+// characters that are unsafe in file names removed.
+func (e *markdownEncoder) filename(entry *domain.JournalEntry) string {
+	title := []rune(strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`/\:*?"<>|`, r) || unicode.IsControl(r) {
+			return -1
+		}
+		return r
+	}, strings.TrimSpace(entry.Title)))
+	if len(title) > maxFilenameTitle {
+		title = title[:maxFilenameTitle]
+	}
+
+	base := entry.CreatedAt.UTC().Format("2006-01-02")
+	if t := strings.Join(strings.Fields(string(title)), " "); t != "" {
+		base += " " + t
+	}
+
+	e.names[base]++
+	if n := e.names[base]; n > 1 {
+		return fmt.Sprintf("%s (%d).md", base, n)
+	}
+	return base + ".md"
+}
+
+// formatOptional formats t as RFC 3339, or returns "" for the zero time.
+func formatOptional(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
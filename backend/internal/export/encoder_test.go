@@ -0,0 +1,101 @@
+package export
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/parkernilson/micro-journal/internal/domain"
+)
+
+func TestEncoders(t *testing.T) {
+	created := time.Date(2024, 3, 5, 14, 30, 0, 0, time.UTC)
+	entry := &domain.JournalEntry{
+		ID:        7,
+		Title:     `Trip: "Lisbon"`,
+		Content:   "Day one,\nsunny.",
+		CreatedAt: created,
+		UpdatedAt: created,
+		Tags:      []string{"travel", "work"},
+	}
+
+	t.Run("json lines", func(t *testing.T) {
+		enc, err := NewEncoder(FormatJSONLines)
+		if err != nil {
+			t.Fatalf("NewEncoder failed: %v", err)
+		}
+		chunk, err := enc.Encode(entry)
+		if err != nil {
+			t.Fatalf("Encode failed: %v", err)
+		}
+		if chunk.Filename != "journal.jsonl" || !strings.HasSuffix(string(chunk.Data), "}\n") {
+			t.Fatalf("Expected one JSON line in journal.jsonl, got %s: %q", chunk.Filename, chunk.Data)
+		}
+
+		var decoded exportedEntry
+		if err := json.Unmarshal(chunk.Data, &decoded); err != nil {
+			t.Fatalf("invalid JSON: %v", err)
+		}
+		if decoded.ID != 7 || decoded.Content != entry.Content || len(decoded.Tags) != 2 {
+			t.Errorf("Unexpected entry %+v", decoded)
+		}
+	})
+
+	t.Run("csv writes the header once", func(t *testing.T) {
+		enc, err := NewEncoder(FormatCSV)
+		if err != nil {
+			t.Fatalf("NewEncoder failed: %v", err)
+		}
+		first, err := enc.Encode(entry)
+		if err != nil {
+			t.Fatalf("Encode failed: %v", err)
+		}
+		second, err := enc.Encode(entry)
+		if err != nil {
+			t.Fatalf("Encode failed: %v", err)
+		}
+		if !strings.HasPrefix(string(first.Data), "id,title,") {
+			t.Errorf("Expected header in first chunk, got %q", first.Data)
+		}
+		if strings.HasPrefix(string(second.Data), "id,title,") {
+			t.Errorf("Expected no header in second chunk, got %q", second.Data)
+		}
+		if !strings.Contains(string(second.Data), `"Day one,`+"\n"+`sunny."`) || !strings.Contains(string(second.Data), "travel;work") {
+			t.Errorf("Unexpected row %q", second.Data)
+		}
+	})
+
+	t.Run("markdown file per entry", func(t *testing.T) {
+		enc, err := NewEncoder(FormatMarkdown)
+		if err != nil {
+			t.Fatalf("NewEncoder failed: %v", err)
+		}
+		chunk, err := enc.Encode(entry)
+		if err != nil {
+			t.Fatalf("Encode failed: %v", err)
+		}
+		if chunk.Filename != `2024-03-05 Trip Lisbon.md` {
+			t.Errorf("Unexpected file name %q", chunk.Filename)
+		}
+
+		want := "---\ntitle: \"Trip: \\\"Lisbon\\\"\"\ncreated_at: 2024-03-05T14:30:00Z\ntags: [travel, work]\n---\n\nDay one,\nsunny.\n"
+		if string(chunk.Data) != want {
+			t.Errorf("Expected %q, got %q", want, chunk.Data)
+		}
+
+		again, err := enc.Encode(entry)
+		if err != nil {
+			t.Fatalf("Encode failed: %v", err)
+		}
+		if again.Filename != `2024-03-05 Trip Lisbon (2).md` {
+			t.Errorf("Expected a distinct name for a second entry, got %q", again.Filename)
+		}
+	})
+
+	t.Run("unknown format", func(t *testing.T) {
+		if _, err := NewEncoder(Format(99)); err == nil {
+			t.Error("Expected error for unknown format, got nil")
+		}
+	})
+}
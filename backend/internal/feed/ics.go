@@ -0,0 +1,150 @@
+package feed
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/parkernilson/micro-journal/internal/domain"
+	"github.com/parkernilson/micro-journal/internal/manager"
+)
+
+// feedPageSize is the page size used when reading entries for the feed.
+const feedPageSize = 100
+
+// maxLineOctets is the maximum length of an iCalendar content line before
+// it must be folded (RFC 5545 section 3.1).
+const maxLineOctets = 75
+
+// JournalManager defines the subset of the manager layer the feed needs.
+type JournalManager interface {
+	ListEntries(ctx context.Context, pageSize int32, pageToken string, opts manager.ListOptions) (*manager.ListEntriesResult, error)
+}
+
+// ICSHandler serves journal entries as an iCalendar feed where each entry is
+// an all-day event on the day it was written.
+type ICSHandler struct {
+	manager  JournalManager
+	token    string
+	linkBase string
+}
+
+// NewICSHandler creates a new instance of ICSHandler.
+// Requests must carry token in the "token" query parameter. If linkBase is
+// non-empty, each event links to linkBase followed by the entry ID.
+func NewICSHandler(manager JournalManager, token, linkBase string) *ICSHandler {
+	return &ICSHandler{manager: manager, token: token, linkBase: linkBase}
+}
+
+// ServeHTTP implements http.Handler.
+func (h *ICSHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	token := r.URL.Query().Get("token")
+	if subtle.ConstantTimeCompare([]byte(token), []byte(h.token)) != 1 {
+		http.Error(w, "invalid feed token", http.StatusUnauthorized)
+		return
+	}
+
+	entries, err := h.listAll(r.Context())
+	if err != nil {
+		slog.ErrorContext(r.Context(), "feed failed to list entries", "error", err)
+		http.Error(w, "failed to list entries", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Write([]byte(h.render(entries)))
+}
+
+// listAll reads every entry by following page tokens.
+func (h *ICSHandler) listAll(ctx context.Context) ([]*domain.JournalEntry, error) {
+	var entries []*domain.JournalEntry
+	pageToken := ""
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		result, err := h.manager.ListEntries(ctx, feedPageSize, pageToken, manager.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, result.Entries...)
+		if result.NextPageToken == "" {
+			return entries, nil
+		}
+		pageToken = result.NextPageToken
+	}
+}
+
+// render builds the iCalendar document for entries.
+func (h *ICSHandler) render(entries []*domain.JournalEntry) string {
+	var b strings.Builder
+	writeLine(&b, "BEGIN:VCALENDAR")
+	writeLine(&b, "VERSION:2.0")
+	writeLine(&b, "PRODID:-//Micro Journal//Journal Feed//EN")
+	writeLine(&b, "CALSCALE:GREGORIAN")
+	writeLine(&b, "X-WR-CALNAME:Micro Journal")
+
+	for _, entry := range entries {
+		day := entry.CreatedAt.Local()
+		start := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, time.UTC)
+
+		writeLine(&b, "BEGIN:VEVENT")
+		writeLine(&b, fmt.Sprintf("UID:entry-%d@micro-journal", entry.ID))
+		writeLine(&b, "DTSTAMP:"+entry.UpdatedAt.UTC().Format("20060102T150405Z"))
+		writeLine(&b, "DTSTART;VALUE=DATE:"+start.Format("20060102"))
+		writeLine(&b, "DTEND;VALUE=DATE:"+start.AddDate(0, 0, 1).Format("20060102"))
+		writeLine(&b, "SUMMARY:"+escapeText(entry.Title))
+		if h.linkBase != "" {
+			writeLine(&b, fmt.Sprintf("URL:%s%d", h.linkBase, entry.ID))
+		}
+		writeLine(&b, "TRANSP:TRANSPARENT")
+		writeLine(&b, "END:VEVENT")
+	}
+
+	writeLine(&b, "END:VCALENDAR")
+	return b.String()
+}
+
+// escapeText escapes a value for an iCalendar TEXT property.
+func escapeText(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+	).Replace(s)
+}
+
+// writeLine writes a CRLF-terminated content line, folding it so that no
+// physical line exceeds maxLineOctets. Folds never split a UTF-8 sequence.
+func writeLine(b *strings.Builder, line string) {
+	limit := maxLineOctets
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !isRuneStart(line[cut]) {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		// Continuation lines begin with a space, which counts toward the limit
+		limit = maxLineOctets - 1
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
+
+// isRuneStart reports whether c can begin a UTF-8 encoded rune.
+func isRuneStart(c byte) bool {
+	return c&0xC0 != 0x80
+}
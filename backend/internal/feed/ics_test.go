@@ -0,0 +1,134 @@
+package feed
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/parkernilson/micro-journal/internal/domain"
+	"github.com/parkernilson/micro-journal/internal/manager"
+)
+
+// mockJournalManager is a mock implementation of JournalManager for testing.
+type mockJournalManager struct {
+	listEntriesFunc func(ctx context.Context, pageSize int32, pageToken string, opts manager.ListOptions) (*manager.ListEntriesResult, error)
+}
+
+func (m *mockJournalManager) ListEntries(ctx context.Context, pageSize int32, pageToken string, opts manager.ListOptions) (*manager.ListEntriesResult, error) {
+	if m.listEntriesFunc != nil {
+		return m.listEntriesFunc(ctx, pageSize, pageToken, opts)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func TestICSHandler_ServeHTTP(t *testing.T) {
+	createdAt := time.Date(2024, 3, 15, 12, 0, 0, 0, time.UTC)
+
+	mockManager := &mockJournalManager{
+		listEntriesFunc: func(ctx context.Context, pageSize int32, pageToken string, opts manager.ListOptions) (*manager.ListEntriesResult, error) {
+			switch pageToken {
+			case "":
+				return &manager.ListEntriesResult{
+					Entries: []*domain.JournalEntry{
+						{ID: 2, Title: "Coffee, then code", CreatedAt: createdAt, UpdatedAt: createdAt},
+					},
+					NextPageToken: "page-2",
+					TotalCount:    2,
+				}, nil
+			case "page-2":
+				return &manager.ListEntriesResult{
+					Entries: []*domain.JournalEntry{
+						{ID: 1, Title: "First entry", CreatedAt: createdAt.AddDate(0, 0, -1), UpdatedAt: createdAt},
+					},
+					TotalCount: 2,
+				}, nil
+			}
+			return nil, errors.New("unexpected page token")
+		},
+	}
+
+	handler := NewICSHandler(mockManager, "secret", "microjournal://entries/")
+
+	t.Run("valid token", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/feed.ics?token=secret", nil)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("Expected status 200, got %d", rec.Code)
+		}
+		if ct := rec.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/calendar") {
+			t.Errorf("Expected text/calendar content type, got '%s'", ct)
+		}
+
+		body := rec.Body.String()
+		for _, want := range []string{
+			"BEGIN:VCALENDAR\r\n",
+			"UID:entry-2@micro-journal\r\n",
+			"DTSTART;VALUE=DATE:20240315\r\n",
+			"DTEND;VALUE=DATE:20240316\r\n",
+			"SUMMARY:Coffee\\, then code\r\n",
+			"URL:microjournal://entries/2\r\n",
+			"UID:entry-1@micro-journal\r\n",
+			"END:VCALENDAR\r\n",
+		} {
+			if !strings.Contains(body, want) {
+				t.Errorf("Expected feed to contain %q, got:\n%s", want, body)
+			}
+		}
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/feed.ics?token=wrong", nil)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusUnauthorized {
+			t.Errorf("Expected status 401, got %d", rec.Code)
+		}
+	})
+
+	t.Run("missing token", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/feed.ics", nil)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusUnauthorized {
+			t.Errorf("Expected status 401, got %d", rec.Code)
+		}
+	})
+
+	t.Run("manager error", func(t *testing.T) {
+		handler := NewICSHandler(&mockJournalManager{}, "secret", "")
+		req := httptest.NewRequest(http.MethodGet, "/feed.ics?token=secret", nil)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusInternalServerError {
+			t.Errorf("Expected status 500, got %d", rec.Code)
+		}
+	})
+}
+
+func TestWriteLine_Folding(t *testing.T) {
+	var b strings.Builder
+	writeLine(&b, "SUMMARY:"+strings.Repeat("é", 100))
+
+	for _, line := range strings.Split(strings.TrimSuffix(b.String(), "\r\n"), "\r\n") {
+		if len(line) > maxLineOctets {
+			t.Errorf("Expected line of at most %d octets, got %d", maxLineOctets, len(line))
+		}
+		if !strings.HasPrefix(line, "SUMMARY:") && !strings.HasPrefix(line, " ") {
+			t.Errorf("Expected continuation line to start with a space, got %q", line)
+		}
+	}
+
+	unfolded := strings.ReplaceAll(b.String(), "\r\n ", "")
+	if unfolded != "SUMMARY:"+strings.Repeat("é", 100)+"\r\n" {
+		t.Errorf("Expected unfolded line to match input, got %q", unfolded)
+	}
+}
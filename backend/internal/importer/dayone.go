@@ -0,0 +1,125 @@
+package importer
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/parkernilson/micro-journal/internal/domain"
+)
+
+// dayOneMoment matches the image links Day One puts in entry text where a
+// photo or other attachment is placed.
+var dayOneMoment = regexp.MustCompile(`!\[[^\]]*\]\(dayone-moment:/*[^)]*\)`)
+
+// dayOneEscape matches the backslash escapes Day One adds before Markdown
+// punctuation.
+var dayOneEscape = regexp.MustCompile(`\\([!-/:-@\[-` + "`" + `{-~])`)
+
+// dayOneExport is the Journal.json file in a Day One JSON export.
+type dayOneExport struct {
+	Entries []dayOneEntry `json:"entries"`
+}
+
+type dayOneEntry struct {
+	UUID         string        `json:"uuid"`
+	CreationDate time.Time     `json:"creationDate"`
+	Text         string        `json:"text"`
+	Tags         []string      `json:"tags"`
+	Photos       []dayOnePhoto `json:"photos"`
+}
+
+type dayOnePhoto struct {
+	MD5  string `json:"md5"`
+	Type string `json:"type"`
+}
+
+// ParseDayOne parses the Journal.json file of a Day One JSON export.
+//
+// A leading "# " heading becomes the entry title; otherwise the title is
+// left empty to be suggested from the content. Day One's attachment links
+// are removed from the text. Photos become photo sections of a document
+// whose URLs are the photos' paths inside the export, such as
+// "photos/<md5>.jpeg", so they resolve if the export folder is served.
+func ParseDayOne(r io.Reader) ([]*domain.JournalEntry, error) {
+	var export dayOneExport
+	if err := json.NewDecoder(r).Decode(&export); err != nil {
+		return nil, fmt.Errorf("invalid Day One export: %w", err)
+	}
+
+	entries := make([]*domain.JournalEntry, 0, len(export.Entries))
+	for _, e := range export.Entries {
+		if e.CreationDate.IsZero() {
+			return nil, fmt.Errorf("Day One entry %s has no creationDate", e.UUID)
+		}
+
+		title, content := splitHeading(dayOneText(e.Text))
+		if content == "" {
+			// A heading on its own is the whole entry
+			content = title
+		}
+		entry := &domain.JournalEntry{
+			Title:     title,
+			Content:   content,
+			CreatedAt: e.CreationDate,
+			Tags:      dayOneTags(e.Tags),
+		}
+		if content == "" && len(e.Photos) == 0 {
+			// Nothing to import, such as an entry that only had a location
+			continue
+		}
+		if len(e.Photos) > 0 {
+			entry.Document = dayOneDocument(content, e.Photos)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// dayOneText removes attachment links and Markdown escapes from entry text.
+func dayOneText(text string) string {
+	text = dayOneMoment.ReplaceAllString(text, "")
+	text = dayOneEscape.ReplaceAllString(text, "$1")
+	return strings.TrimSpace(text)
+}
+
+// dayOneTags turns Day One tags, which may contain spaces, into tag names.
+func dayOneTags(tags []string) []string {
+	var names []string
+	for _, tag := range tags {
+		if tag = strings.Join(strings.Fields(tag), "-"); tag != "" {
+			names = append(names, tag)
+		}
+	}
+	return names
+}
+
+// dayOneDocument builds a document of the entry text followed by its photos.
+func dayOneDocument(content string, photos []dayOnePhoto) *domain.Document {
+	doc := &domain.Document{Version: domain.DocumentVersion}
+	if content != "" {
+		doc.Sections = append(doc.Sections, domain.Section{Type: domain.SectionText, Text: content})
+	}
+	for _, photo := range photos {
+		doc.Sections = append(doc.Sections, domain.Section{
+			Type:     domain.SectionPhoto,
+			PhotoURL: "photos/" + photo.MD5 + "." + photo.Type,
+		})
+	}
+	return doc
+}
+
+// splitHeading returns the text of a leading "# " heading as the title and
+// the rest as the content. Without one, the title is empty.
+func splitHeading(text string) (string, string) {
+	first, rest, _ := strings.Cut(text, "\n")
+	if !strings.HasPrefix(first, "# ") {
+		return "", text
+	}
+	return strings.TrimSpace(first[len("# "):]), strings.TrimSpace(rest)
+}
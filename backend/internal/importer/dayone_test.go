@@ -0,0 +1,149 @@
+package importer
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/parkernilson/micro-journal/internal/domain"
+)
+
+const dayOneJSON = `{
+  "metadata": {"version": "1.0"},
+  "entries": [
+    {
+      "uuid": "A1",
+      "creationDate": "2019-07-04T18:22:05Z",
+      "text": "# Fireworks\n\nWatched them from the roof\\! ![](dayone-moment://5F3A)",
+      "tags": ["Summer Nights", "family"],
+      "photos": [{"identifier": "5F3A", "md5": "abc123", "type": "jpeg"}]
+    },
+    {
+      "uuid": "B2",
+      "creationDate": "2019-07-05T08:00:00Z",
+      "text": "Slow morning. Coffee on the porch."
+    },
+    {
+      "uuid": "C3",
+      "creationDate": "2019-07-06T08:00:00Z",
+      "location": {"placeName": "Home"}
+    }
+  ]
+}`
+
+func TestParseDayOne(t *testing.T) {
+	entries, err := ParseDayOne(strings.NewReader(dayOneJSON))
+	if err != nil {
+		t.Fatalf("ParseDayOne failed: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("Expected 2 entries without the empty one, got %d", len(entries))
+	}
+
+	first := entries[0]
+	if first.Title != "Fireworks" || first.Content != "Watched them from the roof!" {
+		t.Errorf("Unexpected title %q and content %q", first.Title, first.Content)
+	}
+	if !first.CreatedAt.Equal(time.Date(2019, 7, 4, 18, 22, 5, 0, time.UTC)) {
+		t.Errorf("Unexpected created_at %v", first.CreatedAt)
+	}
+	if !reflect.DeepEqual(first.Tags, []string{"Summer-Nights", "family"}) {
+		t.Errorf("Unexpected tags %v", first.Tags)
+	}
+	if first.Document == nil || len(first.Document.Sections) != 2 || first.Document.Sections[1].PhotoURL != "photos/abc123.jpeg" {
+		t.Errorf("Expected text and photo sections, got %+v", first.Document)
+	}
+
+	second := entries[1]
+	if second.Title != "" || second.Document != nil {
+		t.Errorf("Expected plain entry without title, got %+v", second)
+	}
+
+	t.Run("invalid JSON", func(t *testing.T) {
+		if _, err := ParseDayOne(strings.NewReader("not json")); err == nil {
+			t.Error("Expected error, got nil")
+		}
+	})
+}
+
+// mockJournalManager is a mock implementation of JournalManager.
+type mockJournalManager struct {
+	importEntriesFunc func(ctx context.Context, entries []*domain.JournalEntry) (int64, error)
+}
+
+func (m *mockJournalManager) ImportEntries(ctx context.Context, entries []*domain.JournalEntry) (int64, error) {
+	return m.importEntriesFunc(ctx, entries)
+}
+
+func TestLoad(t *testing.T) {
+	ctx := context.Background()
+
+	entries := make([]*domain.JournalEntry, batchSize+10)
+	for i := range entries {
+		entries[i] = &domain.JournalEntry{Content: "Content"}
+	}
+
+	t.Run("imports in batches", func(t *testing.T) {
+		var sizes []int
+		manager := &mockJournalManager{
+			importEntriesFunc: func(ctx context.Context, batch []*domain.JournalEntry) (int64, error) {
+				sizes = append(sizes, len(batch))
+				return int64(len(batch)) - 1, nil
+			},
+		}
+
+		result, err := Load(ctx, manager, entries)
+		if err != nil {
+			t.Fatalf("Load failed: %v", err)
+		}
+		if !reflect.DeepEqual(sizes, []int{batchSize, 10}) {
+			t.Errorf("Expected batches of %d and 10, got %v", batchSize, sizes)
+		}
+		if result.Imported != int64(len(entries))-2 || result.Skipped != 2 {
+			t.Errorf("Unexpected result %+v", result)
+		}
+	})
+
+	t.Run("stops at a failed batch", func(t *testing.T) {
+		manager := &mockJournalManager{
+			importEntriesFunc: func(ctx context.Context, batch []*domain.JournalEntry) (int64, error) {
+				if len(batch) == 10 {
+					return 0, errors.New("entry 3: content cannot be empty")
+				}
+				return int64(len(batch)), nil
+			},
+		}
+
+		result, err := Load(ctx, manager, entries)
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+		if result.Imported != batchSize {
+			t.Errorf("Expected the first batch to stay imported, got %+v", result)
+		}
+	})
+	t.Run("stops when cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		batches := 0
+		manager := &mockJournalManager{
+			importEntriesFunc: func(ctx context.Context, batch []*domain.JournalEntry) (int64, error) {
+				batches++
+				cancel()
+				return int64(len(batch)), nil
+			},
+		}
+
+		result, err := Load(ctx, manager, entries)
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("Expected context.Canceled, got %v", err)
+		}
+		if batches != 1 || result.Imported != batchSize {
+			t.Errorf("Expected only the first batch imported, got %d batches and %+v", batches, result)
+		}
+	})
+}
@@ -0,0 +1,46 @@
+package importer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/parkernilson/micro-journal/internal/domain"
+)
+
+// batchSize is the number of entries passed to the manager at a time.
+const batchSize = 100
+
+// JournalManager defines the subset of the manager layer importers need.
+type JournalManager interface {
+	ImportEntries(ctx context.Context, entries []*domain.JournalEntry) (int64, error)
+}
+
+// Result is the outcome of loading entries into the journal.
+type Result struct {
+	Imported int64
+	// Skipped is the number of entries skipped as already imported.
+	Skipped int64
+}
+
+// Load imports entries through manager in batches, so a large journal is
+// not written in one transaction. Batches before a failed one stay imported
+// and are skipped when the import is retried. Loading stops before the
+// next batch once ctx is done.
+func Load(ctx context.Context, manager JournalManager, entries []*domain.JournalEntry) (*Result, error) {
+	result := &Result{}
+	for start := 0; start < len(entries); start += batchSize {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+		batch := entries[start:min(start+batchSize, len(entries))]
+
+		imported, err := manager.ImportEntries(ctx, batch)
+		if err != nil {
+			return result, fmt.Errorf("failed to import entries %d-%d: %w", start, start+len(batch)-1, err)
+		}
+		result.Imported += imported
+		result.Skipped += int64(len(batch)) - imported
+	}
+
+	return result, nil
+}
@@ -0,0 +1,117 @@
+package importer
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/parkernilson/micro-journal/internal/domain"
+)
+
+// jrnlHeading matches the line that starts a jrnl entry: a timestamp, with or
+// without brackets, followed by the entry's first line.
+var jrnlHeading = regexp.MustCompile(`^\[?(\d{4}-\d{2}-\d{2} \d{1,2}:\d{2}(?::\d{2})?(?: ?[AaPp][Mm])?)\]? ?(.*)$`)
+
+// jrnlTag matches a jrnl @tag.
+var jrnlTag = regexp.MustCompile(`(?:^|\s)@([\p{L}\p{N}_-]+)`)
+
+// jrnlTimeLayouts are the timestamp formats jrnl writes, depending on its
+// timeformat setting.
+var jrnlTimeLayouts = []string{
+	"2006-01-02 03:04 PM",
+	"2006-01-02 3:04 PM",
+	"2006-01-02 15:04",
+	"2006-01-02 15:04:05",
+}
+
+// ParseJrnl parses a journal in jrnl's plain-text format, where each entry
+// starts with a timestamp heading such as "[2020-01-02 09:15 AM] Title" after
+// a blank line. Timestamps are read in loc, since jrnl does not store a time
+// zone.
+//
+// The rest of the heading line becomes the entry title and the following
+// lines its content; an entry with only a heading uses it as both. jrnl
+// @tags in the text become tags.
+func ParseJrnl(r io.Reader, loc *time.Location) ([]*domain.JournalEntry, error) {
+	var entries []*domain.JournalEntry
+	var current *domain.JournalEntry
+	var body []string
+
+	finish := func() {
+		if current == nil {
+			return
+		}
+		current.Content = strings.TrimSpace(strings.Join(body, "\n"))
+		if current.Content == "" {
+			current.Content = current.Title
+		}
+		current.Tags = jrnlTags(current.Title + "\n" + current.Content)
+		if current.Content != "" {
+			entries = append(entries, current)
+		}
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1<<20)
+	previousBlank := true
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimRight(scanner.Text(), "\r")
+
+		if match := jrnlHeading.FindStringSubmatch(line); match != nil && previousBlank {
+			createdAt, err := parseJrnlTime(match[1], loc)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", lineNumber, err)
+			}
+
+			finish()
+			current = &domain.JournalEntry{Title: strings.TrimSpace(match[2]), CreatedAt: createdAt}
+			body = nil
+			previousBlank = false
+			continue
+		}
+
+		if current == nil && strings.TrimSpace(line) != "" {
+			return nil, fmt.Errorf("line %d: expected an entry timestamp", lineNumber)
+		}
+		body = append(body, line)
+		previousBlank = strings.TrimSpace(line) == ""
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read jrnl journal: %w", err)
+	}
+
+	finish()
+	return entries, nil
+}
+
+// parseJrnlTime parses a jrnl timestamp in loc.
+func parseJrnlTime(value string, loc *time.Location) (time.Time, error) {
+	value = strings.ToUpper(value)
+	if strings.HasSuffix(value, "M") && !strings.Contains(value, " AM") && !strings.Contains(value, " PM") {
+		// "09:15AM" is written without the space by some timeformats
+		value = value[:len(value)-2] + " " + value[len(value)-2:]
+	}
+
+	for _, layout := range jrnlTimeLayouts {
+		if t, err := time.ParseInLocation(layout, value, loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid timestamp %q", value)
+}
+
+// jrnlTags returns the distinct @tags in text, without the @.
+func jrnlTags(text string) []string {
+	var tags []string
+	seen := map[string]bool{}
+	for _, match := range jrnlTag.FindAllStringSubmatch(text, -1) {
+		if tag := strings.ToLower(match[1]); !seen[tag] {
+			seen[tag] = true
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
@@ -0,0 +1,67 @@
+package importer
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+const jrnlText = `[2020-01-02 09:15 AM] Snow day. Schools closed again.
+Built a snowman with @Kids and drank cocoa.
+
+[2020-01-02 9:40PM] Late night @work call
+[2020-01-03 08:00] should stay in the body
+
+2020-01-04 18:30 Dinner at Sam's
+
+[2020-01-05 07:00]
+`
+
+func TestParseJrnl(t *testing.T) {
+	loc := time.FixedZone("MST", -7*60*60)
+
+	entries, err := ParseJrnl(strings.NewReader(jrnlText), loc)
+	if err != nil {
+		t.Fatalf("ParseJrnl failed: %v", err)
+	}
+	if len(entries) != 3 {
+		t.Fatalf("Expected 3 entries without the empty one, got %d", len(entries))
+	}
+
+	first := entries[0]
+	if first.Title != "Snow day. Schools closed again." || first.Content != "Built a snowman with @Kids and drank cocoa." {
+		t.Errorf("Unexpected title %q and content %q", first.Title, first.Content)
+	}
+	if !first.CreatedAt.Equal(time.Date(2020, 1, 2, 9, 15, 0, 0, loc)) {
+		t.Errorf("Unexpected created_at %v", first.CreatedAt)
+	}
+	if !reflect.DeepEqual(first.Tags, []string{"kids"}) {
+		t.Errorf("Unexpected tags %v", first.Tags)
+	}
+
+	second := entries[1]
+	if !second.CreatedAt.Equal(time.Date(2020, 1, 2, 21, 40, 0, 0, loc)) {
+		t.Errorf("Unexpected created_at %v", second.CreatedAt)
+	}
+	if second.Content != "[2020-01-03 08:00] should stay in the body" {
+		t.Errorf("Expected a timestamp without a blank line before it to stay in the body, got %q", second.Content)
+	}
+
+	third := entries[2]
+	if third.Title != "Dinner at Sam's" || third.Content != "Dinner at Sam's" {
+		t.Errorf("Expected heading-only entry to use the heading as content, got %+v", third)
+	}
+
+	t.Run("text before the first entry", func(t *testing.T) {
+		if _, err := ParseJrnl(strings.NewReader("notes\n\n[2020-01-02 09:15] Entry\n"), loc); err == nil {
+			t.Error("Expected error, got nil")
+		}
+	})
+
+	t.Run("invalid timestamp", func(t *testing.T) {
+		if _, err := ParseJrnl(strings.NewReader("[2020-13-02 09:15] Entry\n"), loc); err == nil {
+			t.Error("Expected error, got nil")
+		}
+	})
+}
@@ -0,0 +1,214 @@
+package inbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/parkernilson/micro-journal/internal/domain"
+)
+
+const (
+	// archiveDir is the subdirectory that ingested files are moved to.
+	archiveDir = "archive"
+
+	// failedDir is the subdirectory that invalid files are moved to, so they
+	// are not retried on every scan.
+	failedDir = "failed"
+)
+
+// supportedExtensions are the file extensions ingested from the inbox.
+var supportedExtensions = map[string]bool{
+	".md":       true,
+	".markdown": true,
+	".txt":      true,
+}
+
+// JournalManager defines the subset of the manager layer the inbox needs.
+type JournalManager interface {
+	ImportEntries(ctx context.Context, entries []*domain.JournalEntry) (int64, error)
+}
+
+// Watcher ingests Markdown and text files dropped into a directory as
+// journal entries, then moves them into an archive subdirectory.
+type Watcher struct {
+	manager  JournalManager
+	dir      string
+	interval time.Duration
+	settle   time.Duration
+	now      func() time.Time
+}
+
+// NewWatcher creates a new instance of Watcher that scans dir every interval.
+// Files modified less than settle ago are skipped until a later scan, so
+// files that are still being written are not ingested half-finished.
+func NewWatcher(manager JournalManager, dir string, interval, settle time.Duration) *Watcher {
+	return &Watcher{
+		manager:  manager,
+		dir:      dir,
+		interval: interval,
+		settle:   settle,
+		now:      time.Now,
+	}
+}
+
+// Run scans the inbox every interval until ctx is cancelled.
+func (w *Watcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := w.ScanOnce(ctx); err != nil && ctx.Err() == nil {
+			slog.ErrorContext(ctx, "inbox scan failed", "dir", w.dir, "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ScanOnce ingests every settled file currently in the inbox.
+// An invalid file is moved to the failed subdirectory and does not stop the
+// scan. Any other failure, such as the store being busy, stops the scan and
+// leaves the file in the inbox for the next one.
+func (w *Watcher) ScanOnce(ctx context.Context) error {
+	for _, sub := range []string{archiveDir, failedDir} {
+		if err := os.MkdirAll(filepath.Join(w.dir, sub), 0o755); err != nil {
+			return fmt.Errorf("failed to create %s directory: %w", sub, err)
+		}
+	}
+
+	files, err := os.ReadDir(w.dir)
+	if err != nil {
+		return fmt.Errorf("failed to read inbox: %w", err)
+	}
+
+	for _, file := range files {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if file.IsDir() || !supportedExtensions[strings.ToLower(filepath.Ext(file.Name()))] {
+			continue
+		}
+
+		info, err := file.Info()
+		if err != nil {
+			return fmt.Errorf("failed to stat %s: %w", file.Name(), err)
+		}
+		if w.now().Sub(info.ModTime()) < w.settle {
+			continue
+		}
+
+		path := filepath.Join(w.dir, file.Name())
+		dest := archiveDir
+		if err := w.ingest(ctx, path, info.ModTime()); errors.Is(err, domain.ErrValidation) {
+			slog.WarnContext(ctx, "invalid inbox file", "file", file.Name(), "error", err)
+			dest = failedDir
+		} else if err != nil {
+			return fmt.Errorf("failed to ingest %s: %w", file.Name(), err)
+		}
+
+		// Prefix with the scan time so files with reused names do not collide
+		moved := w.now().UTC().Format("20060102T150405") + "-" + file.Name()
+		if err := os.Rename(path, filepath.Join(w.dir, dest, moved)); err != nil {
+			return fmt.Errorf("failed to move %s to %s: %w", file.Name(), dest, err)
+		}
+	}
+
+	return nil
+}
+
+// ingest creates a journal entry from the file at path, which was last
+// modified at modTime. The entry is imported with modTime as its creation
+// time, so ingesting the same file again, such as after it could not be
+// moved out of the inbox, is skipped as a duplicate. Errors about the file's
+// contents wrap domain.ErrValidation.
+func (w *Watcher) ingest(ctx context.Context, path string, modTime time.Time) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read file: %w", err)
+	}
+
+	meta, body, err := parseFrontMatter(string(data))
+	if err != nil {
+		return fmt.Errorf("%w: %v", domain.ErrValidation, err)
+	}
+
+	var revealAt time.Time
+	if raw := meta["reveal_at"]; raw != "" {
+		revealAt, err = time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return fmt.Errorf("%w: invalid reveal_at %q: %v", domain.ErrValidation, raw, err)
+		}
+	}
+
+	// A missing title is suggested from the content by the manager
+	imported, err := w.manager.ImportEntries(ctx, []*domain.JournalEntry{{
+		Title:     meta["title"],
+		Content:   body,
+		CreatedAt: modTime.UTC().Truncate(time.Second),
+		RevealAt:  revealAt,
+		Tags:      parseTags(meta["tags"]),
+	}})
+	if err != nil {
+		return err
+	}
+
+	if imported == 0 {
+		slog.InfoContext(ctx, "inbox file was already ingested", "file", filepath.Base(path))
+	} else {
+		slog.InfoContext(ctx, "ingested inbox file", "file", filepath.Base(path))
+	}
+	return nil
+}
+
+// parseTags splits a comma-separated tags value, optionally written as a
+// "[a, b]" list.
+func parseTags(raw string) []string {
+	raw = strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(raw), "["), "]")
+
+	var tags []string
+	for _, tag := range strings.Split(raw, ",") {
+		if tag = strings.Trim(strings.TrimSpace(tag), `"'`); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+// parseFrontMatter splits optional "---" delimited front matter from the
+// body of a document. Front matter is read as simple "key: value" lines;
+// surrounding quotes on values are removed.
+func parseFrontMatter(doc string) (map[string]string, string, error) {
+	meta := map[string]string{}
+	doc = strings.ReplaceAll(doc, "\r\n", "\n")
+
+	if !strings.HasPrefix(doc, "---\n") {
+		return meta, strings.TrimSpace(doc), nil
+	}
+
+	rest := doc[len("---\n"):]
+	end := strings.Index(rest, "\n---")
+	if end < 0 {
+		return nil, "", fmt.Errorf("unterminated front matter")
+	}
+
+	for _, line := range strings.Split(rest[:end], "\n") {
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		meta[strings.TrimSpace(key)] = strings.Trim(strings.TrimSpace(value), `"'`)
+	}
+
+	body := rest[end+len("\n---"):]
+	return meta, strings.TrimSpace(body), nil
+}
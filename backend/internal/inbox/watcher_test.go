@@ -0,0 +1,188 @@
+package inbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/parkernilson/micro-journal/internal/domain"
+)
+
+// mockJournalManager is a mock implementation of JournalManager for testing.
+type mockJournalManager struct {
+	importEntriesFunc func(ctx context.Context, entries []*domain.JournalEntry) (int64, error)
+}
+
+func (m *mockJournalManager) ImportEntries(ctx context.Context, entries []*domain.JournalEntry) (int64, error) {
+	if m.importEntriesFunc != nil {
+		return m.importEntriesFunc(ctx, entries)
+	}
+	return 0, errors.New("not implemented")
+}
+
+// writeInboxFile writes a file into dir with a modification time in the past.
+func writeInboxFile(t *testing.T, dir, name, content string) {
+	t.Helper()
+
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("failed to write %s: %v", name, err)
+	}
+	old := time.Now().Add(-time.Minute)
+	if err := os.Chtimes(path, old, old); err != nil {
+		t.Fatalf("failed to set mtime on %s: %v", name, err)
+	}
+}
+
+func TestWatcher_ScanOnce(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	var entries []*domain.JournalEntry
+	mockManager := &mockJournalManager{
+		importEntriesFunc: func(ctx context.Context, batch []*domain.JournalEntry) (int64, error) {
+			if batch[0].Content == "fail" {
+				return 0, fmt.Errorf("entry 0: content is invalid: %w", domain.ErrValidation)
+			}
+			entries = append(entries, batch...)
+			return 1, nil
+		},
+	}
+
+	writeInboxFile(t, dir, "a.md", "---\ntitle: \"From Front Matter\"\ntags: [work]\n---\n\nBody text\n")
+	writeInboxFile(t, dir, "b.txt", "Plain note")
+	writeInboxFile(t, dir, "c.md", "fail")
+	writeInboxFile(t, dir, "d.md", "---\nreveal_at: someday\n---\nBody")
+	writeInboxFile(t, dir, "ignored.pdf", "not a note")
+
+	watcher := NewWatcher(mockManager, dir, time.Minute, time.Second)
+	if err := watcher.ScanOnce(ctx); err != nil {
+		t.Fatalf("ScanOnce failed: %v", err)
+	}
+
+	if len(entries) != 2 {
+		t.Fatalf("Expected 2 entries, got %d", len(entries))
+	}
+	if entries[0].Title != "From Front Matter" || entries[0].Content != "Body text" || !reflect.DeepEqual(entries[0].Tags, []string{"work"}) {
+		t.Errorf("Expected front matter entry, got %+v", entries[0])
+	}
+	if entries[1].Title != "" || entries[1].Content != "Plain note" {
+		t.Errorf("Expected an entry without a title, got %+v", entries[1])
+	}
+	if entries[1].CreatedAt.IsZero() || time.Since(entries[1].CreatedAt) < 30*time.Second {
+		t.Errorf("Expected the file's modification time as created_at, got %v", entries[1].CreatedAt)
+	}
+
+	for _, pattern := range []string{
+		filepath.Join(dir, archiveDir, "*-a.md"),
+		filepath.Join(dir, archiveDir, "*-b.txt"),
+		filepath.Join(dir, failedDir, "*-c.md"),
+		filepath.Join(dir, failedDir, "*-d.md"),
+		filepath.Join(dir, "ignored.pdf"),
+	} {
+		if matches, _ := filepath.Glob(pattern); len(matches) != 1 {
+			t.Errorf("Expected one file matching %s, got %v", pattern, matches)
+		}
+	}
+}
+
+func TestWatcher_ScanOnce_KeepsFilesOnStoreErrors(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	var createdAt []time.Time
+	busy := true
+	mockManager := &mockJournalManager{
+		importEntriesFunc: func(ctx context.Context, entries []*domain.JournalEntry) (int64, error) {
+			if busy {
+				return 0, errors.New("database is locked")
+			}
+			createdAt = append(createdAt, entries[0].CreatedAt)
+			return 1, nil
+		},
+	}
+	writeInboxFile(t, dir, "note.md", "Retry me")
+	watcher := NewWatcher(mockManager, dir, time.Minute, time.Second)
+
+	if err := watcher.ScanOnce(ctx); err == nil {
+		t.Fatal("Expected the store error, got nil")
+	}
+	if _, err := os.Stat(filepath.Join(dir, "note.md")); err != nil {
+		t.Fatalf("Expected the file to stay in the inbox: %v", err)
+	}
+
+	busy = false
+	if err := watcher.ScanOnce(ctx); err != nil {
+		t.Fatalf("ScanOnce failed: %v", err)
+	}
+	if len(createdAt) != 1 {
+		t.Fatalf("Expected the file to be ingested once, got %v", createdAt)
+	}
+	if matches, _ := filepath.Glob(filepath.Join(dir, archiveDir, "*-note.md")); len(matches) != 1 {
+		t.Errorf("Expected the file in the archive, got %v", matches)
+	}
+}
+
+func TestWatcher_ScanOnce_SkipsUnsettledFiles(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	if err := os.WriteFile(filepath.Join(dir, "fresh.md"), []byte("Still writing"), 0o644); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+
+	watcher := NewWatcher(&mockJournalManager{}, dir, time.Minute, time.Hour)
+	if err := watcher.ScanOnce(ctx); err != nil {
+		t.Fatalf("ScanOnce failed: %v", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "fresh.md")); err != nil {
+		t.Errorf("Expected unsettled file to stay in the inbox: %v", err)
+	}
+}
+
+func TestParseFrontMatter(t *testing.T) {
+	t.Run("with front matter", func(t *testing.T) {
+		meta, body, err := parseFrontMatter("---\ntitle: Hello\nreveal_at: 2030-01-01T00:00:00Z\ntags: [work, \"travel\"]\n---\nBody")
+		if err != nil {
+			t.Fatalf("parseFrontMatter failed: %v", err)
+		}
+		if meta["title"] != "Hello" {
+			t.Errorf("Expected title 'Hello', got '%s'", meta["title"])
+		}
+		if meta["reveal_at"] != "2030-01-01T00:00:00Z" {
+			t.Errorf("Expected reveal_at '2030-01-01T00:00:00Z', got '%s'", meta["reveal_at"])
+		}
+		if tags := parseTags(meta["tags"]); len(tags) != 2 || tags[0] != "work" || tags[1] != "travel" {
+			t.Errorf("Expected tags [work travel], got %v", tags)
+		}
+		if body != "Body" {
+			t.Errorf("Expected body 'Body', got '%s'", body)
+		}
+	})
+
+	t.Run("without front matter", func(t *testing.T) {
+		meta, body, err := parseFrontMatter("  Just text  ")
+		if err != nil {
+			t.Fatalf("parseFrontMatter failed: %v", err)
+		}
+		if len(meta) != 0 {
+			t.Errorf("Expected no metadata, got %v", meta)
+		}
+		if body != "Just text" {
+			t.Errorf("Expected body 'Just text', got '%s'", body)
+		}
+	})
+
+	t.Run("unterminated front matter", func(t *testing.T) {
+		_, _, err := parseFrontMatter("---\ntitle: Hello\nBody")
+		if err == nil {
+			t.Error("Expected error for unterminated front matter, got nil")
+		}
+	})
+}
@@ -0,0 +1,193 @@
+package loadshed
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// Priority ranks requests for shedding; lower priorities are shed first.
+type Priority int
+
+const (
+	// PriorityLow is for requests that touch many rows, such as list,
+	// search, batch, export, and import.
+	PriorityLow Priority = iota
+	// PriorityHigh is for writes and single-entry reads.
+	PriorityHigh
+)
+
+// Options controls when requests are shed.
+type Options struct {
+	// MaxInFlight is the number of concurrent requests above which every
+	// request is rejected.
+	MaxInFlight int
+	// LowPriorityInFlight is the number of concurrent requests above which
+	// low-priority requests are rejected.
+	LowPriorityInFlight int
+	// MaxWait is the average time spent waiting for a database connection
+	// above which low-priority requests are rejected.
+	MaxWait time.Duration
+	// SampleInterval is how often database stats are sampled.
+	SampleInterval time.Duration
+	// RetryAfter is the delay suggested to rejected clients.
+	RetryAfter time.Duration
+}
+
+// DefaultOptions returns options suited to a single-connection SQLite
+// database.
+func DefaultOptions() Options {
+	return Options{
+		MaxInFlight:         64,
+		LowPriorityInFlight: 16,
+		MaxWait:             100 * time.Millisecond,
+		SampleInterval:      time.Second,
+		RetryAfter:          time.Second,
+	}
+}
+
+// Shedder rejects requests when the database is under pressure, shedding
+// low-priority requests before high-priority ones.
+//
+// Pressure is measured two ways: the number of requests in flight, which
+// approximates the database queue depth because every request waits on the
+// single SQLite connection, and the average time recently spent waiting for
+// that connection, taken from the pool's stats.
+type Shedder struct {
+	stats func() sql.DBStats
+	opts  Options
+	now   func() time.Time
+
+	mu         sync.Mutex
+	inFlight   int
+	last       sql.DBStats
+	lastSample time.Time
+	avgWait    time.Duration
+}
+
+// NewShedder creates a new instance of Shedder. stats is usually the Stats
+// method of the *sql.DB the server uses.
+func NewShedder(stats func() sql.DBStats, opts Options) *Shedder {
+	return &Shedder{stats: stats, opts: opts, now: time.Now}
+}
+
+// UnaryServerInterceptor returns an interceptor that sheds requests under
+// pressure with codes.Unavailable and a RetryInfo detail.
+func (s *Shedder) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		release, err := s.Admit(info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		defer release()
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor is UnaryServerInterceptor for streaming RPCs. A
+// stream is counted as in flight until it ends.
+func (s *Shedder) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		release, err := s.Admit(info.FullMethod)
+		if err != nil {
+			return err
+		}
+		defer release()
+
+		return handler(srv, ss)
+	}
+}
+
+// Admit decides whether a call to fullMethod may run now. If it may,
+// release must be called once the call finishes; otherwise err is the
+// Unavailable status to return. Servers other than gRPC use it to shed
+// requests the same way.
+func (s *Shedder) Admit(fullMethod string) (release func(), err error) {
+	if !s.admit(MethodPriority(fullMethod)) {
+		return nil, s.rejection()
+	}
+	return s.release, nil
+}
+
+// lowPriorityPrefixes are the method name prefixes of low-priority RPCs.
+var lowPriorityPrefixes = []string{"List", "Search", "Batch", "Export", "Import"}
+
+// MethodPriority classifies an RPC by its full method name: List, Search,
+// Batch, Export, and Import methods are low priority and everything else is
+// high priority.
+func MethodPriority(fullMethod string) Priority {
+	name := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	for _, prefix := range lowPriorityPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return PriorityLow
+		}
+	}
+	return PriorityHigh
+}
+
+// admit reports whether a request of the given priority may run, and if so
+// counts it as in flight.
+func (s *Shedder) admit(priority Priority) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sample()
+
+	if s.inFlight >= s.opts.MaxInFlight {
+		return false
+	}
+	if priority == PriorityLow && (s.inFlight >= s.opts.LowPriorityInFlight || s.avgWait > s.opts.MaxWait) {
+		return false
+	}
+
+	s.inFlight++
+	return true
+}
+
+// release marks an admitted request as finished.
+func (s *Shedder) release() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.inFlight--
+}
+
+// sample refreshes the average connection wait from the pool's stats if the
+// last sample is older than the sample interval. s.mu must be held.
+func (s *Shedder) sample() {
+	now := s.now()
+	if now.Sub(s.lastSample) < s.opts.SampleInterval {
+		return
+	}
+
+	stats := s.stats()
+	waits := stats.WaitCount - s.last.WaitCount
+	if waits > 0 {
+		s.avgWait = (stats.WaitDuration - s.last.WaitDuration) / time.Duration(waits)
+	} else {
+		s.avgWait = 0
+	}
+
+	s.last = stats
+	s.lastSample = now
+}
+
+// rejection builds the error returned to shed requests.
+func (s *Shedder) rejection() error {
+	st := status.New(codes.Unavailable, "server is overloaded, retry later")
+	detailed, err := st.WithDetails(&errdetails.RetryInfo{
+		RetryDelay: durationpb.New(s.opts.RetryAfter),
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
@@ -0,0 +1,154 @@
+package loadshed
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMethodPriority(t *testing.T) {
+	tests := []struct {
+		method string
+		want   Priority
+	}{
+		{"/journal.v1.JournalService/ListJournalEntries", PriorityLow},
+		{"/journal.v1.JournalService/SearchJournalEntries", PriorityLow},
+		{"/journal.v1.JournalService/BatchCreateJournalEntries", PriorityLow},
+		{"/journal.v1.JournalService/BatchGetJournalEntries", PriorityLow},
+		{"/journal.v1.JournalService/BatchDeleteJournalEntries", PriorityLow},
+		{"/journal.v1.JournalService/ExportJournal", PriorityLow},
+		{"/journal.v1.JournalService/ImportJournal", PriorityLow},
+		{"/journal.v1.JournalService/ImportDayOne", PriorityLow},
+		{"/journal.v1.JournalService/DeleteJournalEntry", PriorityHigh},
+		{"/journal.v1.JournalService/CreateJournalEntry", PriorityHigh},
+		{"/journal.v1.JournalService/GetJournalEntry", PriorityHigh},
+	}
+
+	for _, tt := range tests {
+		if got := MethodPriority(tt.method); got != tt.want {
+			t.Errorf("MethodPriority(%s) = %d, want %d", tt.method, got, tt.want)
+		}
+	}
+}
+
+func TestShedder_InFlightLimits(t *testing.T) {
+	opts := DefaultOptions()
+	opts.MaxInFlight = 2
+	opts.LowPriorityInFlight = 1
+	shedder := NewShedder(func() sql.DBStats { return sql.DBStats{} }, opts)
+
+	if !shedder.admit(PriorityLow) {
+		t.Fatal("Expected first low-priority request to be admitted")
+	}
+	if shedder.admit(PriorityLow) {
+		t.Error("Expected second low-priority request to be shed")
+	}
+	if !shedder.admit(PriorityHigh) {
+		t.Fatal("Expected high-priority request to be admitted")
+	}
+	if shedder.admit(PriorityHigh) {
+		t.Error("Expected high-priority request over the limit to be shed")
+	}
+
+	shedder.release()
+	shedder.release()
+	if !shedder.admit(PriorityLow) {
+		t.Error("Expected low-priority request to be admitted after release")
+	}
+}
+
+func TestShedder_ConnectionWait(t *testing.T) {
+	stats := sql.DBStats{}
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	shedder := NewShedder(func() sql.DBStats { return stats }, DefaultOptions())
+	shedder.now = func() time.Time { return now }
+
+	if !shedder.admit(PriorityLow) {
+		t.Fatal("Expected request to be admitted without pressure")
+	}
+	shedder.release()
+
+	// 10 waits averaging 500ms since the last sample
+	stats.WaitCount = 10
+	stats.WaitDuration = 5 * time.Second
+	now = now.Add(2 * time.Second)
+
+	if shedder.admit(PriorityLow) {
+		t.Error("Expected low-priority request to be shed under connection wait")
+	}
+	if !shedder.admit(PriorityHigh) {
+		t.Error("Expected high-priority request to be admitted under connection wait")
+	}
+	shedder.release()
+
+	// No new waits: pressure has cleared
+	now = now.Add(2 * time.Second)
+	if !shedder.admit(PriorityLow) {
+		t.Error("Expected low-priority request to be admitted once pressure clears")
+	}
+}
+
+func TestShedder_UnaryServerInterceptor(t *testing.T) {
+	opts := DefaultOptions()
+	opts.LowPriorityInFlight = 0
+	shedder := NewShedder(func() sql.DBStats { return sql.DBStats{} }, opts)
+	interceptor := shedder.UnaryServerInterceptor()
+
+	handler := func(ctx context.Context, req any) (any, error) { return "ok", nil }
+
+	resp, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/journal.v1.JournalService/CreateJournalEntry"}, handler)
+	if err != nil || resp != "ok" {
+		t.Fatalf("Expected high-priority request to succeed, got %v, %v", resp, err)
+	}
+
+	_, err = interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/journal.v1.JournalService/ListJournalEntries"}, handler)
+	st := status.Convert(err)
+	if st.Code() != codes.Unavailable {
+		t.Fatalf("Expected Unavailable, got %v", err)
+	}
+	if len(st.Details()) != 1 {
+		t.Fatalf("Expected a RetryInfo detail, got %v", st.Details())
+	}
+	retry, ok := st.Details()[0].(*errdetails.RetryInfo)
+	if !ok || retry.RetryDelay.AsDuration() != opts.RetryAfter {
+		t.Errorf("Expected retry delay %v, got %v", opts.RetryAfter, st.Details()[0])
+	}
+
+	if shedder.inFlight != 0 {
+		t.Errorf("Expected no requests in flight, got %d", shedder.inFlight)
+	}
+}
+
+func TestShedder_StreamServerInterceptor(t *testing.T) {
+	opts := DefaultOptions()
+	opts.LowPriorityInFlight = 0
+	shedder := NewShedder(func() sql.DBStats { return sql.DBStats{} }, opts)
+	interceptor := shedder.StreamServerInterceptor()
+
+	called := false
+	handler := func(srv any, ss grpc.ServerStream) error {
+		called = true
+		return nil
+	}
+
+	err := interceptor(nil, nil, &grpc.StreamServerInfo{FullMethod: "/journal.v1.JournalService/ExportJournal"}, handler)
+	if status.Code(err) != codes.Unavailable || called {
+		t.Fatalf("Expected export to be shed, got %v", err)
+	}
+
+	opts.LowPriorityInFlight = 1
+	shedder = NewShedder(func() sql.DBStats { return sql.DBStats{} }, opts)
+	if err := shedder.StreamServerInterceptor()(nil, nil, &grpc.StreamServerInfo{FullMethod: "/journal.v1.JournalService/ImportJournal"}, handler); err != nil || !called {
+		t.Fatalf("Expected import to run, got %v", err)
+	}
+	if shedder.inFlight != 0 {
+		t.Errorf("Expected no streams in flight, got %d", shedder.inFlight)
+	}
+}
@@ -0,0 +1,150 @@
+// Package logging sets up structured logging with log/slog and provides
+// gRPC interceptors that log every request with a generated request ID.
+package logging
+
+import (
+	"context"
+	"crypto/rand"
+	"io"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// RequestIDHeader is the response metadata key the request ID is returned
+// in.
+const RequestIDHeader = "x-request-id"
+
+// Log formats accepted by NewLogger.
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+)
+
+// requestIDKey is the context key of the request ID.
+type requestIDKey struct{}
+
+// NewLogger creates a logger writing records at level or above to w, as
+// logfmt-style text or as JSON depending on format. Records logged with a
+// context carrying a request ID include it as request_id.
+func NewLogger(w io.Writer, level slog.Level, format string) *slog.Logger {
+	opts := &slog.HandlerOptions{Level: level}
+
+	var handler slog.Handler
+	if format == FormatJSON {
+		handler = slog.NewJSONHandler(w, opts)
+	} else {
+		handler = slog.NewTextHandler(w, opts)
+	}
+	return slog.New(contextHandler{handler})
+}
+
+// WithRequestID returns a copy of ctx carrying the request ID id.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestID returns the request ID carried by ctx, or "" if there is none.
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// contextHandler adds the request ID carried by a record's context to the
+// record.
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h contextHandler) Handle(ctx context.Context, record slog.Record) error {
+	if id := RequestID(ctx); id != "" {
+		record.AddAttrs(slog.String("request_id", id))
+	}
+	return h.Handler.Handle(ctx, record)
+}
+
+func (h contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return contextHandler{h.Handler.WithAttrs(attrs)}
+}
+
+func (h contextHandler) WithGroup(name string) slog.Handler {
+	return contextHandler{h.Handler.WithGroup(name)}
+}
+
+// UnaryServerInterceptor returns an interceptor that gives each request a
+// request ID, attaches it to the context and the response headers, and logs
+// the method, latency, and status code once the request finishes.
+func UnaryServerInterceptor(logger *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, id := StartRequest(ctx)
+		if err := grpc.SetHeader(ctx, metadata.Pairs(RequestIDHeader, id)); err != nil {
+			logger.WarnContext(ctx, "failed to set request ID header", "error", err)
+		}
+
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		LogRequest(ctx, logger, info.FullMethod, time.Since(start), err)
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor is UnaryServerInterceptor for streaming RPCs.
+func StreamServerInterceptor(logger *slog.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, id := StartRequest(ss.Context())
+		if err := ss.SetHeader(metadata.Pairs(RequestIDHeader, id)); err != nil {
+			logger.WarnContext(ctx, "failed to set request ID header", "error", err)
+		}
+
+		start := time.Now()
+		err := handler(srv, &requestStream{ServerStream: ss, ctx: ctx})
+		LogRequest(ctx, logger, info.FullMethod, time.Since(start), err)
+		return err
+	}
+}
+
+// requestStream is a grpc.ServerStream whose context carries the request ID.
+type requestStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *requestStream) Context() context.Context {
+	return s.ctx
+}
+
+// StartRequest generates a request ID and attaches it to ctx. Servers other
+// than gRPC use it with LogRequest to log requests the same way.
+func StartRequest(ctx context.Context) (context.Context, string) {
+	id := newRequestID()
+	return WithRequestID(ctx, id), id
+}
+
+// LogRequest logs a finished request, where err is a gRPC status error or
+// nil: at error level for failures that are the server's fault, at warn
+// level for other failures, and at info level otherwise.
+func LogRequest(ctx context.Context, logger *slog.Logger, method string, latency time.Duration, err error) {
+	code := status.Code(err)
+	attrs := []any{"method", method, "latency", latency, "code", code.String()}
+	if err != nil {
+		attrs = append(attrs, "error", status.Convert(err).Message())
+	}
+
+	level := slog.LevelWarn
+	switch code {
+	case codes.OK:
+		level = slog.LevelInfo
+	case codes.Unknown, codes.Internal, codes.DataLoss, codes.Unimplemented:
+		level = slog.LevelError
+	}
+	logger.Log(ctx, level, "request finished", attrs...)
+}
+
+// newRequestID returns a random 16-character base32 request ID.
+func newRequestID() string {
+	return rand.Text()[:16]
+}
@@ -0,0 +1,165 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// mockTransportStream records the headers set on a unary call.
+type mockTransportStream struct {
+	header metadata.MD
+}
+
+func (s *mockTransportStream) Method() string { return "" }
+
+func (s *mockTransportStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func (s *mockTransportStream) SendHeader(md metadata.MD) error { return s.SetHeader(md) }
+
+func (s *mockTransportStream) SetTrailer(md metadata.MD) error { return nil }
+
+// mockServerStream is a grpc.ServerStream that records its headers.
+type mockServerStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	header metadata.MD
+}
+
+func (s *mockServerStream) Context() context.Context { return s.ctx }
+
+func (s *mockServerStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+// decodeRecords parses the JSON log records written to buf.
+func decodeRecords(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	t.Helper()
+
+	var records []map[string]any
+	decoder := json.NewDecoder(buf)
+	for decoder.More() {
+		var record map[string]any
+		if err := decoder.Decode(&record); err != nil {
+			t.Fatalf("Failed to decode log record: %v", err)
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+func TestNewLogger(t *testing.T) {
+	t.Run("adds the request ID from the context", func(t *testing.T) {
+		var buf bytes.Buffer
+		logger := NewLogger(&buf, slog.LevelInfo, FormatJSON).With("component", "test")
+
+		logger.InfoContext(WithRequestID(context.Background(), "abc"), "hello")
+
+		records := decodeRecords(t, &buf)
+		if len(records) != 1 || records[0]["request_id"] != "abc" || records[0]["component"] != "test" {
+			t.Errorf("Expected one record with request_id and component, got %v", records)
+		}
+	})
+
+	t.Run("drops records below the level", func(t *testing.T) {
+		var buf bytes.Buffer
+		logger := NewLogger(&buf, slog.LevelWarn, FormatText)
+
+		logger.Info("quiet")
+		logger.Warn("loud")
+
+		if bytes.Contains(buf.Bytes(), []byte("quiet")) || !bytes.Contains(buf.Bytes(), []byte("msg=loud")) {
+			t.Errorf("Expected only the warning as text, got %q", buf.String())
+		}
+	})
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/journal.v1.JournalService/GetJournalEntry"}
+
+	tests := []struct {
+		name  string
+		err   error
+		code  string
+		level string
+	}{
+		{"success", nil, "OK", "INFO"},
+		{"client error", status.Error(codes.NotFound, "no such entry"), "NotFound", "WARN"},
+		{"server error", status.Error(codes.Internal, "disk full"), "Internal", "ERROR"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			interceptor := UnaryServerInterceptor(NewLogger(&buf, slog.LevelInfo, FormatJSON))
+
+			transport := &mockTransportStream{}
+			ctx := grpc.NewContextWithServerTransportStream(context.Background(), transport)
+
+			var handlerID string
+			_, err := interceptor(ctx, nil, info, func(ctx context.Context, req any) (any, error) {
+				handlerID = RequestID(ctx)
+				return nil, tt.err
+			})
+			if err != tt.err {
+				t.Errorf("Expected the handler's error, got %v", err)
+			}
+
+			if handlerID == "" {
+				t.Fatal("Expected a request ID in the handler's context")
+			}
+			if got := transport.header.Get(RequestIDHeader); len(got) != 1 || got[0] != handlerID {
+				t.Errorf("Expected request ID header %q, got %v", handlerID, got)
+			}
+
+			records := decodeRecords(t, &buf)
+			if len(records) != 1 {
+				t.Fatalf("Expected one log record, got %d", len(records))
+			}
+			record := records[0]
+			if record["method"] != info.FullMethod || record["code"] != tt.code || record["level"] != tt.level || record["request_id"] != handlerID {
+				t.Errorf("Unexpected log record %v", record)
+			}
+			if _, ok := record["latency"]; !ok {
+				t.Error("Expected latency in the log record")
+			}
+		})
+	}
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	var buf bytes.Buffer
+	interceptor := StreamServerInterceptor(NewLogger(&buf, slog.LevelInfo, FormatJSON))
+	info := &grpc.StreamServerInfo{FullMethod: "/journal.v1.JournalService/ExportJournal"}
+
+	stream := &mockServerStream{ctx: context.Background()}
+	var handlerID string
+	err := interceptor(nil, stream, info, func(srv any, stream grpc.ServerStream) error {
+		handlerID = RequestID(stream.Context())
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if handlerID == "" {
+		t.Fatal("Expected a request ID in the stream's context")
+	}
+	if got := stream.header.Get(RequestIDHeader); len(got) != 1 || got[0] != handlerID {
+		t.Errorf("Expected request ID header %q, got %v", handlerID, got)
+	}
+	if records := decodeRecords(t, &buf); len(records) != 1 || records[0]["method"] != info.FullMethod {
+		t.Errorf("Expected one record for the method, got %v", records)
+	}
+}
@@ -0,0 +1,191 @@
+package maintenance
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+)
+
+// task is a single named maintenance statement.
+type task struct {
+	name      string
+	statement string
+}
+
+// tasks are run in order on every maintenance pass.
+var tasks = []task{
+	{name: "optimize", statement: "PRAGMA optimize"},
+	{name: "analyze", statement: "ANALYZE"},
+	{name: "wal checkpoint", statement: "PRAGMA wal_checkpoint(TRUNCATE)"},
+	{name: "incremental vacuum", statement: "PRAGMA incremental_vacuum"},
+}
+
+// Window restricts maintenance to a daily time-of-day range in local time.
+// Start and End are offsets from midnight. A window whose End is before its
+// Start wraps past midnight. The zero value allows maintenance at any time.
+type Window struct {
+	Start time.Duration
+	End   time.Duration
+}
+
+// Contains reports whether t falls inside the window.
+func (w Window) Contains(t time.Time) bool {
+	if w.Start == w.End {
+		return true
+	}
+
+	midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	offset := t.Sub(midnight)
+
+	if w.Start < w.End {
+		return offset >= w.Start && offset < w.End
+	}
+	return offset >= w.Start || offset < w.End
+}
+
+// MinWindow is the shortest window maintenance can be restricted to, so a
+// pass can still start when the process wakes up late.
+const MinWindow = 10 * time.Minute
+
+// Length returns how long the window is open each day, or 24 hours for the
+// zero value.
+func (w Window) Length() time.Duration {
+	if w.Start == w.End {
+		return 24 * time.Hour
+	}
+	if w.Start < w.End {
+		return w.End - w.Start
+	}
+	return 24*time.Hour - w.Start + w.End
+}
+
+// Next returns t if it falls inside the window, otherwise the next time the
+// window opens after t.
+func (w Window) Next(t time.Time) time.Time {
+	if w.Contains(t) {
+		return t
+	}
+
+	midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	open := midnight.Add(w.Start)
+	if open.Before(t) {
+		open = midnight.AddDate(0, 0, 1).Add(w.Start)
+	}
+	return open
+}
+
+// MarshalText formats the window as "HH:MM-HH:MM", or as an empty string
+// for the zero value.
+func (w Window) MarshalText() ([]byte, error) {
+	if w == (Window{}) {
+		return nil, nil
+	}
+	return []byte(formatClock(w.Start) + "-" + formatClock(w.End)), nil
+}
+
+// UnmarshalText parses a window written as "HH:MM-HH:MM", such as
+// "02:00-05:00". An empty string is the zero value.
+func (w *Window) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*w = Window{}
+		return nil
+	}
+
+	start, end, ok := strings.Cut(string(text), "-")
+	if !ok {
+		return fmt.Errorf("window %q is not in HH:MM-HH:MM form", text)
+	}
+	startOffset, err := parseClock(start)
+	if err != nil {
+		return err
+	}
+	endOffset, err := parseClock(end)
+	if err != nil {
+		return err
+	}
+
+	*w = Window{Start: startOffset, End: endOffset}
+	return nil
+}
+
+// parseClock parses an "HH:MM" time of day into an offset from midnight.
+func parseClock(s string) (time.Duration, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(s))
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of day %q: %w", s, err)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// formatClock formats an offset from midnight as "HH:MM".
+func formatClock(d time.Duration) string {
+	return fmt.Sprintf("%02d:%02d", int(d.Hours()), int(d.Minutes())%60)
+}
+
+// Maintainer periodically runs SQLite housekeeping so the database file stays
+// healthy without manual intervention.
+type Maintainer struct {
+	db       *sql.DB
+	interval time.Duration
+	window   Window
+	now      func() time.Time
+	after    func(d time.Duration) <-chan time.Time
+}
+
+// NewMaintainer creates a new instance of Maintainer that runs every interval
+// while inside window.
+func NewMaintainer(db *sql.DB, interval time.Duration, window Window) *Maintainer {
+	return &Maintainer{
+		db:       db,
+		interval: interval,
+		window:   window,
+		now:      time.Now,
+		after:    time.After,
+	}
+}
+
+// Run performs maintenance until ctx is cancelled. Each pass is due an
+// interval after the last one, or once the window opens if that is later.
+// The first pass is due an interval after Run is called.
+func (m *Maintainer) Run(ctx context.Context) {
+	last := m.now()
+	for {
+		due := last.Add(m.interval)
+		if now := m.now(); due.Before(now) {
+			due = now
+		}
+		due = m.window.Next(due)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-m.after(due.Sub(m.now())):
+		}
+
+		// Waking up after the window closed, such as after a suspend, waits
+		// for the window to open again
+		now := m.now()
+		if !m.window.Contains(now) {
+			continue
+		}
+		last = now
+		if err := m.RunOnce(ctx); err != nil {
+			slog.ErrorContext(ctx, "database maintenance failed", "error", err)
+		}
+	}
+}
+
+// RunOnce executes a single maintenance pass, stopping at the first failure.
+func (m *Maintainer) RunOnce(ctx context.Context) error {
+	for _, t := range tasks {
+		start := m.now()
+		if _, err := m.db.ExecContext(ctx, t.statement); err != nil {
+			return fmt.Errorf("failed to run %s: %w", t.name, err)
+		}
+		slog.InfoContext(ctx, "database maintenance task completed", "task", t.name, "duration", m.now().Sub(start))
+	}
+	return nil
+}
@@ -0,0 +1,178 @@
+package maintenance
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	_ "modernc.org/sqlite"
+)
+
+// setupTestDB creates an in-memory SQLite database with a table to maintain.
+func setupTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	db, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		t.Fatalf("failed to open in-memory database: %v", err)
+	}
+	db.SetMaxOpenConns(1)
+
+	_, err = db.Exec(`CREATE TABLE journal_entries (id INTEGER PRIMARY KEY, title TEXT NOT NULL)`)
+	if err != nil {
+		t.Fatalf("failed to create schema: %v", err)
+	}
+
+	return db
+}
+
+func TestMaintainer_RunOnce(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	maintainer := NewMaintainer(db, time.Hour, Window{})
+	if err := maintainer.RunOnce(context.Background()); err != nil {
+		t.Fatalf("RunOnce failed: %v", err)
+	}
+}
+
+func TestMaintainer_RunOnce_CancelledContext(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	maintainer := NewMaintainer(db, time.Hour, Window{})
+	if err := maintainer.RunOnce(ctx); err == nil {
+		t.Error("Expected error for cancelled context, got nil")
+	}
+}
+
+func TestMaintainer_Run(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Start at noon, outside the window, and advance the clock by every wait
+	now := time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC)
+	var wakes []time.Time
+	maintainer := NewMaintainer(db, 6*time.Hour, Window{Start: 2 * time.Hour, End: 5 * time.Hour})
+	maintainer.now = func() time.Time { return now }
+	maintainer.after = func(d time.Duration) <-chan time.Time {
+		now = now.Add(d)
+		wakes = append(wakes, now)
+		if len(wakes) == 3 {
+			cancel()
+			return nil
+		}
+		ch := make(chan time.Time, 1)
+		ch <- now
+		return ch
+	}
+	maintainer.Run(ctx)
+
+	want := []time.Time{
+		time.Date(2024, 1, 3, 2, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 4, 2, 0, 0, 0, time.UTC),
+	}
+	if len(wakes) != 3 || !wakes[0].Equal(want[0]) || !wakes[1].Equal(want[1]) {
+		t.Errorf("Expected runs at %v, got wakes at %v", want, wakes)
+	}
+}
+
+func TestWindow_Contains(t *testing.T) {
+	at := func(hour, minute int) time.Time {
+		return time.Date(2024, 1, 2, hour, minute, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name   string
+		window Window
+		t      time.Time
+		want   bool
+	}{
+		{"zero window always open", Window{}, at(12, 0), true},
+		{"inside window", Window{Start: 2 * time.Hour, End: 5 * time.Hour}, at(3, 30), true},
+		{"at window start", Window{Start: 2 * time.Hour, End: 5 * time.Hour}, at(2, 0), true},
+		{"at window end", Window{Start: 2 * time.Hour, End: 5 * time.Hour}, at(5, 0), false},
+		{"outside window", Window{Start: 2 * time.Hour, End: 5 * time.Hour}, at(12, 0), false},
+		{"wrapping window before midnight", Window{Start: 23 * time.Hour, End: 1 * time.Hour}, at(23, 30), true},
+		{"wrapping window after midnight", Window{Start: 23 * time.Hour, End: 1 * time.Hour}, at(0, 30), true},
+		{"outside wrapping window", Window{Start: 23 * time.Hour, End: 1 * time.Hour}, at(12, 0), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.window.Contains(tt.t); got != tt.want {
+				t.Errorf("Expected Contains to return %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestWindow_Next(t *testing.T) {
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2024, 1, day, hour, minute, 0, 0, time.UTC)
+	}
+	window := Window{Start: 2 * time.Hour, End: 5 * time.Hour}
+
+	tests := []struct {
+		name   string
+		window Window
+		t      time.Time
+		want   time.Time
+	}{
+		{"zero window", Window{}, at(2, 12, 0), at(2, 12, 0)},
+		{"inside window", window, at(2, 3, 0), at(2, 3, 0)},
+		{"before window", window, at(2, 1, 0), at(2, 2, 0)},
+		{"after window", window, at(2, 12, 0), at(3, 2, 0)},
+		{"wrapping window", Window{Start: 23 * time.Hour, End: 1 * time.Hour}, at(2, 12, 0), at(2, 23, 0)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.window.Next(tt.t); !got.Equal(tt.want) {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestWindow_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		want    Window
+		wantErr bool
+	}{
+		{"empty", "", Window{}, false},
+		{"range", "02:00-05:30", Window{Start: 2 * time.Hour, End: 5*time.Hour + 30*time.Minute}, false},
+		{"wrapping range", "23:00-01:00", Window{Start: 23 * time.Hour, End: 1 * time.Hour}, false},
+		{"missing end", "02:00", Window{}, true},
+		{"invalid time", "25:00-05:00", Window{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var w Window
+			err := w.UnmarshalText([]byte(tt.text))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Expected error %v, got %v", tt.wantErr, err)
+			}
+			if w != tt.want {
+				t.Errorf("Expected %+v, got %+v", tt.want, w)
+			}
+			if tt.wantErr {
+				return
+			}
+			text, err := w.MarshalText()
+			if err != nil || string(text) != tt.text {
+				t.Errorf("Expected MarshalText to return %q, got %q (%v)", tt.text, text, err)
+			}
+		})
+	}
+}
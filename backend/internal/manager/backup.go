@@ -0,0 +1,17 @@
+package manager
+
+import (
+	"context"
+
+	"github.com/parkernilson/micro-journal/internal/domain"
+)
+
+// PushBackup pushes a copy of the journal to the store's backup remote and
+// returns an identifier of the version pushed, such as a git commit hash.
+func (m *JournalManager) PushBackup(ctx context.Context) (string, error) {
+	pusher, ok := m.store.(domain.BackupPusher)
+	if !ok {
+		return "", preconditionf("the storage backend does not support pushing backups")
+	}
+	return pusher.PushBackup(ctx)
+}
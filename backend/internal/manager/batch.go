@@ -0,0 +1,128 @@
+package manager
+
+import (
+	"context"
+	"errors"
+
+	"github.com/parkernilson/micro-journal/internal/domain"
+)
+
+// maxBatchSize is the maximum number of items in one batch request.
+const maxBatchSize = 500
+
+// BatchResult is the outcome of one item of a batch. Err is set if the item
+// failed; otherwise Entry is set, except for deletes.
+type BatchResult struct {
+	Entry *domain.JournalEntry
+	Err   error
+}
+
+// BatchCreateEntries creates entries in one store transaction. Title,
+// Content, RevealAt, Document, and Tags are read from each entry, and are
+// validated as in CreateEntry. An entry that is invalid fails on its own
+// while the others are created; any other error fails the whole batch and
+// nothing is created. Results are in the order of entries.
+func (m *JournalManager) BatchCreateEntries(ctx context.Context, entries []*domain.JournalEntry) ([]BatchResult, error) {
+	if len(entries) > maxBatchSize {
+		return nil, invalidf("too many entries in batch: %d (max %d)", len(entries), maxBatchSize)
+	}
+
+	results := make([]BatchResult, len(entries))
+	err := m.store.WithTx(ctx, func(tx domain.JournalTx) error {
+		for i, e := range entries {
+			content, tags, err := m.normalizeCreate(e.Title, e.Content, e.RevealAt, e.Document, e.Tags)
+			var entry *domain.JournalEntry
+			if err == nil {
+				entry, err = tx.Create(ctx, e.Title, content, e.RevealAt, e.Document, tags)
+			}
+			if err := m.setResult(&results[i], entry, err); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// BatchGetEntries retrieves entries by ID in one store transaction, so they
+// are read from the same snapshot of the journal. An entry that does not
+// exist fails on its own. Results are in the order of ids.
+func (m *JournalManager) BatchGetEntries(ctx context.Context, ids []int64) ([]BatchResult, error) {
+	if len(ids) > maxBatchSize {
+		return nil, invalidf("too many entries in batch: %d (max %d)", len(ids), maxBatchSize)
+	}
+
+	results := make([]BatchResult, len(ids))
+	err := m.store.WithTx(ctx, func(tx domain.JournalTx) error {
+		for i, id := range ids {
+			entry, err := tx.GetByID(ctx, id)
+			if err := m.setResult(&results[i], entry, err); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// BatchDeleteEntries moves entries to the trash in one store transaction, or
+// removes them permanently if permanent is set. An entry that does not exist
+// fails on its own while the others are deleted. Results are in the order of
+// ids.
+func (m *JournalManager) BatchDeleteEntries(ctx context.Context, ids []int64, permanent bool) ([]BatchResult, error) {
+	if len(ids) > maxBatchSize {
+		return nil, invalidf("too many entries in batch: %d (max %d)", len(ids), maxBatchSize)
+	}
+
+	results := make([]BatchResult, len(ids))
+	err := m.store.WithTx(ctx, func(tx domain.JournalTx) error {
+		for i, id := range ids {
+			var err error
+			if permanent {
+				err = tx.Purge(ctx, id)
+			} else {
+				err = tx.Delete(ctx, id)
+			}
+			if err := m.setResult(&results[i], nil, err); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// setResult records the outcome of an item in result. If err is not about
+// the item alone, such as a database failure, it is returned instead so the
+// batch is rolled back.
+func (m *JournalManager) setResult(result *BatchResult, entry *domain.JournalEntry, err error) error {
+	if err != nil {
+		if !itemError(err) {
+			return err
+		}
+		result.Err = err
+		return nil
+	}
+	if entry != nil {
+		result.Entry = m.seal(entry)
+	}
+	return nil
+}
+
+// itemError reports whether err is about a single item of a batch, such as
+// invalid input or a missing entry, rather than a failure of the batch.
+func itemError(err error) bool {
+	return errors.Is(err, domain.ErrNotFound) ||
+		errors.Is(err, domain.ErrValidation) ||
+		errors.Is(err, domain.ErrFailedPrecondition) ||
+		errors.Is(err, domain.ErrConflict)
+}
@@ -0,0 +1,96 @@
+package manager
+
+import (
+	"fmt"
+
+	"github.com/parkernilson/micro-journal/internal/domain"
+)
+
+const (
+	// maxDocumentSections is the maximum number of sections in a document.
+	maxDocumentSections = 100
+
+	// defaultMaxRating is the rating scale used when a rating section does
+	// not specify one, and maxRatingScale is the largest scale allowed.
+	defaultMaxRating = 5
+	maxRatingScale   = 10
+)
+
+// normalizeDocument validates doc and fills in defaults (current version,
+// default rating scale). A nil document is valid and means plain text.
+func normalizeDocument(doc *domain.Document) error {
+	if doc == nil {
+		return nil
+	}
+
+	if doc.Version == 0 {
+		doc.Version = domain.DocumentVersion
+	}
+	if doc.Version != domain.DocumentVersion {
+		return invalidf("unsupported document version %d", doc.Version)
+	}
+
+	if len(doc.Sections) == 0 {
+		return invalidf("document must have at least one section")
+	}
+	if len(doc.Sections) > maxDocumentSections {
+		return invalidf("document cannot have more than %d sections", maxDocumentSections)
+	}
+
+	for i := range doc.Sections {
+		if err := normalizeSection(&doc.Sections[i]); err != nil {
+			return fmt.Errorf("section %d: %w", i+1, err)
+		}
+	}
+
+	return nil
+}
+
+// normalizeSection validates a single section and fills in defaults.
+func normalizeSection(section *domain.Section) error {
+	switch section.Type {
+	case domain.SectionText:
+		if section.Text == "" {
+			return invalidf("text cannot be empty")
+		}
+	case domain.SectionChecklist:
+		if len(section.Items) == 0 {
+			return invalidf("checklist must have at least one item")
+		}
+		for _, item := range section.Items {
+			if item.Text == "" {
+				return invalidf("checklist item text cannot be empty")
+			}
+		}
+	case domain.SectionRating:
+		if section.MaxRating == 0 {
+			section.MaxRating = defaultMaxRating
+		}
+		if section.MaxRating < 1 || section.MaxRating > maxRatingScale {
+			return invalidf("rating scale must be between 1 and %d", maxRatingScale)
+		}
+		if section.Rating < 1 || section.Rating > section.MaxRating {
+			return invalidf("rating must be between 1 and %d", section.MaxRating)
+		}
+	case domain.SectionPhoto:
+		if section.PhotoURL == "" {
+			return invalidf("photo URL cannot be empty")
+		}
+	default:
+		return invalidf("unknown section type %q", section.Type)
+	}
+
+	return nil
+}
+
+// documentContent validates doc and returns the content to store with it:
+// content itself, or the document's plain-text rendering if content is empty.
+func documentContent(content string, doc *domain.Document) (string, error) {
+	if err := normalizeDocument(doc); err != nil {
+		return "", fmt.Errorf("invalid document: %w", err)
+	}
+	if content == "" && doc != nil {
+		return doc.PlainText(), nil
+	}
+	return content, nil
+}
@@ -0,0 +1,32 @@
+package manager
+
+import (
+	"fmt"
+
+	"github.com/parkernilson/micro-journal/internal/domain"
+)
+
+// kindError is an error that also matches one of the domain error kinds,
+// without the kind being added to its message.
+type kindError struct {
+	err  error
+	kind error
+}
+
+func (e *kindError) Error() string {
+	return e.err.Error()
+}
+
+func (e *kindError) Unwrap() []error {
+	return []error{e.err, e.kind}
+}
+
+// invalidf formats an error matching domain.ErrValidation.
+func invalidf(format string, args ...any) error {
+	return &kindError{err: fmt.Errorf(format, args...), kind: domain.ErrValidation}
+}
+
+// preconditionf formats an error matching domain.ErrFailedPrecondition.
+func preconditionf(format string, args ...any) error {
+	return &kindError{err: fmt.Errorf(format, args...), kind: domain.ErrFailedPrecondition}
+}
@@ -0,0 +1,16 @@
+package manager
+
+import (
+	"context"
+
+	"github.com/parkernilson/micro-journal/internal/domain"
+)
+
+// ExportEntries calls fn for every entry not in the trash, oldest first,
+// stopping at the first error fn returns. Sealed entries are passed with
+// their content withheld.
+func (m *JournalManager) ExportEntries(ctx context.Context, fn func(entry *domain.JournalEntry) error) error {
+	return m.store.Export(ctx, func(entry *domain.JournalEntry) error {
+		return fn(m.seal(entry))
+	})
+}
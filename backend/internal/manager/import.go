@@ -0,0 +1,62 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/parkernilson/micro-journal/internal/domain"
+)
+
+// maxImportBatch is the maximum number of entries imported in one batch.
+const maxImportBatch = 500
+
+// maxClockSkew is how far in the future an imported entry's CreatedAt may
+// be, so entries captured on a machine whose clock runs slightly ahead are
+// not rejected.
+const maxClockSkew = time.Minute
+
+// ImportEntries validates a batch of entries from another journal and
+// inserts them in one transaction, keeping each entry's CreatedAt.
+// Title, Content, CreatedAt, RevealAt, Document, and Tags are read from each
+// entry; a missing title is suggested from the content. Entries that were
+// already imported are skipped. Returns the number of entries inserted.
+func (m *JournalManager) ImportEntries(ctx context.Context, entries []*domain.JournalEntry) (int64, error) {
+	if len(entries) > maxImportBatch {
+		return 0, invalidf("too many entries in batch: %d (max %d)", len(entries), maxImportBatch)
+	}
+
+	for i, entry := range entries {
+		if err := m.normalizeImport(entry); err != nil {
+			return 0, fmt.Errorf("entry %d: %w", i, err)
+		}
+	}
+
+	return m.store.Import(ctx, entries)
+}
+
+// normalizeImport validates an imported entry in place.
+func (m *JournalManager) normalizeImport(entry *domain.JournalEntry) error {
+	content, err := documentContent(entry.Content, entry.Document)
+	if err != nil {
+		return err
+	}
+	if content == "" {
+		return invalidf("content cannot be empty")
+	}
+	entry.Content = content
+
+	if entry.Title == "" {
+		entry.Title = suggestTitle(content)
+	}
+
+	if entry.CreatedAt.IsZero() {
+		return invalidf("created_at is required")
+	}
+	if entry.CreatedAt.After(m.now().Add(maxClockSkew)) {
+		return invalidf("created_at cannot be in the future")
+	}
+
+	entry.Tags, err = NormalizeTags(entry.Tags)
+	return err
+}
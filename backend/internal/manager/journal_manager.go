@@ -5,66 +5,132 @@ import (
 	"encoding/base64"
 	"fmt"
 	"strconv"
+	"time"
 
+	"github.com/parkernilson/micro-journal/internal/dateparse"
 	"github.com/parkernilson/micro-journal/internal/domain"
 )
 
-// JournalStore defines the interface for the store layer.
-// This allows the manager to be tested with a mock store.
-type JournalStore interface {
-	Create(ctx context.Context, title, content string) (*domain.JournalEntry, error)
-	GetByID(ctx context.Context, id int64) (*domain.JournalEntry, error)
-	Update(ctx context.Context, id int64, title, content string) (*domain.JournalEntry, error)
-	Delete(ctx context.Context, id int64) error
-	List(ctx context.Context, limit, offset int) ([]*domain.JournalEntry, int64, error)
-}
-
 // JournalManager handles business logic for journal entries.
 type JournalManager struct {
-	store JournalStore
+	store domain.JournalStore
+	now   func() time.Time
 }
 
 // NewJournalManager creates a new instance of JournalManager.
-func NewJournalManager(store JournalStore) *JournalManager {
-	return &JournalManager{store: store}
+func NewJournalManager(store domain.JournalStore) *JournalManager {
+	return &JournalManager{store: store, now: time.Now}
 }
 
 // CreateEntry creates a new journal entry.
-func (m *JournalManager) CreateEntry(ctx context.Context, title, content string) (*domain.JournalEntry, error) {
+// A non-zero revealAt seals the entry's content until that time.
+// A non-nil doc makes the entry structured; content defaults to its plain-text
+// rendering when empty. Tags are normalized to lowercase.
+func (m *JournalManager) CreateEntry(ctx context.Context, title, content string, revealAt time.Time, doc *domain.Document, tags []string) (*domain.JournalEntry, error) {
+	content, tags, err := m.normalizeCreate(title, content, revealAt, doc, tags)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := m.store.Create(ctx, title, content, revealAt, doc, tags)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.seal(entry), nil
+}
+
+// normalizeCreate validates a new entry and returns its content, derived
+// from doc if empty, and its normalized tags.
+func (m *JournalManager) normalizeCreate(title, content string, revealAt time.Time, doc *domain.Document, tags []string) (string, []string, error) {
 	// Add any business logic validation here
 	if title == "" {
-		return nil, fmt.Errorf("title cannot be empty")
+		return "", nil, invalidf("title cannot be empty")
+	}
+	content, err := documentContent(content, doc)
+	if err != nil {
+		return "", nil, err
 	}
 	if content == "" {
-		return nil, fmt.Errorf("content cannot be empty")
+		return "", nil, invalidf("content cannot be empty")
 	}
-
-	return m.store.Create(ctx, title, content)
+	if !revealAt.IsZero() && !revealAt.After(m.now()) {
+		return "", nil, invalidf("reveal time must be in the future")
+	}
+	tags, err = NormalizeTags(tags)
+	if err != nil {
+		return "", nil, err
+	}
+	return content, tags, nil
 }
 
 // GetEntry retrieves a journal entry by ID.
+// The content of a sealed entry is withheld until its reveal time.
 func (m *JournalManager) GetEntry(ctx context.Context, id int64) (*domain.JournalEntry, error) {
-	return m.store.GetByID(ctx, id)
+	entry, err := m.store.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.seal(entry), nil
 }
 
 // UpdateEntry updates an existing journal entry.
-func (m *JournalManager) UpdateEntry(ctx context.Context, id int64, title, content string) (*domain.JournalEntry, error) {
+// The entry's document and tags are replaced by doc and tags; a nil doc makes
+// it plain text. A non-zero expectedVersion makes the update fail with
+// domain.ErrConflict if the entry has changed since that version.
+func (m *JournalManager) UpdateEntry(ctx context.Context, id int64, title, content string, doc *domain.Document, tags []string, expectedVersion int64) (*domain.JournalEntry, error) {
 	// Add any business logic validation here
 	if title == "" {
-		return nil, fmt.Errorf("title cannot be empty")
+		return nil, invalidf("title cannot be empty")
+	}
+	content, err := documentContent(content, doc)
+	if err != nil {
+		return nil, err
 	}
 	if content == "" {
-		return nil, fmt.Errorf("content cannot be empty")
+		return nil, invalidf("content cannot be empty")
+	}
+	if expectedVersion < 0 {
+		return nil, invalidf("expected version cannot be negative")
 	}
 
-	return m.store.Update(ctx, id, title, content)
+	tags, err = NormalizeTags(tags)
+	if err != nil {
+		return nil, err
+	}
+
+	// Sealed entries cannot be edited until they are revealed
+	existing, err := m.store.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if existing.IsSealedAt(m.now()) {
+		return nil, preconditionf("journal entry is sealed until %s", existing.RevealAt.Format(time.RFC3339))
+	}
+
+	return m.store.Update(ctx, id, title, content, doc, tags, expectedVersion)
 }
 
-// DeleteEntry deletes a journal entry.
-func (m *JournalManager) DeleteEntry(ctx context.Context, id int64) error {
+// DeleteEntry moves a journal entry to the trash, or removes it permanently
+// if permanent is set.
+func (m *JournalManager) DeleteEntry(ctx context.Context, id int64, permanent bool) error {
+	if permanent {
+		return m.store.Purge(ctx, id)
+	}
 	return m.store.Delete(ctx, id)
 }
 
+// SuggestTitle suggests a title for the given content.
+func (m *JournalManager) SuggestTitle(ctx context.Context, content string) (string, error) {
+	title := suggestTitle(content)
+	if title == "" {
+		return "", invalidf("content cannot be empty")
+	}
+
+	return title, nil
+}
+
 // ListEntriesResult contains the result of listing journal entries.
 type ListEntriesResult struct {
 	Entries       []*domain.JournalEntry
@@ -72,10 +138,80 @@ type ListEntriesResult struct {
 	TotalCount    int64
 }
 
+// ListOptions filters the entries returned by ListEntries.
+type ListOptions struct {
+	// DateFilter is a human date phrase such as "last week" or "March 2023".
+	DateFilter string
+	// TimeZone is the IANA time zone DateFilter is interpreted in (UTC if empty).
+	TimeZone string
+	// Tag restricts results to entries with this tag.
+	Tag string
+}
+
 // ListEntries retrieves journal entries with pagination.
 // pageSize determines how many entries to return per page.
 // pageToken is a base64-encoded offset for pagination (empty for first page).
-func (m *JournalManager) ListEntries(ctx context.Context, pageSize int32, pageToken string) (*ListEntriesResult, error) {
+func (m *JournalManager) ListEntries(ctx context.Context, pageSize int32, pageToken string, opts ListOptions) (*ListEntriesResult, error) {
+	limit, offset, err := pageBounds(pageSize, pageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	filter, err := m.entryFilter(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get entries from store
+	entries, totalCount, err := m.store.List(ctx, filter, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		m.seal(entry)
+	}
+
+	return &ListEntriesResult{
+		Entries:       entries,
+		NextPageToken: nextPageToken(offset, len(entries), totalCount),
+		TotalCount:    totalCount,
+	}, nil
+}
+
+// SearchEntries retrieves journal entries matching a full-text query, best
+// match first, with the same pagination as ListEntries.
+// Sealed entries are never returned.
+func (m *JournalManager) SearchEntries(ctx context.Context, query string, pageSize int32, pageToken string) (*ListEntriesResult, error) {
+	match, err := normalizeSearchQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	limit, offset, err := pageBounds(pageSize, pageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, totalCount, err := m.store.Search(ctx, match, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		m.seal(entry)
+	}
+
+	return &ListEntriesResult{
+		Entries:       entries,
+		NextPageToken: nextPageToken(offset, len(entries), totalCount),
+		TotalCount:    totalCount,
+	}, nil
+}
+
+// pageBounds converts a page size and page token into a store limit and
+// offset. pageToken is a base64-encoded offset (empty for the first page).
+func pageBounds(pageSize int32, pageToken string) (limit, offset int, err error) {
 	// Default page size
 	if pageSize <= 0 {
 		pageSize = 10
@@ -87,34 +223,67 @@ func (m *JournalManager) ListEntries(ctx context.Context, pageSize int32, pageTo
 	}
 
 	// Decode page token to get offset
-	offset := 0
 	if pageToken != "" {
 		decoded, err := base64.StdEncoding.DecodeString(pageToken)
 		if err != nil {
-			return nil, fmt.Errorf("invalid page token: %w", err)
+			return 0, 0, invalidf("invalid page token: %w", err)
 		}
 		offset, err = strconv.Atoi(string(decoded))
 		if err != nil {
-			return nil, fmt.Errorf("invalid page token: %w", err)
+			return 0, 0, invalidf("invalid page token: %w", err)
 		}
 	}
 
-	// Get entries from store
-	entries, totalCount, err := m.store.List(ctx, int(pageSize), offset)
+	return int(pageSize), offset, nil
+}
+
+// nextPageToken returns the token for the page after one that started at
+// offset and returned count of total entries, or "" if it was the last page.
+func nextPageToken(offset, count int, total int64) string {
+	nextOffset := offset + count
+	if nextOffset >= int(total) {
+		return ""
+	}
+	return base64.StdEncoding.EncodeToString([]byte(strconv.Itoa(nextOffset)))
+}
+
+// entryFilter converts list options into a store filter.
+func (m *JournalManager) entryFilter(opts ListOptions) (domain.EntryFilter, error) {
+	var filter domain.EntryFilter
+
+	if opts.Tag != "" {
+		tag, err := normalizeTag(opts.Tag)
+		if err != nil {
+			return domain.EntryFilter{}, fmt.Errorf("invalid tag filter: %w", err)
+		}
+		filter.Tag = tag
+	}
+
+	if opts.DateFilter == "" {
+		return filter, nil
+	}
+
+	loc, err := time.LoadLocation(opts.TimeZone)
 	if err != nil {
-		return nil, err
+		return domain.EntryFilter{}, invalidf("invalid time zone: %w", err)
 	}
 
-	// Calculate next page token
-	nextPageToken := ""
-	nextOffset := offset + len(entries)
-	if nextOffset < int(totalCount) {
-		nextPageToken = base64.StdEncoding.EncodeToString([]byte(strconv.Itoa(nextOffset)))
+	dateRange, err := dateparse.Parse(opts.DateFilter, m.now().In(loc))
+	if err != nil {
+		return domain.EntryFilter{}, invalidf("invalid date filter: %w", err)
 	}
 
-	return &ListEntriesResult{
-		Entries:       entries,
-		NextPageToken: nextPageToken,
-		TotalCount:    totalCount,
-	}, nil
+	filter.CreatedFrom = dateRange.Start
+	filter.CreatedUntil = dateRange.End
+	return filter, nil
+}
+
+// seal withholds the content of entry if it has not been revealed yet.
+func (m *JournalManager) seal(entry *domain.JournalEntry) *domain.JournalEntry {
+	if entry.IsSealedAt(m.now()) {
+		entry.Content = ""
+		entry.Document = nil
+		entry.Sealed = true
+	}
+	return entry
 }
@@ -3,6 +3,9 @@ package manager
 import (
 	"context"
 	"errors"
+	"fmt"
+	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -11,16 +14,30 @@ import (
 
 // mockJournalStore is a mock implementation of JournalStore for testing.
 type mockJournalStore struct {
-	createFunc  func(ctx context.Context, title, content string) (*domain.JournalEntry, error)
-	getByIDFunc func(ctx context.Context, id int64) (*domain.JournalEntry, error)
-	updateFunc  func(ctx context.Context, id int64, title, content string) (*domain.JournalEntry, error)
-	deleteFunc  func(ctx context.Context, id int64) error
-	listFunc    func(ctx context.Context, limit, offset int) ([]*domain.JournalEntry, int64, error)
+	createFunc          func(ctx context.Context, title, content string, revealAt time.Time, doc *domain.Document, tags []string) (*domain.JournalEntry, error)
+	getByIDFunc         func(ctx context.Context, id int64) (*domain.JournalEntry, error)
+	updateFunc          func(ctx context.Context, id int64, title, content string, doc *domain.Document, tags []string, expectedVersion int64) (*domain.JournalEntry, error)
+	deleteFunc          func(ctx context.Context, id int64) error
+	listFunc            func(ctx context.Context, filter domain.EntryFilter, limit, offset int) ([]*domain.JournalEntry, int64, error)
+	searchFunc          func(ctx context.Context, query string, limit, offset int) ([]*domain.JournalEntry, int64, error)
+	listTagsFunc        func(ctx context.Context) ([]*domain.Tag, error)
+	renameTagFunc       func(ctx context.Context, name, newName string) (*domain.Tag, error)
+	deleteTagFunc       func(ctx context.Context, name string) error
+	purgeFunc           func(ctx context.Context, id int64) error
+	listTrashFunc       func(ctx context.Context, limit, offset int) ([]*domain.JournalEntry, int64, error)
+	restoreFunc         func(ctx context.Context, id int64) (*domain.JournalEntry, error)
+	purgeTrashFunc      func(ctx context.Context, deletedBefore time.Time) (int64, error)
+	listRevisionsFunc   func(ctx context.Context, entryID int64, limit, offset int) ([]*domain.Revision, int64, error)
+	searchRevisionsFunc func(ctx context.Context, entryID int64, query string, limit, offset int) ([]*domain.Revision, int64, error)
+	restoreRevisionFunc func(ctx context.Context, entryID, revisionID int64) (*domain.JournalEntry, error)
+	verifyArchiveFunc   func(ctx context.Context) (*domain.ArchiveReport, error)
+	exportFunc          func(ctx context.Context, fn func(entry *domain.JournalEntry) error) error
+	importFunc          func(ctx context.Context, entries []*domain.JournalEntry) (int64, error)
 }
 
-func (m *mockJournalStore) Create(ctx context.Context, title, content string) (*domain.JournalEntry, error) {
+func (m *mockJournalStore) Create(ctx context.Context, title, content string, revealAt time.Time, doc *domain.Document, tags []string) (*domain.JournalEntry, error) {
 	if m.createFunc != nil {
-		return m.createFunc(ctx, title, content)
+		return m.createFunc(ctx, title, content, revealAt, doc, tags)
 	}
 	return nil, errors.New("not implemented")
 }
@@ -32,9 +49,9 @@ func (m *mockJournalStore) GetByID(ctx context.Context, id int64) (*domain.Journ
 	return nil, errors.New("not implemented")
 }
 
-func (m *mockJournalStore) Update(ctx context.Context, id int64, title, content string) (*domain.JournalEntry, error) {
+func (m *mockJournalStore) Update(ctx context.Context, id int64, title, content string, doc *domain.Document, tags []string, expectedVersion int64) (*domain.JournalEntry, error) {
 	if m.updateFunc != nil {
-		return m.updateFunc(ctx, id, title, content)
+		return m.updateFunc(ctx, id, title, content, doc, tags, expectedVersion)
 	}
 	return nil, errors.New("not implemented")
 }
@@ -46,19 +63,122 @@ func (m *mockJournalStore) Delete(ctx context.Context, id int64) error {
 	return errors.New("not implemented")
 }
 
-func (m *mockJournalStore) List(ctx context.Context, limit, offset int) ([]*domain.JournalEntry, int64, error) {
+func (m *mockJournalStore) List(ctx context.Context, filter domain.EntryFilter, limit, offset int) ([]*domain.JournalEntry, int64, error) {
 	if m.listFunc != nil {
-		return m.listFunc(ctx, limit, offset)
+		return m.listFunc(ctx, filter, limit, offset)
 	}
 	return nil, 0, errors.New("not implemented")
 }
 
+func (m *mockJournalStore) Search(ctx context.Context, query string, limit, offset int) ([]*domain.JournalEntry, int64, error) {
+	if m.searchFunc != nil {
+		return m.searchFunc(ctx, query, limit, offset)
+	}
+	return nil, 0, errors.New("not implemented")
+}
+
+func (m *mockJournalStore) ListTags(ctx context.Context) ([]*domain.Tag, error) {
+	if m.listTagsFunc != nil {
+		return m.listTagsFunc(ctx)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockJournalStore) RenameTag(ctx context.Context, name, newName string) (*domain.Tag, error) {
+	if m.renameTagFunc != nil {
+		return m.renameTagFunc(ctx, name, newName)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockJournalStore) DeleteTag(ctx context.Context, name string) error {
+	if m.deleteTagFunc != nil {
+		return m.deleteTagFunc(ctx, name)
+	}
+	return errors.New("not implemented")
+}
+
+func (m *mockJournalStore) Purge(ctx context.Context, id int64) error {
+	if m.purgeFunc != nil {
+		return m.purgeFunc(ctx, id)
+	}
+	return errors.New("not implemented")
+}
+
+func (m *mockJournalStore) ListTrash(ctx context.Context, limit, offset int) ([]*domain.JournalEntry, int64, error) {
+	if m.listTrashFunc != nil {
+		return m.listTrashFunc(ctx, limit, offset)
+	}
+	return nil, 0, errors.New("not implemented")
+}
+
+func (m *mockJournalStore) Restore(ctx context.Context, id int64) (*domain.JournalEntry, error) {
+	if m.restoreFunc != nil {
+		return m.restoreFunc(ctx, id)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockJournalStore) PurgeTrash(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	if m.purgeTrashFunc != nil {
+		return m.purgeTrashFunc(ctx, deletedBefore)
+	}
+	return 0, errors.New("not implemented")
+}
+
+func (m *mockJournalStore) ListRevisions(ctx context.Context, entryID int64, limit, offset int) ([]*domain.Revision, int64, error) {
+	if m.listRevisionsFunc != nil {
+		return m.listRevisionsFunc(ctx, entryID, limit, offset)
+	}
+	return nil, 0, errors.New("not implemented")
+}
+
+func (m *mockJournalStore) SearchRevisions(ctx context.Context, entryID int64, query string, limit, offset int) ([]*domain.Revision, int64, error) {
+	if m.searchRevisionsFunc != nil {
+		return m.searchRevisionsFunc(ctx, entryID, query, limit, offset)
+	}
+	return nil, 0, errors.New("not implemented")
+}
+
+func (m *mockJournalStore) RestoreRevision(ctx context.Context, entryID, revisionID int64) (*domain.JournalEntry, error) {
+	if m.restoreRevisionFunc != nil {
+		return m.restoreRevisionFunc(ctx, entryID, revisionID)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockJournalStore) VerifyArchive(ctx context.Context) (*domain.ArchiveReport, error) {
+	if m.verifyArchiveFunc != nil {
+		return m.verifyArchiveFunc(ctx)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockJournalStore) Export(ctx context.Context, fn func(entry *domain.JournalEntry) error) error {
+	if m.exportFunc != nil {
+		return m.exportFunc(ctx, fn)
+	}
+	return errors.New("not implemented")
+}
+
+func (m *mockJournalStore) Import(ctx context.Context, entries []*domain.JournalEntry) (int64, error) {
+	if m.importFunc != nil {
+		return m.importFunc(ctx, entries)
+	}
+	return 0, errors.New("not implemented")
+}
+
+// WithTx runs fn against the mock itself, which has no transactions.
+func (m *mockJournalStore) WithTx(ctx context.Context, fn func(tx domain.JournalTx) error) error {
+	return fn(m)
+}
+
 func TestJournalManager_CreateEntry(t *testing.T) {
 	ctx := context.Background()
 
 	t.Run("successful creation", func(t *testing.T) {
 		mockStore := &mockJournalStore{
-			createFunc: func(ctx context.Context, title, content string) (*domain.JournalEntry, error) {
+			createFunc: func(ctx context.Context, title, content string, revealAt time.Time, doc *domain.Document, tags []string) (*domain.JournalEntry, error) {
 				return &domain.JournalEntry{
 					ID:        1,
 					Title:     title,
@@ -70,7 +190,7 @@ func TestJournalManager_CreateEntry(t *testing.T) {
 		}
 
 		manager := NewJournalManager(mockStore)
-		entry, err := manager.CreateEntry(ctx, "Test Title", "Test Content")
+		entry, err := manager.CreateEntry(ctx, "Test Title", "Test Content", time.Time{}, nil, nil)
 
 		if err != nil {
 			t.Fatalf("CreateEntry failed: %v", err)
@@ -83,22 +203,121 @@ func TestJournalManager_CreateEntry(t *testing.T) {
 	t.Run("empty title", func(t *testing.T) {
 		mockStore := &mockJournalStore{}
 		manager := NewJournalManager(mockStore)
-		_, err := manager.CreateEntry(ctx, "", "Test Content")
+		_, err := manager.CreateEntry(ctx, "", "Test Content", time.Time{}, nil, nil)
 
-		if err == nil {
-			t.Error("Expected error for empty title, got nil")
+		if !errors.Is(err, domain.ErrValidation) {
+			t.Errorf("Expected validation error for empty title, got %v", err)
 		}
 	})
 
 	t.Run("empty content", func(t *testing.T) {
 		mockStore := &mockJournalStore{}
 		manager := NewJournalManager(mockStore)
-		_, err := manager.CreateEntry(ctx, "Test Title", "")
+		_, err := manager.CreateEntry(ctx, "Test Title", "", time.Time{}, nil, nil)
 
 		if err == nil {
 			t.Error("Expected error for empty content, got nil")
 		}
 	})
+
+	t.Run("sealed entry", func(t *testing.T) {
+		revealAt := time.Now().Add(24 * time.Hour)
+		mockStore := &mockJournalStore{
+			createFunc: func(ctx context.Context, title, content string, gotRevealAt time.Time, doc *domain.Document, tags []string) (*domain.JournalEntry, error) {
+				return &domain.JournalEntry{
+					ID:        1,
+					Title:     title,
+					Content:   content,
+					CreatedAt: time.Now(),
+					UpdatedAt: time.Now(),
+					RevealAt:  gotRevealAt,
+				}, nil
+			},
+		}
+
+		manager := NewJournalManager(mockStore)
+		entry, err := manager.CreateEntry(ctx, "Dear Future Me", "Sealed Content", revealAt, nil, nil)
+
+		if err != nil {
+			t.Fatalf("CreateEntry failed: %v", err)
+		}
+		if !entry.Sealed {
+			t.Error("Expected entry to be sealed")
+		}
+		if entry.Content != "" {
+			t.Errorf("Expected empty content, got '%s'", entry.Content)
+		}
+	})
+
+	t.Run("reveal time in the past", func(t *testing.T) {
+		mockStore := &mockJournalStore{}
+		manager := NewJournalManager(mockStore)
+		_, err := manager.CreateEntry(ctx, "Test Title", "Test Content", time.Now().Add(-time.Hour), nil, nil)
+
+		if err == nil {
+			t.Error("Expected error for past reveal time, got nil")
+		}
+	})
+
+	t.Run("structured entry", func(t *testing.T) {
+		var stored *domain.Document
+		mockStore := &mockJournalStore{
+			createFunc: func(ctx context.Context, title, content string, revealAt time.Time, doc *domain.Document, tags []string) (*domain.JournalEntry, error) {
+				stored = doc
+				return &domain.JournalEntry{ID: 1, Title: title, Content: content, Document: doc}, nil
+			},
+		}
+
+		manager := NewJournalManager(mockStore)
+		doc := &domain.Document{
+			Sections: []domain.Section{
+				{Type: domain.SectionText, Heading: "Morning", Text: "Coffee"},
+				{Type: domain.SectionChecklist, Items: []domain.ChecklistItem{{Text: "Stretch", Checked: true}, {Text: "Read"}}},
+				{Type: domain.SectionRating, Rating: 4},
+			},
+		}
+		entry, err := manager.CreateEntry(ctx, "Test Title", "", time.Time{}, doc, nil)
+
+		if err != nil {
+			t.Fatalf("CreateEntry failed: %v", err)
+		}
+		if stored.Version != domain.DocumentVersion {
+			t.Errorf("Expected version %d, got %d", domain.DocumentVersion, stored.Version)
+		}
+		if stored.Sections[2].MaxRating != defaultMaxRating {
+			t.Errorf("Expected default max rating %d, got %d", defaultMaxRating, stored.Sections[2].MaxRating)
+		}
+		want := "Morning\nCoffee\n\n- [x] Stretch\n- [ ] Read\n\n4/5"
+		if entry.Content != want {
+			t.Errorf("Expected derived content %q, got %q", want, entry.Content)
+		}
+	})
+
+	t.Run("invalid document", func(t *testing.T) {
+		tests := []struct {
+			name string
+			doc  *domain.Document
+		}{
+			{"unsupported version", &domain.Document{Version: 2, Sections: []domain.Section{{Type: domain.SectionText, Text: "x"}}}},
+			{"no sections", &domain.Document{}},
+			{"empty text", &domain.Document{Sections: []domain.Section{{Type: domain.SectionText}}}},
+			{"empty checklist", &domain.Document{Sections: []domain.Section{{Type: domain.SectionChecklist}}}},
+			{"rating out of range", &domain.Document{Sections: []domain.Section{{Type: domain.SectionRating, Rating: 6}}}},
+			{"photo without url", &domain.Document{Sections: []domain.Section{{Type: domain.SectionPhoto, Caption: "Sunset"}}}},
+			{"unknown type", &domain.Document{Sections: []domain.Section{{Type: "video"}}}},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				manager := NewJournalManager(&mockJournalStore{})
+				_, err := manager.CreateEntry(ctx, "Test Title", "Test Content", time.Time{}, tt.doc, nil)
+
+				if !errors.Is(err, domain.ErrValidation) {
+					t.Errorf("Expected validation error for invalid document, got %v", err)
+				}
+			})
+		}
+	})
 }
 
 func TestJournalManager_GetEntry(t *testing.T) {
@@ -139,12 +358,65 @@ func TestJournalManager_GetEntry(t *testing.T) {
 	})
 }
 
+func TestJournalManager_GetEntry_Sealed(t *testing.T) {
+	ctx := context.Background()
+	revealAt := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	mockStore := &mockJournalStore{
+		getByIDFunc: func(ctx context.Context, id int64) (*domain.JournalEntry, error) {
+			return &domain.JournalEntry{
+				ID:        id,
+				Title:     "Dear Future Me",
+				Content:   "Sealed Content",
+				CreatedAt: time.Now(),
+				UpdatedAt: time.Now(),
+				RevealAt:  revealAt,
+			}, nil
+		},
+	}
+
+	manager := NewJournalManager(mockStore)
+
+	t.Run("before reveal time", func(t *testing.T) {
+		manager.now = func() time.Time { return revealAt.Add(-time.Second) }
+
+		entry, err := manager.GetEntry(ctx, 1)
+		if err != nil {
+			t.Fatalf("GetEntry failed: %v", err)
+		}
+		if !entry.Sealed {
+			t.Error("Expected entry to be sealed")
+		}
+		if entry.Content != "" {
+			t.Errorf("Expected empty content, got '%s'", entry.Content)
+		}
+	})
+
+	t.Run("at reveal time", func(t *testing.T) {
+		manager.now = func() time.Time { return revealAt }
+
+		entry, err := manager.GetEntry(ctx, 1)
+		if err != nil {
+			t.Fatalf("GetEntry failed: %v", err)
+		}
+		if entry.Sealed {
+			t.Error("Expected entry to be revealed")
+		}
+		if entry.Content != "Sealed Content" {
+			t.Errorf("Expected content 'Sealed Content', got '%s'", entry.Content)
+		}
+	})
+}
+
 func TestJournalManager_UpdateEntry(t *testing.T) {
 	ctx := context.Background()
 
 	t.Run("successful update", func(t *testing.T) {
 		mockStore := &mockJournalStore{
-			updateFunc: func(ctx context.Context, id int64, title, content string) (*domain.JournalEntry, error) {
+			getByIDFunc: func(ctx context.Context, id int64) (*domain.JournalEntry, error) {
+				return &domain.JournalEntry{ID: id, Title: "Title", Content: "Content"}, nil
+			},
+			updateFunc: func(ctx context.Context, id int64, title, content string, doc *domain.Document, tags []string, expectedVersion int64) (*domain.JournalEntry, error) {
 				return &domain.JournalEntry{
 					ID:        id,
 					Title:     title,
@@ -156,7 +428,7 @@ func TestJournalManager_UpdateEntry(t *testing.T) {
 		}
 
 		manager := NewJournalManager(mockStore)
-		entry, err := manager.UpdateEntry(ctx, 1, "Updated Title", "Updated Content")
+		entry, err := manager.UpdateEntry(ctx, 1, "Updated Title", "Updated Content", nil, nil, 0)
 
 		if err != nil {
 			t.Fatalf("UpdateEntry failed: %v", err)
@@ -169,7 +441,7 @@ func TestJournalManager_UpdateEntry(t *testing.T) {
 	t.Run("empty title", func(t *testing.T) {
 		mockStore := &mockJournalStore{}
 		manager := NewJournalManager(mockStore)
-		_, err := manager.UpdateEntry(ctx, 1, "", "Test Content")
+		_, err := manager.UpdateEntry(ctx, 1, "", "Test Content", nil, nil, 0)
 
 		if err == nil {
 			t.Error("Expected error for empty title, got nil")
@@ -179,12 +451,58 @@ func TestJournalManager_UpdateEntry(t *testing.T) {
 	t.Run("empty content", func(t *testing.T) {
 		mockStore := &mockJournalStore{}
 		manager := NewJournalManager(mockStore)
-		_, err := manager.UpdateEntry(ctx, 1, "Test Title", "")
+		_, err := manager.UpdateEntry(ctx, 1, "Test Title", "", nil, nil, 0)
 
 		if err == nil {
 			t.Error("Expected error for empty content, got nil")
 		}
 	})
+
+	t.Run("sealed entry", func(t *testing.T) {
+		mockStore := &mockJournalStore{
+			getByIDFunc: func(ctx context.Context, id int64) (*domain.JournalEntry, error) {
+				return &domain.JournalEntry{
+					ID:       id,
+					Title:    "Dear Future Me",
+					Content:  "Sealed Content",
+					RevealAt: time.Now().Add(24 * time.Hour),
+				}, nil
+			},
+		}
+
+		manager := NewJournalManager(mockStore)
+		_, err := manager.UpdateEntry(ctx, 1, "Updated Title", "Updated Content", nil, nil, 0)
+
+		if !errors.Is(err, domain.ErrFailedPrecondition) {
+			t.Errorf("Expected failed precondition for sealed entry, got %v", err)
+		}
+	})
+
+	t.Run("expected version", func(t *testing.T) {
+		var got int64
+		mockStore := &mockJournalStore{
+			getByIDFunc: func(ctx context.Context, id int64) (*domain.JournalEntry, error) {
+				return &domain.JournalEntry{ID: id, Title: "Title", Content: "Content", Version: 3}, nil
+			},
+			updateFunc: func(ctx context.Context, id int64, title, content string, doc *domain.Document, tags []string, expectedVersion int64) (*domain.JournalEntry, error) {
+				got = expectedVersion
+				return &domain.JournalEntry{ID: id, Title: title, Content: content, Version: expectedVersion + 1}, nil
+			},
+		}
+
+		manager := NewJournalManager(mockStore)
+		if _, err := manager.UpdateEntry(ctx, 1, "Updated Title", "Updated Content", nil, nil, 3); err != nil {
+			t.Fatalf("UpdateEntry failed: %v", err)
+		}
+		if got != 3 {
+			t.Errorf("Expected expected version 3 passed to the store, got %d", got)
+		}
+
+		_, err := manager.UpdateEntry(ctx, 1, "Updated Title", "Updated Content", nil, nil, -1)
+		if !errors.Is(err, domain.ErrValidation) {
+			t.Errorf("Expected validation error for negative version, got %v", err)
+		}
+	})
 }
 
 func TestJournalManager_DeleteEntry(t *testing.T) {
@@ -202,18 +520,139 @@ func TestJournalManager_DeleteEntry(t *testing.T) {
 	manager := NewJournalManager(mockStore)
 
 	t.Run("successful delete", func(t *testing.T) {
-		err := manager.DeleteEntry(ctx, 1)
+		err := manager.DeleteEntry(ctx, 1, false)
 		if err != nil {
 			t.Fatalf("DeleteEntry failed: %v", err)
 		}
 	})
 
 	t.Run("not found", func(t *testing.T) {
-		err := manager.DeleteEntry(ctx, 999)
+		err := manager.DeleteEntry(ctx, 999, false)
 		if err == nil {
 			t.Error("Expected error for non-existent entry, got nil")
 		}
 	})
+
+	t.Run("permanent delete", func(t *testing.T) {
+		purged := false
+		mockStore.purgeFunc = func(ctx context.Context, id int64) error {
+			purged = true
+			return nil
+		}
+
+		if err := manager.DeleteEntry(ctx, 1, true); err != nil {
+			t.Fatalf("DeleteEntry failed: %v", err)
+		}
+		if !purged {
+			t.Error("Expected entry to be purged")
+		}
+	})
+}
+
+func TestJournalManager_Batch(t *testing.T) {
+	ctx := context.Background()
+	revealAt := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	mockStore := &mockJournalStore{
+		createFunc: func(ctx context.Context, title, content string, revealAt time.Time, doc *domain.Document, tags []string) (*domain.JournalEntry, error) {
+			return &domain.JournalEntry{ID: 1, Title: title, Content: content, RevealAt: revealAt, Tags: tags}, nil
+		},
+		getByIDFunc: func(ctx context.Context, id int64) (*domain.JournalEntry, error) {
+			if id != 1 {
+				return nil, fmt.Errorf("journal entry %d: %w", id, domain.ErrNotFound)
+			}
+			return &domain.JournalEntry{ID: id, Content: "Sealed Content", RevealAt: revealAt}, nil
+		},
+		deleteFunc: func(ctx context.Context, id int64) error {
+			if id != 1 {
+				return fmt.Errorf("journal entry %d: %w", id, domain.ErrNotFound)
+			}
+			return nil
+		},
+	}
+
+	manager := NewJournalManager(mockStore)
+	manager.now = func() time.Time { return revealAt.Add(-time.Second) }
+
+	t.Run("invalid entries fail on their own", func(t *testing.T) {
+		results, err := manager.BatchCreateEntries(ctx, []*domain.JournalEntry{
+			{Title: "First", Content: "Content", Tags: []string{" Work "}},
+			{Title: "Empty"},
+		})
+		if err != nil {
+			t.Fatalf("BatchCreateEntries failed: %v", err)
+		}
+		if results[0].Err != nil || !reflect.DeepEqual(results[0].Entry.Tags, []string{"work"}) {
+			t.Errorf("Unexpected first result %+v", results[0])
+		}
+		if !errors.Is(results[1].Err, domain.ErrValidation) || results[1].Entry != nil {
+			t.Errorf("Expected a validation error, got %+v", results[1])
+		}
+	})
+
+	t.Run("get seals entries and reports missing ones", func(t *testing.T) {
+		results, err := manager.BatchGetEntries(ctx, []int64{1, 2})
+		if err != nil {
+			t.Fatalf("BatchGetEntries failed: %v", err)
+		}
+		if !results[0].Entry.Sealed || results[0].Entry.Content != "" {
+			t.Errorf("Expected a sealed entry, got %+v", results[0].Entry)
+		}
+		if !errors.Is(results[1].Err, domain.ErrNotFound) {
+			t.Errorf("Expected not found, got %v", results[1].Err)
+		}
+	})
+
+	t.Run("delete reports missing entries", func(t *testing.T) {
+		results, err := manager.BatchDeleteEntries(ctx, []int64{1, 2}, false)
+		if err != nil {
+			t.Fatalf("BatchDeleteEntries failed: %v", err)
+		}
+		if results[0].Err != nil || !errors.Is(results[1].Err, domain.ErrNotFound) {
+			t.Errorf("Unexpected results %+v", results)
+		}
+	})
+
+	t.Run("store failure fails the batch", func(t *testing.T) {
+		failing := &mockJournalStore{
+			purgeFunc: func(ctx context.Context, id int64) error {
+				return errors.New("disk full")
+			},
+		}
+		if _, err := NewJournalManager(failing).BatchDeleteEntries(ctx, []int64{1}, true); err == nil {
+			t.Error("Expected error, got nil")
+		}
+	})
+
+	t.Run("too many entries", func(t *testing.T) {
+		_, err := manager.BatchGetEntries(ctx, make([]int64, maxBatchSize+1))
+		if !errors.Is(err, domain.ErrValidation) {
+			t.Errorf("Expected validation error, got %v", err)
+		}
+	})
+}
+
+func TestJournalManager_RestoreEntry(t *testing.T) {
+	ctx := context.Background()
+
+	mockStore := &mockJournalStore{
+		restoreFunc: func(ctx context.Context, id int64) (*domain.JournalEntry, error) {
+			return &domain.JournalEntry{
+				ID:       id,
+				Content:  "Sealed Content",
+				RevealAt: time.Now().Add(time.Hour),
+			}, nil
+		},
+	}
+
+	manager := NewJournalManager(mockStore)
+	entry, err := manager.RestoreEntry(ctx, 1)
+	if err != nil {
+		t.Fatalf("RestoreEntry failed: %v", err)
+	}
+	if !entry.Sealed || entry.Content != "" {
+		t.Errorf("Expected restored entry to stay sealed, got %+v", entry)
+	}
 }
 
 func TestJournalManager_ListEntries(t *testing.T) {
@@ -235,7 +674,7 @@ func TestJournalManager_ListEntries(t *testing.T) {
 
 	t.Run("first page", func(t *testing.T) {
 		mockStore := &mockJournalStore{
-			listFunc: func(ctx context.Context, limit, offset int) ([]*domain.JournalEntry, int64, error) {
+			listFunc: func(ctx context.Context, filter domain.EntryFilter, limit, offset int) ([]*domain.JournalEntry, int64, error) {
 				if limit == 10 && offset == 0 {
 					return createMockEntries(10), 25, nil
 				}
@@ -244,7 +683,7 @@ func TestJournalManager_ListEntries(t *testing.T) {
 		}
 
 		manager := NewJournalManager(mockStore)
-		result, err := manager.ListEntries(ctx, 10, "")
+		result, err := manager.ListEntries(ctx, 10, "", ListOptions{})
 
 		if err != nil {
 			t.Fatalf("ListEntries failed: %v", err)
@@ -262,7 +701,7 @@ func TestJournalManager_ListEntries(t *testing.T) {
 
 	t.Run("second page", func(t *testing.T) {
 		mockStore := &mockJournalStore{
-			listFunc: func(ctx context.Context, limit, offset int) ([]*domain.JournalEntry, int64, error) {
+			listFunc: func(ctx context.Context, filter domain.EntryFilter, limit, offset int) ([]*domain.JournalEntry, int64, error) {
 				if limit == 10 && offset == 10 {
 					return createMockEntries(10), 25, nil
 				}
@@ -273,7 +712,7 @@ func TestJournalManager_ListEntries(t *testing.T) {
 		manager := NewJournalManager(mockStore)
 		// "10" encoded in base64 is "MTA="
 		pageToken := "MTA="
-		result, err := manager.ListEntries(ctx, 10, pageToken)
+		result, err := manager.ListEntries(ctx, 10, pageToken, ListOptions{})
 
 		if err != nil {
 			t.Fatalf("ListEntries failed: %v", err)
@@ -285,7 +724,7 @@ func TestJournalManager_ListEntries(t *testing.T) {
 
 	t.Run("last page", func(t *testing.T) {
 		mockStore := &mockJournalStore{
-			listFunc: func(ctx context.Context, limit, offset int) ([]*domain.JournalEntry, int64, error) {
+			listFunc: func(ctx context.Context, filter domain.EntryFilter, limit, offset int) ([]*domain.JournalEntry, int64, error) {
 				if limit == 10 && offset == 20 {
 					return createMockEntries(5), 25, nil
 				}
@@ -296,7 +735,7 @@ func TestJournalManager_ListEntries(t *testing.T) {
 		manager := NewJournalManager(mockStore)
 		// "20" encoded in base64 is "MjA="
 		pageToken := "MjA="
-		result, err := manager.ListEntries(ctx, 10, pageToken)
+		result, err := manager.ListEntries(ctx, 10, pageToken, ListOptions{})
 
 		if err != nil {
 			t.Fatalf("ListEntries failed: %v", err)
@@ -311,7 +750,7 @@ func TestJournalManager_ListEntries(t *testing.T) {
 
 	t.Run("default page size", func(t *testing.T) {
 		mockStore := &mockJournalStore{
-			listFunc: func(ctx context.Context, limit, offset int) ([]*domain.JournalEntry, int64, error) {
+			listFunc: func(ctx context.Context, filter domain.EntryFilter, limit, offset int) ([]*domain.JournalEntry, int64, error) {
 				if limit == 10 && offset == 0 {
 					return createMockEntries(10), 10, nil
 				}
@@ -320,7 +759,7 @@ func TestJournalManager_ListEntries(t *testing.T) {
 		}
 
 		manager := NewJournalManager(mockStore)
-		_, err := manager.ListEntries(ctx, 0, "")
+		_, err := manager.ListEntries(ctx, 0, "", ListOptions{})
 
 		if err != nil {
 			t.Fatalf("ListEntries failed: %v", err)
@@ -329,7 +768,7 @@ func TestJournalManager_ListEntries(t *testing.T) {
 
 	t.Run("max page size", func(t *testing.T) {
 		mockStore := &mockJournalStore{
-			listFunc: func(ctx context.Context, limit, offset int) ([]*domain.JournalEntry, int64, error) {
+			listFunc: func(ctx context.Context, filter domain.EntryFilter, limit, offset int) ([]*domain.JournalEntry, int64, error) {
 				if limit == 100 && offset == 0 {
 					return createMockEntries(100), 200, nil
 				}
@@ -338,20 +777,470 @@ func TestJournalManager_ListEntries(t *testing.T) {
 		}
 
 		manager := NewJournalManager(mockStore)
-		_, err := manager.ListEntries(ctx, 200, "")
+		_, err := manager.ListEntries(ctx, 200, "", ListOptions{})
+
+		if err != nil {
+			t.Fatalf("ListEntries failed: %v", err)
+		}
+	})
+
+	t.Run("date filter", func(t *testing.T) {
+		mockStore := &mockJournalStore{
+			listFunc: func(ctx context.Context, filter domain.EntryFilter, limit, offset int) ([]*domain.JournalEntry, int64, error) {
+				loc, _ := time.LoadLocation("America/New_York")
+				wantFrom := time.Date(2023, 3, 1, 0, 0, 0, 0, loc)
+				wantUntil := time.Date(2023, 4, 1, 0, 0, 0, 0, loc)
+				if !filter.CreatedFrom.Equal(wantFrom) || !filter.CreatedUntil.Equal(wantUntil) {
+					return nil, 0, errors.New("unexpected filter")
+				}
+				return createMockEntries(3), 3, nil
+			},
+		}
+
+		manager := NewJournalManager(mockStore)
+		opts := ListOptions{DateFilter: "March 2023", TimeZone: "America/New_York"}
+		result, err := manager.ListEntries(ctx, 10, "", opts)
 
 		if err != nil {
 			t.Fatalf("ListEntries failed: %v", err)
 		}
+		if len(result.Entries) != 3 {
+			t.Errorf("Expected 3 entries, got %d", len(result.Entries))
+		}
+	})
+
+	t.Run("invalid date filter", func(t *testing.T) {
+		mockStore := &mockJournalStore{}
+		manager := NewJournalManager(mockStore)
+		_, err := manager.ListEntries(ctx, 10, "", ListOptions{DateFilter: "whenever"})
+
+		if err == nil {
+			t.Error("Expected error for invalid date filter, got nil")
+		}
+	})
+
+	t.Run("invalid time zone", func(t *testing.T) {
+		mockStore := &mockJournalStore{}
+		manager := NewJournalManager(mockStore)
+		_, err := manager.ListEntries(ctx, 10, "", ListOptions{DateFilter: "today", TimeZone: "Mars/Olympus"})
+
+		if err == nil {
+			t.Error("Expected error for invalid time zone, got nil")
+		}
 	})
 
 	t.Run("invalid page token", func(t *testing.T) {
 		mockStore := &mockJournalStore{}
 		manager := NewJournalManager(mockStore)
-		_, err := manager.ListEntries(ctx, 10, "invalid-token")
+		_, err := manager.ListEntries(ctx, 10, "invalid-token", ListOptions{})
 
 		if err == nil {
 			t.Error("Expected error for invalid page token, got nil")
 		}
 	})
 }
+
+func TestJournalManager_SuggestTitle(t *testing.T) {
+	ctx := context.Background()
+	manager := NewJournalManager(&mockJournalStore{})
+
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{"first sentence", "Went hiking today. The view was great.", "Went hiking today"},
+		{"first line", "Morning coffee\nThen a long walk", "Morning coffee"},
+		{"skips blank lines", "\n\n  Late night thoughts  ", "Late night thoughts"},
+		{"strips markdown heading", "## Weekly review\nIt went well.", "Weekly review"},
+		{"collapses whitespace", "Too   many\tspaces here", "Too many spaces here"},
+		{"skips leading punctuation", "...and then it rained. All day.", "and then it rained"},
+		{"keeps punctuation within words", "v1.2 release is out", "v1.2 release is out"},
+		{"repeated punctuation", "Wow!! What a day", "Wow"},
+		{"only punctuation", "?!", "?!"},
+		{"only heading markers", "###", "###"},
+		{
+			"shortens at word boundary",
+			"This is a very long first sentence that keeps going well past the limit for titles",
+			"This is a very long first sentence that keeps going well...",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			title, err := manager.SuggestTitle(ctx, tt.content)
+			if err != nil {
+				t.Fatalf("SuggestTitle failed: %v", err)
+			}
+			if title != tt.want {
+				t.Errorf("Expected title '%s', got '%s'", tt.want, title)
+			}
+		})
+	}
+
+	t.Run("empty content", func(t *testing.T) {
+		_, err := manager.SuggestTitle(ctx, "  \n ")
+		if err == nil {
+			t.Error("Expected error for empty content, got nil")
+		}
+	})
+}
+
+func TestJournalManager_SearchEntries(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("normalizes query and paginates", func(t *testing.T) {
+		mockStore := &mockJournalStore{
+			searchFunc: func(ctx context.Context, query string, limit, offset int) ([]*domain.JournalEntry, int64, error) {
+				if query != `"Coffee" "morn"*` {
+					t.Errorf("Expected normalized query, got %s", query)
+				}
+				if limit != 2 || offset != 0 {
+					t.Errorf("Expected limit 2 offset 0, got %d %d", limit, offset)
+				}
+				return []*domain.JournalEntry{{ID: 1}, {ID: 2}}, 3, nil
+			},
+		}
+
+		manager := NewJournalManager(mockStore)
+		result, err := manager.SearchEntries(ctx, "  Coffee? morn* ", 2, "")
+
+		if err != nil {
+			t.Fatalf("SearchEntries failed: %v", err)
+		}
+		if len(result.Entries) != 2 {
+			t.Errorf("Expected 2 entries, got %d", len(result.Entries))
+		}
+		if result.NextPageToken == "" {
+			t.Error("Expected a next page token")
+		}
+	})
+
+	t.Run("invalid query", func(t *testing.T) {
+		manager := NewJournalManager(&mockJournalStore{})
+		for _, query := range []string{"", "   ", "!!! ???", strings.Repeat("a ", 200)} {
+			if _, err := manager.SearchEntries(ctx, query, 10, ""); err == nil {
+				t.Errorf("Expected error for query %q, got nil", query)
+			}
+		}
+	})
+}
+
+func TestNormalizeSearchQuery(t *testing.T) {
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{"coffee", `"coffee"`},
+		{"Coffee Shop", `"Coffee" "Shop"`},
+		{`"quoted" OR NOT`, `"quoted" "OR" "NOT"`},
+		{"run*", `"run"*`},
+		{"don't", `"don" "t"`},
+		{"café *", `"café"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			got, err := normalizeSearchQuery(tt.query)
+			if err != nil {
+				t.Fatalf("normalizeSearchQuery failed: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestJournalManager_CreateEntry_Tags(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("normalizes tags", func(t *testing.T) {
+		mockStore := &mockJournalStore{
+			createFunc: func(ctx context.Context, title, content string, revealAt time.Time, doc *domain.Document, tags []string) (*domain.JournalEntry, error) {
+				want := []string{"travel", "work-trip"}
+				if !reflect.DeepEqual(tags, want) {
+					t.Errorf("Expected tags %v, got %v", want, tags)
+				}
+				return &domain.JournalEntry{ID: 1, Title: title, Content: content, Tags: tags}, nil
+			},
+		}
+
+		manager := NewJournalManager(mockStore)
+		_, err := manager.CreateEntry(ctx, "Test Title", "Test Content", time.Time{}, nil, []string{"Work-Trip", " #travel", "travel"})
+
+		if err != nil {
+			t.Fatalf("CreateEntry failed: %v", err)
+		}
+	})
+
+	t.Run("invalid tags", func(t *testing.T) {
+		manager := NewJournalManager(&mockJournalStore{})
+		for _, tags := range [][]string{
+			{""},
+			{"two words"},
+			{strings.Repeat("a", maxTagLength+1)},
+		} {
+			if _, err := manager.CreateEntry(ctx, "Test Title", "Test Content", time.Time{}, nil, tags); err == nil {
+				t.Errorf("Expected error for tags %q, got nil", tags)
+			}
+		}
+	})
+}
+
+func TestJournalManager_RenameTag(t *testing.T) {
+	ctx := context.Background()
+
+	mockStore := &mockJournalStore{
+		renameTagFunc: func(ctx context.Context, name, newName string) (*domain.Tag, error) {
+			if name != "work" || newName != "job" {
+				t.Errorf("Expected rename work -> job, got %s -> %s", name, newName)
+			}
+			return &domain.Tag{Name: newName, EntryCount: 2}, nil
+		},
+	}
+
+	manager := NewJournalManager(mockStore)
+	tag, err := manager.RenameTag(ctx, "#Work", "JOB")
+	if err != nil {
+		t.Fatalf("RenameTag failed: %v", err)
+	}
+	if tag.Name != "job" {
+		t.Errorf("Expected tag 'job', got '%s'", tag.Name)
+	}
+
+	if _, err := manager.RenameTag(ctx, "work", "not valid"); err == nil {
+		t.Error("Expected error for invalid new name, got nil")
+	}
+}
+
+func TestJournalManager_ListEntries_TagFilter(t *testing.T) {
+	ctx := context.Background()
+
+	mockStore := &mockJournalStore{
+		listFunc: func(ctx context.Context, filter domain.EntryFilter, limit, offset int) ([]*domain.JournalEntry, int64, error) {
+			if filter.Tag != "travel" {
+				t.Errorf("Expected tag filter 'travel', got '%s'", filter.Tag)
+			}
+			return nil, 0, nil
+		},
+	}
+
+	manager := NewJournalManager(mockStore)
+	if _, err := manager.ListEntries(ctx, 10, "", ListOptions{Tag: "Travel"}); err != nil {
+		t.Fatalf("ListEntries failed: %v", err)
+	}
+}
+
+func TestJournalManager_Revisions(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("list revisions", func(t *testing.T) {
+		mockStore := &mockJournalStore{
+			getByIDFunc: func(ctx context.Context, id int64) (*domain.JournalEntry, error) {
+				return &domain.JournalEntry{ID: id}, nil
+			},
+			listRevisionsFunc: func(ctx context.Context, entryID int64, limit, offset int) ([]*domain.Revision, int64, error) {
+				return []*domain.Revision{{ID: 2, EntryID: entryID}, {ID: 1, EntryID: entryID}}, 3, nil
+			},
+		}
+
+		manager := NewJournalManager(mockStore)
+		result, err := manager.ListRevisions(ctx, 1, 2, "")
+		if err != nil {
+			t.Fatalf("ListRevisions failed: %v", err)
+		}
+		if len(result.Revisions) != 2 || result.NextPageToken == "" {
+			t.Errorf("Expected 2 revisions and a next page, got %+v", result)
+		}
+	})
+
+	t.Run("search revisions", func(t *testing.T) {
+		mockStore := &mockJournalStore{
+			getByIDFunc: func(ctx context.Context, id int64) (*domain.JournalEntry, error) {
+				return &domain.JournalEntry{ID: id}, nil
+			},
+			searchRevisionsFunc: func(ctx context.Context, entryID int64, query string, limit, offset int) ([]*domain.Revision, int64, error) {
+				if query != `"lighthouse"` {
+					t.Errorf("Expected normalized query, got %s", query)
+				}
+				return []*domain.Revision{{ID: 4, EntryID: entryID}}, 1, nil
+			},
+		}
+
+		manager := NewJournalManager(mockStore)
+		result, err := manager.SearchRevisions(ctx, 1, "lighthouse", 10, "")
+		if err != nil {
+			t.Fatalf("SearchRevisions failed: %v", err)
+		}
+		if len(result.Revisions) != 1 || result.NextPageToken != "" {
+			t.Errorf("Expected 1 revision and no next page, got %+v", result)
+		}
+
+		if _, err := manager.SearchRevisions(ctx, 1, "  ", 10, ""); err == nil {
+			t.Error("Expected error for empty query, got nil")
+		}
+	})
+
+	t.Run("sealed entry", func(t *testing.T) {
+		mockStore := &mockJournalStore{
+			getByIDFunc: func(ctx context.Context, id int64) (*domain.JournalEntry, error) {
+				return &domain.JournalEntry{ID: id, RevealAt: time.Now().Add(time.Hour)}, nil
+			},
+		}
+
+		manager := NewJournalManager(mockStore)
+		if _, err := manager.ListRevisions(ctx, 1, 10, ""); err == nil {
+			t.Error("Expected error for sealed entry, got nil")
+		}
+		if _, err := manager.RestoreRevision(ctx, 1, 1); err == nil {
+			t.Error("Expected error for sealed entry, got nil")
+		}
+		if _, err := manager.SearchRevisions(ctx, 1, "secret", 10, ""); err == nil {
+			t.Error("Expected error for sealed entry, got nil")
+		}
+	})
+}
+
+func TestJournalManager_ExportEntries(t *testing.T) {
+	ctx := context.Background()
+	revealAt := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	mockStore := &mockJournalStore{
+		exportFunc: func(ctx context.Context, fn func(entry *domain.JournalEntry) error) error {
+			for _, entry := range []*domain.JournalEntry{
+				{ID: 1, Title: "Open", Content: "Open Content"},
+				{ID: 2, Title: "Dear Future Me", Content: "Sealed Content", RevealAt: revealAt},
+			} {
+				if err := fn(entry); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+
+	manager := NewJournalManager(mockStore)
+	manager.now = func() time.Time { return revealAt.Add(-time.Second) }
+
+	var exported []*domain.JournalEntry
+	err := manager.ExportEntries(ctx, func(entry *domain.JournalEntry) error {
+		exported = append(exported, entry)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("ExportEntries failed: %v", err)
+	}
+
+	if len(exported) != 2 {
+		t.Fatalf("Expected 2 entries, got %d", len(exported))
+	}
+	if exported[0].Content != "Open Content" {
+		t.Errorf("Expected open entry content, got '%s'", exported[0].Content)
+	}
+	if !exported[1].Sealed || exported[1].Content != "" {
+		t.Errorf("Expected sealed entry without content, got %+v", exported[1])
+	}
+}
+
+func TestJournalManager_ImportEntries(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	written := now.AddDate(-5, 0, 0)
+
+	var stored []*domain.JournalEntry
+	mockStore := &mockJournalStore{
+		importFunc: func(ctx context.Context, entries []*domain.JournalEntry) (int64, error) {
+			stored = entries
+			return int64(len(entries)), nil
+		},
+	}
+
+	manager := NewJournalManager(mockStore)
+	manager.now = func() time.Time { return now }
+
+	t.Run("normalizes entries", func(t *testing.T) {
+		imported, err := manager.ImportEntries(ctx, []*domain.JournalEntry{
+			{Content: "Went hiking. It rained.", CreatedAt: written, Tags: []string{"#Outdoors"}},
+		})
+		if err != nil {
+			t.Fatalf("ImportEntries failed: %v", err)
+		}
+		if imported != 1 {
+			t.Errorf("Expected 1 entry imported, got %d", imported)
+		}
+		if stored[0].Title != "Went hiking" {
+			t.Errorf("Expected suggested title 'Went hiking', got '%s'", stored[0].Title)
+		}
+		if !reflect.DeepEqual(stored[0].Tags, []string{"outdoors"}) {
+			t.Errorf("Expected normalized tags, got %v", stored[0].Tags)
+		}
+	})
+
+	t.Run("tolerates clock skew", func(t *testing.T) {
+		_, err := manager.ImportEntries(ctx, []*domain.JournalEntry{
+			{Title: "Title", Content: "Content", CreatedAt: now.Add(maxClockSkew / 2)},
+		})
+		if err != nil {
+			t.Errorf("Expected an entry slightly in the future to be accepted, got %v", err)
+		}
+	})
+
+	invalid := []struct {
+		name  string
+		entry *domain.JournalEntry
+	}{
+		{"empty content", &domain.JournalEntry{Title: "Title", CreatedAt: written}},
+		{"missing created_at", &domain.JournalEntry{Title: "Title", Content: "Content"}},
+		{"future created_at", &domain.JournalEntry{Title: "Title", Content: "Content", CreatedAt: now.Add(time.Hour)}},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			stored = nil
+			if _, err := manager.ImportEntries(ctx, []*domain.JournalEntry{tt.entry}); err == nil {
+				t.Error("Expected error, got nil")
+			}
+			if stored != nil {
+				t.Error("Expected nothing to be stored")
+			}
+		})
+	}
+}
+
+// mockBackupStore is a mockJournalStore that can also push backups.
+type mockBackupStore struct {
+	mockJournalStore
+	pushBackupFunc func(ctx context.Context) (string, error)
+}
+
+func (m *mockBackupStore) PushBackup(ctx context.Context) (string, error) {
+	return m.pushBackupFunc(ctx)
+}
+
+func TestJournalManager_PushBackup(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("pushes through the store", func(t *testing.T) {
+		manager := NewJournalManager(&mockBackupStore{
+			pushBackupFunc: func(ctx context.Context) (string, error) {
+				return "abc123", nil
+			},
+		})
+
+		version, err := manager.PushBackup(ctx)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if version != "abc123" {
+			t.Errorf("Expected version 'abc123', got '%s'", version)
+		}
+	})
+
+	t.Run("store without backups", func(t *testing.T) {
+		manager := NewJournalManager(&mockJournalStore{})
+
+		if _, err := manager.PushBackup(ctx); !errors.Is(err, domain.ErrFailedPrecondition) {
+			t.Errorf("Expected ErrFailedPrecondition, got %v", err)
+		}
+	})
+}
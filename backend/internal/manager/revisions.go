@@ -0,0 +1,98 @@
+package manager
+
+import (
+	"context"
+	"time"
+
+	"github.com/parkernilson/micro-journal/internal/domain"
+)
+
+// ListRevisionsResult contains the result of listing entry revisions.
+type ListRevisionsResult struct {
+	Revisions     []*domain.Revision
+	NextPageToken string
+	TotalCount    int64
+}
+
+// ListRevisions retrieves the previous versions of an entry, newest first,
+// with the same pagination as ListEntries.
+func (m *JournalManager) ListRevisions(ctx context.Context, entryID int64, pageSize int32, pageToken string) (*ListRevisionsResult, error) {
+	if err := m.checkRevisable(ctx, entryID); err != nil {
+		return nil, err
+	}
+
+	limit, offset, err := pageBounds(pageSize, pageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions, totalCount, err := m.store.ListRevisions(ctx, entryID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListRevisionsResult{
+		Revisions:     revisions,
+		NextPageToken: nextPageToken(offset, len(revisions), totalCount),
+		TotalCount:    totalCount,
+	}, nil
+}
+
+// SearchRevisions retrieves the previous versions of an entry matching a
+// free-text query, best match first, with the same query syntax as
+// SearchEntries and the same pagination as ListEntries.
+func (m *JournalManager) SearchRevisions(ctx context.Context, entryID int64, query string, pageSize int32, pageToken string) (*ListRevisionsResult, error) {
+	match, err := normalizeSearchQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.checkRevisable(ctx, entryID); err != nil {
+		return nil, err
+	}
+
+	limit, offset, err := pageBounds(pageSize, pageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions, totalCount, err := m.store.SearchRevisions(ctx, entryID, match, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListRevisionsResult{
+		Revisions:     revisions,
+		NextPageToken: nextPageToken(offset, len(revisions), totalCount),
+		TotalCount:    totalCount,
+	}, nil
+}
+
+// RestoreRevision rolls an entry back to one of its revisions. The version
+// it replaces becomes a new revision.
+func (m *JournalManager) RestoreRevision(ctx context.Context, entryID, revisionID int64) (*domain.JournalEntry, error) {
+	if err := m.checkRevisable(ctx, entryID); err != nil {
+		return nil, err
+	}
+
+	return m.store.RestoreRevision(ctx, entryID, revisionID)
+}
+
+// checkRevisable returns an error if the entry does not exist or is still
+// sealed, since its history would reveal its content.
+func (m *JournalManager) checkRevisable(ctx context.Context, entryID int64) error {
+	entry, err := m.store.GetByID(ctx, entryID)
+	if err != nil {
+		return err
+	}
+	if entry.IsSealedAt(m.now()) {
+		return preconditionf("journal entry is sealed until %s", entry.RevealAt.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// VerifyArchive checks the entry archive's hash chain and that archived
+// entries still match their newest archived version.
+func (m *JournalManager) VerifyArchive(ctx context.Context) (*domain.ArchiveReport, error) {
+	return m.store.VerifyArchive(ctx)
+}
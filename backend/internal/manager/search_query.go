@@ -0,0 +1,58 @@
+package manager
+
+import (
+	"strings"
+	"unicode"
+)
+
+const (
+	// maxSearchQueryLength is the maximum length of a search query in bytes.
+	maxSearchQueryLength = 256
+
+	// maxSearchTerms is the maximum number of terms in a search query.
+	maxSearchTerms = 16
+)
+
+// normalizeSearchQuery converts a user's search text into an FTS5 MATCH
+// expression. Every word must match; a trailing '*' on a word matches it as
+// a prefix. FTS5 operators and punctuation in the input are not interpreted,
+// so any text is a valid query.
+func normalizeSearchQuery(query string) (string, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return "", invalidf("search query cannot be empty")
+	}
+	if len(query) > maxSearchQueryLength {
+		return "", invalidf("search query cannot be longer than %d characters", maxSearchQueryLength)
+	}
+
+	var terms []string
+	for _, word := range strings.Fields(query) {
+		prefix := strings.HasSuffix(word, "*")
+		start := len(terms)
+
+		// Keep only characters the tokenizer indexes
+		word = strings.Map(func(r rune) rune {
+			if unicode.IsLetter(r) || unicode.IsNumber(r) {
+				return r
+			}
+			return ' '
+		}, word)
+
+		for _, token := range strings.Fields(word) {
+			terms = append(terms, `"`+token+`"`)
+		}
+		if prefix && len(terms) > start {
+			terms[len(terms)-1] += "*"
+		}
+	}
+
+	if len(terms) == 0 {
+		return "", invalidf("search query must contain a letter or number")
+	}
+	if len(terms) > maxSearchTerms {
+		return "", invalidf("search query cannot have more than %d terms", maxSearchTerms)
+	}
+
+	return strings.Join(terms, " "), nil
+}
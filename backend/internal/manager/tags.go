@@ -0,0 +1,90 @@
+package manager
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/parkernilson/micro-journal/internal/domain"
+)
+
+const (
+	// maxTagLength is the maximum length of a tag name in characters.
+	maxTagLength = 32
+
+	// maxEntryTags is the maximum number of tags on a single entry.
+	maxEntryTags = 20
+)
+
+// ListTags retrieves every tag with the number of entries that use it.
+func (m *JournalManager) ListTags(ctx context.Context) ([]*domain.Tag, error) {
+	return m.store.ListTags(ctx)
+}
+
+// RenameTag renames a tag on every entry. Renaming to an existing tag merges
+// the two.
+func (m *JournalManager) RenameTag(ctx context.Context, name, newName string) (*domain.Tag, error) {
+	name, err := normalizeTag(name)
+	if err != nil {
+		return nil, err
+	}
+	newName, err = normalizeTag(newName)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.store.RenameTag(ctx, name, newName)
+}
+
+// DeleteTag removes a tag from every entry.
+func (m *JournalManager) DeleteTag(ctx context.Context, name string) error {
+	name, err := normalizeTag(name)
+	if err != nil {
+		return err
+	}
+
+	return m.store.DeleteTag(ctx, name)
+}
+
+// NormalizeTags normalizes each tag and returns them sorted and deduplicated.
+// Clients can use it to reject invalid tags before sending them.
+func NormalizeTags(tags []string) ([]string, error) {
+	seen := make(map[string]bool, len(tags))
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag, err := normalizeTag(tag)
+		if err != nil {
+			return nil, err
+		}
+		if !seen[tag] {
+			seen[tag] = true
+			normalized = append(normalized, tag)
+		}
+	}
+
+	if len(normalized) > maxEntryTags {
+		return nil, invalidf("an entry cannot have more than %d tags", maxEntryTags)
+	}
+
+	sort.Strings(normalized)
+	return normalized, nil
+}
+
+// normalizeTag lowercases a tag and strips surrounding whitespace and a
+// leading '#'. Tags may contain letters, numbers, '-', and '_'.
+func normalizeTag(tag string) (string, error) {
+	tag = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(tag), "#"))
+	if tag == "" {
+		return "", invalidf("tag cannot be empty")
+	}
+	if len([]rune(tag)) > maxTagLength {
+		return "", invalidf("tag cannot be longer than %d characters", maxTagLength)
+	}
+	for _, r := range tag {
+		if !unicode.IsLetter(r) && !unicode.IsNumber(r) && r != '-' && r != '_' {
+			return "", invalidf("tag %q can only contain letters, numbers, '-', and '_'", tag)
+		}
+	}
+	return tag, nil
+}
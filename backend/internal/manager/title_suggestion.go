@@ -0,0 +1,69 @@
+package manager
+
+import (
+	"strings"
+	"unicode"
+)
+
+// maxSuggestedTitleLength is the maximum number of characters in a suggested title.
+const maxSuggestedTitleLength = 60
+
+// suggestTitle derives a title from the first sentence of content.
+// Leading Markdown heading markers and punctuation are removed and long
+// sentences are shortened at a word boundary. If that leaves nothing, the
+// first words of content are used, so the title is only empty if content
+// is.
+func suggestTitle(content string) string {
+	// Use the first non-empty line
+	var line string
+	for _, l := range strings.Split(content, "\n") {
+		l = strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(l), "#"))
+		if l != "" {
+			line = l
+			break
+		}
+	}
+
+	// Cut at the end of the first sentence, skipping punctuation it starts
+	// with such as an ellipsis
+	line = strings.TrimLeftFunc(line, unicode.IsPunct)
+	if i := sentenceEnd(line); i >= 0 {
+		line = line[:i]
+	}
+	title := strings.Join(strings.Fields(line), " ")
+	if title == "" {
+		title = strings.Join(strings.Fields(content), " ")
+	}
+
+	runes := []rune(title)
+	if len(runes) <= maxSuggestedTitleLength {
+		return title
+	}
+
+	// Shorten at the last word boundary that fits
+	cut := string(runes[:maxSuggestedTitleLength])
+	if i := strings.LastIndexFunc(cut, unicode.IsSpace); i > 0 {
+		cut = cut[:i]
+	}
+	return strings.TrimRightFunc(cut, unicode.IsPunct) + "..."
+}
+
+// sentenceEnd returns the index of the first run of '.', '!', or '?' in line
+// that is followed by whitespace or ends the line, or -1 if there is none.
+// Punctuation within a word, as in "v1.2", does not end a sentence.
+func sentenceEnd(line string) int {
+	start := -1
+	for i, r := range line {
+		switch {
+		case strings.ContainsRune(".!?", r):
+			if start < 0 {
+				start = i
+			}
+		case unicode.IsSpace(r) && start >= 0:
+			return start
+		default:
+			start = -1
+		}
+	}
+	return start
+}
@@ -0,0 +1,49 @@
+package manager
+
+import (
+	"context"
+	"time"
+
+	"github.com/parkernilson/micro-journal/internal/domain"
+)
+
+// ListTrash retrieves entries in the trash, most recently deleted first, with
+// the same pagination as ListEntries.
+func (m *JournalManager) ListTrash(ctx context.Context, pageSize int32, pageToken string) (*ListEntriesResult, error) {
+	limit, offset, err := pageBounds(pageSize, pageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, totalCount, err := m.store.ListTrash(ctx, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		m.seal(entry)
+	}
+
+	return &ListEntriesResult{
+		Entries:       entries,
+		NextPageToken: nextPageToken(offset, len(entries), totalCount),
+		TotalCount:    totalCount,
+	}, nil
+}
+
+// RestoreEntry moves a journal entry out of the trash.
+func (m *JournalManager) RestoreEntry(ctx context.Context, id int64) (*domain.JournalEntry, error) {
+	entry, err := m.store.Restore(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.seal(entry), nil
+}
+
+// PurgeTrash permanently removes entries moved to the trash before
+// deletedBefore, or the whole trash if deletedBefore is zero.
+// Returns the number of entries removed.
+func (m *JournalManager) PurgeTrash(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	return m.store.PurgeTrash(ctx, deletedBefore)
+}
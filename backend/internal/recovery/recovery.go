@@ -0,0 +1,53 @@
+// Package recovery turns panics in gRPC handlers into Internal errors, so a
+// bug in one request does not crash the server.
+package recovery
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errPanic is returned to the client in place of a panic. It deliberately
+// says nothing about the panic, which may include internal details.
+var errPanic = status.Error(codes.Internal, "internal server error")
+
+// UnaryServerInterceptor recovers from a panic in the handler, logs it with
+// its stack trace, and returns an Internal error instead. It should come
+// after the logging interceptor so the log record carries the request ID.
+func UnaryServerInterceptor(logger *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		defer func() {
+			if p := recover(); p != nil {
+				resp, err = nil, Recovered(ctx, logger, info.FullMethod, p)
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor is UnaryServerInterceptor for streaming RPCs.
+func StreamServerInterceptor(logger *slog.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if p := recover(); p != nil {
+				err = Recovered(ss.Context(), logger, info.FullMethod, p)
+			}
+		}()
+		return handler(srv, ss)
+	}
+}
+
+// Recovered logs a panic value p recovered from the handler of method with
+// the stack of the goroutine that panicked, and returns the Internal error
+// to send the client in its place. Servers other than gRPC use it to handle
+// panics the same way.
+func Recovered(ctx context.Context, logger *slog.Logger, method string, p any) error {
+	logger.ErrorContext(ctx, "recovered from panic", "method", method, "panic", fmt.Sprint(p), "stack", string(debug.Stack()))
+	return errPanic
+}
@@ -0,0 +1,97 @@
+package recovery
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// mockServerStream is a grpc.ServerStream with only a context.
+type mockServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *mockServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// decodeRecord decodes the single JSON log record written to buf.
+func decodeRecord(t *testing.T, buf *bytes.Buffer) map[string]any {
+	t.Helper()
+	var record map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &record); err != nil {
+		t.Fatalf("Expected one JSON log record, got %q: %v", buf.String(), err)
+	}
+	return record
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/journal.v1.JournalService/GetJournalEntry"}
+
+	t.Run("panic", func(t *testing.T) {
+		var buf bytes.Buffer
+		interceptor := UnaryServerInterceptor(slog.New(slog.NewJSONHandler(&buf, nil)))
+
+		resp, err := interceptor(context.Background(), nil, info, func(ctx context.Context, req any) (any, error) {
+			var entry *struct{ Title string }
+			return entry.Title, nil
+		})
+		if resp != nil || status.Code(err) != codes.Internal {
+			t.Fatalf("Expected Internal and no response, got %v, %v", resp, err)
+		}
+		if strings.Contains(status.Convert(err).Message(), "nil pointer") {
+			t.Errorf("Expected the panic to stay out of the response, got %q", status.Convert(err).Message())
+		}
+
+		record := decodeRecord(t, &buf)
+		if record["level"] != "ERROR" || record["method"] != info.FullMethod {
+			t.Errorf("Unexpected log record %v", record)
+		}
+		if msg, _ := record["panic"].(string); !strings.Contains(msg, "nil pointer") {
+			t.Errorf("Expected the panic in the log, got %v", record["panic"])
+		}
+		if stack, _ := record["stack"].(string); !strings.Contains(stack, "recovery_test.go") {
+			t.Errorf("Expected the stack of the panicking handler, got %v", record["stack"])
+		}
+	})
+
+	t.Run("no panic", func(t *testing.T) {
+		var buf bytes.Buffer
+		interceptor := UnaryServerInterceptor(slog.New(slog.NewJSONHandler(&buf, nil)))
+
+		want := status.Error(codes.NotFound, "no such entry")
+		resp, err := interceptor(context.Background(), nil, info, func(ctx context.Context, req any) (any, error) {
+			return "entry", want
+		})
+		if resp != "entry" || err != want {
+			t.Errorf("Expected the handler's result, got %v, %v", resp, err)
+		}
+		if buf.Len() != 0 {
+			t.Errorf("Expected nothing logged, got %q", buf.String())
+		}
+	})
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	var buf bytes.Buffer
+	interceptor := StreamServerInterceptor(slog.New(slog.NewJSONHandler(&buf, nil)))
+	info := &grpc.StreamServerInfo{FullMethod: "/journal.v1.JournalService/ExportJournal"}
+
+	err := interceptor(nil, &mockServerStream{ctx: context.Background()}, info, func(srv any, stream grpc.ServerStream) error {
+		panic("encoder broke")
+	})
+	if status.Code(err) != codes.Internal {
+		t.Fatalf("Expected Internal, got %v", err)
+	}
+	if record := decodeRecord(t, &buf); record["panic"] != "encoder broke" {
+		t.Errorf("Unexpected log record %v", record)
+	}
+}
@@ -0,0 +1,274 @@
+package rest
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	pb "github.com/parkernilson/micro-journal/gen/journal/v1"
+	"github.com/parkernilson/micro-journal/internal/loadshed"
+	"github.com/parkernilson/micro-journal/internal/logging"
+	"github.com/parkernilson/micro-journal/internal/recovery"
+)
+
+// maxBodyBytes is the largest request body accepted.
+const maxBodyBytes = 1 << 20
+
+// Handler serves a REST/JSON API by transcoding requests to the
+// JournalService in-process. Bodies and responses use the protobuf JSON
+// mapping, so field names match the gRPC API in lowerCamelCase, and errors
+// are google.rpc.Status objects with the matching HTTP status code.
+//
+// Like the gRPC server, the handler logs every request with a request ID,
+// turns panics into Internal errors, and sheds requests when the database
+// is under pressure. Requests are logged and prioritized under the name of
+// the gRPC method they call.
+type Handler struct {
+	service pb.JournalServiceServer
+	logger  *slog.Logger
+	shedder *loadshed.Shedder
+	mux     *http.ServeMux
+}
+
+// call handles a REST request by calling the service, returning the
+// response to write or a gRPC status error.
+type call func(w http.ResponseWriter, r *http.Request) (proto.Message, error)
+
+// NewHandler creates a new instance of Handler.
+func NewHandler(service pb.JournalServiceServer, logger *slog.Logger, shedder *loadshed.Shedder) *Handler {
+	h := &Handler{service: service, logger: logger, shedder: shedder, mux: http.NewServeMux()}
+
+	h.handle("POST /v1/entries", pb.JournalService_CreateJournalEntry_FullMethodName, h.createEntry)
+	h.handle("GET /v1/entries", pb.JournalService_ListJournalEntries_FullMethodName, h.listEntries)
+	h.handle("GET /v1/entries:search", pb.JournalService_SearchJournalEntries_FullMethodName, h.searchEntries)
+	h.handle("GET /v1/entries/{id}", pb.JournalService_GetJournalEntry_FullMethodName, h.getEntry)
+	h.handle("PUT /v1/entries/{id}", pb.JournalService_UpdateJournalEntry_FullMethodName, h.updateEntry)
+	h.handle("DELETE /v1/entries/{id}", pb.JournalService_DeleteJournalEntry_FullMethodName, h.deleteEntry)
+	h.handle("GET /v1/tags", pb.JournalService_ListTags_FullMethodName, h.listTags)
+
+	return h
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+// handle registers c for pattern. Each request gets a request ID, returned
+// in the response headers, and is logged under fullMethod once written.
+func (h *Handler) handle(pattern, fullMethod string, c call) {
+	h.mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		ctx, id := logging.StartRequest(r.Context())
+		r = r.WithContext(ctx)
+		w.Header().Set(logging.RequestIDHeader, id)
+
+		start := time.Now()
+		resp, err := h.serve(w, r, fullMethod, c)
+		logging.LogRequest(ctx, h.logger, fullMethod, time.Since(start), err)
+		write(ctx, w, resp, err)
+	})
+}
+
+// serve runs c if the shedder admits the request, turning a panic in it
+// into an Internal error.
+func (h *Handler) serve(w http.ResponseWriter, r *http.Request, fullMethod string, c call) (resp proto.Message, err error) {
+	release, err := h.shedder.Admit(fullMethod)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	defer func() {
+		if p := recover(); p != nil {
+			// net/http uses this panic to abort a response on purpose
+			if p == http.ErrAbortHandler {
+				panic(p)
+			}
+			resp, err = nil, recovery.Recovered(r.Context(), h.logger, fullMethod, p)
+		}
+	}()
+	return c(w, r)
+}
+
+func (h *Handler) createEntry(w http.ResponseWriter, r *http.Request) (proto.Message, error) {
+	req := &pb.CreateJournalEntryRequest{}
+	if err := decodeBody(w, r, req); err != nil {
+		return nil, err
+	}
+	return h.service.CreateJournalEntry(r.Context(), req)
+}
+
+func (h *Handler) listEntries(w http.ResponseWriter, r *http.Request) (proto.Message, error) {
+	req := &pb.ListJournalEntriesRequest{}
+	if err := decodeQuery(r.URL.Query(), req); err != nil {
+		return nil, err
+	}
+	return h.service.ListJournalEntries(r.Context(), req)
+}
+
+func (h *Handler) searchEntries(w http.ResponseWriter, r *http.Request) (proto.Message, error) {
+	req := &pb.SearchJournalEntriesRequest{}
+	if err := decodeQuery(r.URL.Query(), req); err != nil {
+		return nil, err
+	}
+	return h.service.SearchJournalEntries(r.Context(), req)
+}
+
+func (h *Handler) getEntry(w http.ResponseWriter, r *http.Request) (proto.Message, error) {
+	req := &pb.GetJournalEntryRequest{Id: r.PathValue("id")}
+	return h.service.GetJournalEntry(r.Context(), req)
+}
+
+func (h *Handler) updateEntry(w http.ResponseWriter, r *http.Request) (proto.Message, error) {
+	req := &pb.UpdateJournalEntryRequest{}
+	if err := decodeBody(w, r, req); err != nil {
+		return nil, err
+	}
+	req.Id = r.PathValue("id")
+	return h.service.UpdateJournalEntry(r.Context(), req)
+}
+
+func (h *Handler) deleteEntry(w http.ResponseWriter, r *http.Request) (proto.Message, error) {
+	req := &pb.DeleteJournalEntryRequest{}
+	if err := decodeQuery(r.URL.Query(), req); err != nil {
+		return nil, err
+	}
+	req.Id = r.PathValue("id")
+	return h.service.DeleteJournalEntry(r.Context(), req)
+}
+
+func (h *Handler) listTags(w http.ResponseWriter, r *http.Request) (proto.Message, error) {
+	return h.service.ListTags(r.Context(), &pb.ListTagsRequest{})
+}
+
+// decodeBody reads a JSON request body into msg. Unknown fields are
+// rejected so typos in field names do not go unnoticed.
+func decodeBody(w http.ResponseWriter, r *http.Request, msg proto.Message) error {
+	data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodyBytes))
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "failed to read request body: %v", err)
+	}
+	if err := protojson.Unmarshal(data, msg); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid request body: %v", err)
+	}
+	return nil
+}
+
+// decodeQuery sets scalar fields of msg from query parameters named after
+// either the proto field name or its JSON name.
+func decodeQuery(values url.Values, msg proto.Message) error {
+	m := msg.ProtoReflect()
+	fields := m.Descriptor().Fields()
+
+	for key, vals := range values {
+		field := fields.ByName(protoreflect.Name(key))
+		if field == nil {
+			field = fields.ByJSONName(key)
+		}
+		if field == nil || field.IsList() || field.IsMap() {
+			return status.Errorf(codes.InvalidArgument, "unknown query parameter %q", key)
+		}
+
+		value, err := parseScalar(field, vals[len(vals)-1])
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid query parameter %q: %v", key, err)
+		}
+		m.Set(field, value)
+	}
+
+	return nil
+}
+
+// parseScalar parses a query parameter value for field.
+func parseScalar(field protoreflect.FieldDescriptor, raw string) (protoreflect.Value, error) {
+	switch field.Kind() {
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(raw), nil
+	case protoreflect.BoolKind:
+		v, err := strconv.ParseBool(raw)
+		return protoreflect.ValueOfBool(v), err
+	case protoreflect.Int32Kind:
+		v, err := strconv.ParseInt(raw, 10, 32)
+		return protoreflect.ValueOfInt32(int32(v)), err
+	case protoreflect.Int64Kind:
+		v, err := strconv.ParseInt(raw, 10, 64)
+		return protoreflect.ValueOfInt64(v), err
+	default:
+		return protoreflect.Value{}, fmt.Errorf("unsupported field type %s", field.Kind())
+	}
+}
+
+// write writes resp as JSON, or err if the call failed.
+func write(ctx context.Context, w http.ResponseWriter, resp proto.Message, err error) {
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	data, err := protojson.Marshal(resp)
+	if err != nil {
+		writeError(ctx, w, status.Errorf(codes.Internal, "failed to encode response: %v", err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(data)
+}
+
+// writeError writes err as a google.rpc.Status with the HTTP status code
+// matching its gRPC code.
+func writeError(ctx context.Context, w http.ResponseWriter, err error) {
+	st := status.Convert(err)
+	data, marshalErr := protojson.Marshal(st.Proto())
+	if marshalErr != nil {
+		slog.ErrorContext(ctx, "failed to encode REST error", "code", st.Code().String(), "error", marshalErr)
+		http.Error(w, st.Message(), httpStatus(st.Code()))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus(st.Code()))
+	w.Write(data)
+}
+
+// httpStatus maps a gRPC code to an HTTP status code, following the mapping
+// in google/rpc/code.proto.
+func httpStatus(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return 499
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,191 @@
+package rest
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/parkernilson/micro-journal/gen/journal/v1"
+	"github.com/parkernilson/micro-journal/internal/loadshed"
+	"github.com/parkernilson/micro-journal/internal/logging"
+)
+
+// newTestHandler creates a Handler for service with a shedder using opts
+// and a logger that discards its output.
+func newTestHandler(service pb.JournalServiceServer, opts loadshed.Options) *Handler {
+	shedder := loadshed.NewShedder(func() sql.DBStats { return sql.DBStats{} }, opts)
+	return NewHandler(service, slog.New(slog.NewTextHandler(io.Discard, nil)), shedder)
+}
+
+// mockJournalService is a mock implementation of pb.JournalServiceServer.
+type mockJournalService struct {
+	pb.UnimplementedJournalServiceServer
+	createFunc func(ctx context.Context, req *pb.CreateJournalEntryRequest) (*pb.CreateJournalEntryResponse, error)
+	getFunc    func(ctx context.Context, req *pb.GetJournalEntryRequest) (*pb.GetJournalEntryResponse, error)
+	listFunc   func(ctx context.Context, req *pb.ListJournalEntriesRequest) (*pb.ListJournalEntriesResponse, error)
+	deleteFunc func(ctx context.Context, req *pb.DeleteJournalEntryRequest) (*pb.DeleteJournalEntryResponse, error)
+}
+
+func (m *mockJournalService) CreateJournalEntry(ctx context.Context, req *pb.CreateJournalEntryRequest) (*pb.CreateJournalEntryResponse, error) {
+	return m.createFunc(ctx, req)
+}
+
+func (m *mockJournalService) GetJournalEntry(ctx context.Context, req *pb.GetJournalEntryRequest) (*pb.GetJournalEntryResponse, error) {
+	return m.getFunc(ctx, req)
+}
+
+func (m *mockJournalService) ListJournalEntries(ctx context.Context, req *pb.ListJournalEntriesRequest) (*pb.ListJournalEntriesResponse, error) {
+	return m.listFunc(ctx, req)
+}
+
+func (m *mockJournalService) DeleteJournalEntry(ctx context.Context, req *pb.DeleteJournalEntryRequest) (*pb.DeleteJournalEntryResponse, error) {
+	return m.deleteFunc(ctx, req)
+}
+
+func TestHandler(t *testing.T) {
+	service := &mockJournalService{
+		createFunc: func(ctx context.Context, req *pb.CreateJournalEntryRequest) (*pb.CreateJournalEntryResponse, error) {
+			return &pb.CreateJournalEntryResponse{Entry: &pb.JournalEntry{Id: "1", Title: req.Title, Tags: req.Tags}}, nil
+		},
+		getFunc: func(ctx context.Context, req *pb.GetJournalEntryRequest) (*pb.GetJournalEntryResponse, error) {
+			if req.Id != "1" {
+				return nil, status.Errorf(codes.NotFound, "journal entry not found: %s", req.Id)
+			}
+			return &pb.GetJournalEntryResponse{Entry: &pb.JournalEntry{Id: "1", Title: "Hello"}}, nil
+		},
+		listFunc: func(ctx context.Context, req *pb.ListJournalEntriesRequest) (*pb.ListJournalEntriesResponse, error) {
+			if req.PageSize != 5 || req.Tag != "work" {
+				t.Errorf("Expected page_size 5 and tag 'work', got %v", req)
+			}
+			return &pb.ListJournalEntriesResponse{TotalCount: 0}, nil
+		},
+		deleteFunc: func(ctx context.Context, req *pb.DeleteJournalEntryRequest) (*pb.DeleteJournalEntryResponse, error) {
+			if req.Id != "1" || !req.Permanent {
+				t.Errorf("Expected permanent delete of entry 1, got %v", req)
+			}
+			return &pb.DeleteJournalEntryResponse{Success: true}, nil
+		},
+	}
+	handler := newTestHandler(service, loadshed.DefaultOptions())
+
+	serve := func(method, target, body string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(method, target, strings.NewReader(body)))
+		return rec
+	}
+
+	t.Run("create entry", func(t *testing.T) {
+		rec := serve(http.MethodPost, "/v1/entries", `{"title": "Hello", "content": "World", "tags": ["a"]}`)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("Expected 200, got %d: %s", rec.Code, rec.Body)
+		}
+
+		var resp struct {
+			Entry struct {
+				ID    string   `json:"id"`
+				Title string   `json:"title"`
+				Tags  []string `json:"tags"`
+			} `json:"entry"`
+		}
+		if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("invalid response JSON: %v", err)
+		}
+		if resp.Entry.ID != "1" || resp.Entry.Title != "Hello" || len(resp.Entry.Tags) != 1 {
+			t.Errorf("Unexpected entry %+v", resp.Entry)
+		}
+	})
+
+	t.Run("invalid body", func(t *testing.T) {
+		rec := serve(http.MethodPost, "/v1/entries", `{"titel": "Hello"}`)
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("Expected 400, got %d", rec.Code)
+		}
+	})
+
+	t.Run("get entry", func(t *testing.T) {
+		if rec := serve(http.MethodGet, "/v1/entries/1", ""); rec.Code != http.StatusOK {
+			t.Errorf("Expected 200, got %d", rec.Code)
+		}
+
+		rec := serve(http.MethodGet, "/v1/entries/2", "")
+		if rec.Code != http.StatusNotFound {
+			t.Errorf("Expected 404, got %d", rec.Code)
+		}
+		var st struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+		}
+		if err := json.Unmarshal(rec.Body.Bytes(), &st); err != nil {
+			t.Fatalf("invalid error JSON: %v", err)
+		}
+		if st.Code != int(codes.NotFound) || st.Message == "" {
+			t.Errorf("Expected NotFound status, got %+v", st)
+		}
+	})
+
+	t.Run("list entries with query parameters", func(t *testing.T) {
+		if rec := serve(http.MethodGet, "/v1/entries?pageSize=5&tag=work", ""); rec.Code != http.StatusOK {
+			t.Errorf("Expected 200, got %d: %s", rec.Code, rec.Body)
+		}
+		if rec := serve(http.MethodGet, "/v1/entries?page_size=many", ""); rec.Code != http.StatusBadRequest {
+			t.Errorf("Expected 400 for invalid page_size, got %d", rec.Code)
+		}
+		if rec := serve(http.MethodGet, "/v1/entries?sort=asc", ""); rec.Code != http.StatusBadRequest {
+			t.Errorf("Expected 400 for unknown parameter, got %d", rec.Code)
+		}
+	})
+
+	t.Run("delete entry", func(t *testing.T) {
+		if rec := serve(http.MethodDelete, "/v1/entries/1?permanent=true", ""); rec.Code != http.StatusOK {
+			t.Errorf("Expected 200, got %d", rec.Code)
+		}
+	})
+
+	t.Run("unimplemented method", func(t *testing.T) {
+		if rec := serve(http.MethodGet, "/v1/tags", ""); rec.Code != http.StatusNotImplemented {
+			t.Errorf("Expected 501, got %d", rec.Code)
+		}
+	})
+}
+
+func TestHandler_Interceptors(t *testing.T) {
+	// getFunc is unset, so getting an entry panics
+	service := &mockJournalService{
+		listFunc: func(ctx context.Context, req *pb.ListJournalEntriesRequest) (*pb.ListJournalEntriesResponse, error) {
+			return &pb.ListJournalEntriesResponse{}, nil
+		},
+	}
+
+	t.Run("returns a request ID", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		newTestHandler(service, loadshed.DefaultOptions()).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/entries", nil))
+		if rec.Code != http.StatusOK || rec.Header().Get(logging.RequestIDHeader) == "" {
+			t.Errorf("Expected 200 with a request ID header, got %d %v", rec.Code, rec.Header())
+		}
+	})
+
+	t.Run("recovers from panics", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		newTestHandler(service, loadshed.DefaultOptions()).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/entries/1", nil))
+		if rec.Code != http.StatusInternalServerError || !strings.Contains(rec.Body.String(), `"internal server error"`) {
+			t.Errorf("Expected a safe 500, got %d: %s", rec.Code, rec.Body)
+		}
+	})
+
+	t.Run("sheds requests under pressure", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		newTestHandler(service, loadshed.Options{MaxInFlight: 0}).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/entries", nil))
+		if rec.Code != http.StatusServiceUnavailable {
+			t.Errorf("Expected 503, got %d: %s", rec.Code, rec.Body)
+		}
+	})
+}
@@ -0,0 +1,195 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/parkernilson/micro-journal/gen/journal/v1"
+	"github.com/parkernilson/micro-journal/gen/journal/v1/journalv1connect"
+	"github.com/parkernilson/micro-journal/internal/loadshed"
+	"github.com/parkernilson/micro-journal/internal/logging"
+	"github.com/parkernilson/micro-journal/internal/recovery"
+)
+
+// connectJournalService adapts JournalService to the Connect handler
+// interface, which differs from the gRPC one only for streaming methods.
+type connectJournalService struct {
+	*JournalService
+}
+
+// ExportJournal streams every entry in the requested format
+func (s connectJournalService) ExportJournal(ctx context.Context, req *pb.ExportJournalRequest, stream *connect.ServerStream[pb.ExportJournalResponse]) error {
+	return connectError(s.exportJournal(ctx, req, stream.Send))
+}
+
+// ImportJournal inserts batches of entries from another journal
+func (s connectJournalService) ImportJournal(ctx context.Context, stream *connect.ClientStream[pb.ImportJournalRequest]) (*pb.ImportJournalResponse, error) {
+	resp, err := s.importJournal(ctx, receive(stream))
+	return resp, connectError(err)
+}
+
+// ImportDayOne imports the entries of a Day One JSON export
+func (s connectJournalService) ImportDayOne(ctx context.Context, stream *connect.ClientStream[pb.ImportDayOneRequest]) (*pb.ImportDayOneResponse, error) {
+	resp, err := s.importDayOne(ctx, receive(stream))
+	return resp, connectError(err)
+}
+
+// receive adapts a Connect client stream to a gRPC-style Recv function that
+// returns io.EOF at the end of the stream.
+func receive[T any](stream *connect.ClientStream[T]) func() (*T, error) {
+	return func() (*T, error) {
+		if !stream.Receive() {
+			if err := stream.Err(); err != nil {
+				return nil, err
+			}
+			return nil, io.EOF
+		}
+		return stream.Msg(), nil
+	}
+}
+
+// NewConnectHandler creates an HTTP handler serving s over the Connect,
+// gRPC, and gRPC-Web protocols, so browser and mobile clients can call it
+// over plain HTTP/1.1. Returns the path prefix to mount the handler on.
+//
+// Like the gRPC server, the handler logs every request with a request ID to
+// logger, turns panics into Internal errors, and sheds requests with
+// shedder when the database is under pressure.
+func NewConnectHandler(s *JournalService, logger *slog.Logger, shedder *loadshed.Shedder) (string, http.Handler) {
+	return journalv1connect.NewJournalServiceHandler(connectJournalService{s},
+		connect.WithInterceptors(serverInterceptor{logger: logger, shedder: shedder}),
+		connect.WithRecover(func(ctx context.Context, spec connect.Spec, _ http.Header, p any) error {
+			return connectError(recovery.Recovered(ctx, logger, spec.Procedure, p))
+		}),
+		connect.WithInterceptors(statusInterceptor()),
+	)
+}
+
+// serverInterceptor gives Connect requests what the gRPC server's logging
+// and load shedding interceptors give gRPC requests. Procedure names match
+// gRPC method names, so requests are logged and prioritized the same way.
+type serverInterceptor struct {
+	logger  *slog.Logger
+	shedder *loadshed.Shedder
+}
+
+func (i serverInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		procedure := req.Spec().Procedure
+		ctx, id := logging.StartRequest(ctx)
+
+		start := time.Now()
+		var resp connect.AnyResponse
+		err := i.admit(procedure, func() error {
+			var err error
+			resp, err = next(ctx, req)
+			return err
+		})
+		err = connectError(err)
+		logging.LogRequest(ctx, i.logger, procedure, time.Since(start), statusError(err))
+
+		// The request ID is returned in the response headers, or in the
+		// error's metadata if the request failed
+		var connectErr *connect.Error
+		if errors.As(err, &connectErr) {
+			connectErr.Meta().Set(logging.RequestIDHeader, id)
+		} else if resp != nil {
+			resp.Header().Set(logging.RequestIDHeader, id)
+		}
+		return resp, err
+	}
+}
+
+func (i serverInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i serverInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		procedure := conn.Spec().Procedure
+		ctx, id := logging.StartRequest(ctx)
+		conn.ResponseHeader().Set(logging.RequestIDHeader, id)
+
+		start := time.Now()
+		err := connectError(i.admit(procedure, func() error {
+			return next(ctx, conn)
+		}))
+		logging.LogRequest(ctx, i.logger, procedure, time.Since(start), statusError(err))
+		return err
+	}
+}
+
+// admit runs call if the shedder admits a request to procedure, and returns
+// the shedder's rejection otherwise.
+func (i serverInterceptor) admit(procedure string, call func() error) error {
+	release, err := i.shedder.Admit(procedure)
+	if err != nil {
+		return err
+	}
+	defer release()
+	return call()
+}
+
+// statusInterceptor converts the gRPC status errors returned by unary
+// JournalService methods into Connect errors.
+func statusInterceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			resp, err := next(ctx, req)
+			if err != nil {
+				return nil, connectError(err)
+			}
+			return resp, nil
+		}
+	}
+}
+
+// connectError converts a gRPC status error into a Connect error with the
+// same code and details, which Connect would otherwise report as Unknown.
+func connectError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		return err
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	connectErr = connect.NewError(connect.Code(st.Code()), errors.New(st.Message()))
+	for _, detail := range st.Details() {
+		if msg, ok := detail.(proto.Message); ok {
+			if errDetail, err := connect.NewErrorDetail(msg); err == nil {
+				connectErr.AddDetail(errDetail)
+			}
+		}
+	}
+	return connectErr
+}
+
+// statusError converts a Connect error into a gRPC status error with the
+// same code, which the logging package expects.
+func statusError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		return status.Error(codes.Code(connectErr.Code()), connectErr.Message())
+	}
+	return status.Error(codes.Unknown, err.Error())
+}
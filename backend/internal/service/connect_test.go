@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	pb "github.com/parkernilson/micro-journal/gen/journal/v1"
+	"github.com/parkernilson/micro-journal/gen/journal/v1/journalv1connect"
+	"github.com/parkernilson/micro-journal/internal/domain"
+	"github.com/parkernilson/micro-journal/internal/loadshed"
+	"github.com/parkernilson/micro-journal/internal/logging"
+)
+
+// newConnectTestServer serves s over Connect with a shedder using opts and
+// returns a client for it along with the server's URL.
+func newConnectTestServer(t *testing.T, s *JournalService, opts loadshed.Options) (journalv1connect.JournalServiceClient, string) {
+	t.Helper()
+	shedder := loadshed.NewShedder(func() sql.DBStats { return sql.DBStats{} }, opts)
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	mux := http.NewServeMux()
+	mux.Handle(NewConnectHandler(s, logger, shedder))
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return journalv1connect.NewJournalServiceClient(server.Client(), server.URL), server.URL
+}
+
+func TestConnectHandler(t *testing.T) {
+	ctx := context.Background()
+
+	mockManager := &mockJournalManager{
+		getEntryFunc: func(ctx context.Context, id int64) (*domain.JournalEntry, error) {
+			if id != 1 {
+				return nil, fmt.Errorf("journal entry %d: %w", id, domain.ErrNotFound)
+			}
+			return &domain.JournalEntry{ID: 1, Title: "Hello"}, nil
+		},
+		exportEntriesFunc: func(ctx context.Context, fn func(entry *domain.JournalEntry) error) error {
+			return fn(&domain.JournalEntry{ID: 1, Title: "Hello", Content: "World"})
+		},
+		listTagsFunc: func(ctx context.Context) ([]*domain.Tag, error) {
+			panic("secret details")
+		},
+	}
+
+	client, url := newConnectTestServer(t, NewJournalService(mockManager), loadshed.DefaultOptions())
+
+	t.Run("calls the service", func(t *testing.T) {
+		resp, err := client.GetJournalEntry(ctx, &pb.GetJournalEntryRequest{Id: "1"})
+		if err != nil {
+			t.Fatalf("GetJournalEntry failed: %v", err)
+		}
+		if resp.Entry.Title != "Hello" {
+			t.Errorf("Expected title 'Hello', got '%s'", resp.Entry.Title)
+		}
+	})
+
+	t.Run("returns a request ID", func(t *testing.T) {
+		resp, err := http.Post(url+"/journal.v1.JournalService/GetJournalEntry", "application/json", strings.NewReader(`{"id": "1"}`))
+		if err != nil {
+			t.Fatalf("POST failed: %v", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK || resp.Header.Get(logging.RequestIDHeader) == "" {
+			t.Errorf("Expected 200 with a request ID header, got %d %v", resp.StatusCode, resp.Header)
+		}
+	})
+
+	t.Run("keeps the status code", func(t *testing.T) {
+		_, err := client.GetJournalEntry(ctx, &pb.GetJournalEntryRequest{Id: "2"})
+		if connect.CodeOf(err) != connect.CodeNotFound {
+			t.Errorf("Expected NotFound, got %v", err)
+		}
+	})
+
+	t.Run("recovers from panics", func(t *testing.T) {
+		_, err := client.ListTags(ctx, &pb.ListTagsRequest{})
+		var connectErr *connect.Error
+		if !errors.As(err, &connectErr) || connectErr.Code() != connect.CodeInternal || connectErr.Message() != "internal server error" {
+			t.Errorf("Expected a safe Internal error, got %v", err)
+		}
+	})
+
+	t.Run("sheds requests under pressure", func(t *testing.T) {
+		client, _ := newConnectTestServer(t, NewJournalService(mockManager), loadshed.Options{MaxInFlight: 0})
+		_, err := client.GetJournalEntry(ctx, &pb.GetJournalEntryRequest{Id: "1"})
+		if connect.CodeOf(err) != connect.CodeUnavailable {
+			t.Errorf("Expected Unavailable, got %v", err)
+		}
+	})
+
+	t.Run("streams exports", func(t *testing.T) {
+		stream, err := client.ExportJournal(ctx, &pb.ExportJournalRequest{Format: pb.ExportFormat_EXPORT_FORMAT_MARKDOWN})
+		if err != nil {
+			t.Fatalf("ExportJournal failed: %v", err)
+		}
+		defer stream.Close()
+
+		var files []string
+		for stream.Receive() {
+			files = append(files, stream.Msg().Filename)
+		}
+		if err := stream.Err(); err != nil {
+			t.Fatalf("ExportJournal stream failed: %v", err)
+		}
+		if len(files) != 1 {
+			t.Errorf("Expected 1 file, got %v", files)
+		}
+	})
+}
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"fmt"
+
+	pb "github.com/parkernilson/micro-journal/gen/journal/v1"
+	"github.com/parkernilson/micro-journal/internal/domain"
+)
+
+// protoToDocument converts a protobuf EntryDocument to a domain Document.
+// A nil document converts to nil (a plain-text entry).
+func protoToDocument(doc *pb.EntryDocument) (*domain.Document, error) {
+	if doc == nil {
+		return nil, nil
+	}
+
+	sections := make([]domain.Section, len(doc.Sections))
+	for i, s := range doc.Sections {
+		section := domain.Section{Heading: s.Heading}
+		switch body := s.Body.(type) {
+		case *pb.Section_Text:
+			section.Type = domain.SectionText
+			section.Text = body.Text.GetText()
+		case *pb.Section_Checklist:
+			section.Type = domain.SectionChecklist
+			for _, item := range body.Checklist.GetItems() {
+				section.Items = append(section.Items, domain.ChecklistItem{
+					Text:    item.Text,
+					Checked: item.Checked,
+				})
+			}
+		case *pb.Section_Rating:
+			section.Type = domain.SectionRating
+			section.Rating = int(body.Rating.GetValue())
+			section.MaxRating = int(body.Rating.GetMax())
+		case *pb.Section_Photo:
+			section.Type = domain.SectionPhoto
+			section.PhotoURL = body.Photo.GetUrl()
+			section.Caption = body.Photo.GetCaption()
+		default:
+			return nil, fmt.Errorf("section %d has no body", i+1)
+		}
+		sections[i] = section
+	}
+
+	return &domain.Document{
+		Version:  int(doc.Version),
+		Sections: sections,
+	}, nil
+}
+
+// documentToProto converts a domain Document to a protobuf EntryDocument.
+func documentToProto(doc *domain.Document) *pb.EntryDocument {
+	if doc == nil {
+		return nil
+	}
+
+	sections := make([]*pb.Section, len(doc.Sections))
+	for i, s := range doc.Sections {
+		section := &pb.Section{Heading: s.Heading}
+		switch s.Type {
+		case domain.SectionText:
+			section.Body = &pb.Section_Text{Text: &pb.TextSection{Text: s.Text}}
+		case domain.SectionChecklist:
+			items := make([]*pb.ChecklistItem, len(s.Items))
+			for j, item := range s.Items {
+				items[j] = &pb.ChecklistItem{Text: item.Text, Checked: item.Checked}
+			}
+			section.Body = &pb.Section_Checklist{Checklist: &pb.ChecklistSection{Items: items}}
+		case domain.SectionRating:
+			section.Body = &pb.Section_Rating{Rating: &pb.RatingSection{
+				Value: int32(s.Rating),
+				Max:   int32(s.MaxRating),
+			}}
+		case domain.SectionPhoto:
+			section.Body = &pb.Section_Photo{Photo: &pb.PhotoSection{
+				Url:     s.PhotoURL,
+				Caption: s.Caption,
+			}}
+		}
+		sections[i] = section
+	}
+
+	return &pb.EntryDocument{
+		Version:  int32(doc.Version),
+		Sections: sections,
+	}
+}
@@ -1,27 +1,55 @@
 package service
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
-	"log"
+	"io"
+	"log/slog"
 	"strconv"
+	"time"
 
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	pb "github.com/parkernilson/micro-journal/gen/journal/v1"
 	"github.com/parkernilson/micro-journal/internal/domain"
+	"github.com/parkernilson/micro-journal/internal/export"
+	"github.com/parkernilson/micro-journal/internal/importer"
 	"github.com/parkernilson/micro-journal/internal/manager"
 )
 
+// maxDayOneExportBytes is the largest Day One Journal.json accepted.
+const maxDayOneExportBytes = 64 << 20
+
 // JournalManager defines the interface for the manager layer.
 type JournalManager interface {
-	CreateEntry(ctx context.Context, title, content string) (*domain.JournalEntry, error)
+	CreateEntry(ctx context.Context, title, content string, revealAt time.Time, doc *domain.Document, tags []string) (*domain.JournalEntry, error)
 	GetEntry(ctx context.Context, id int64) (*domain.JournalEntry, error)
-	UpdateEntry(ctx context.Context, id int64, title, content string) (*domain.JournalEntry, error)
-	DeleteEntry(ctx context.Context, id int64) error
-	ListEntries(ctx context.Context, pageSize int32, pageToken string) (*manager.ListEntriesResult, error)
+	UpdateEntry(ctx context.Context, id int64, title, content string, doc *domain.Document, tags []string, expectedVersion int64) (*domain.JournalEntry, error)
+	DeleteEntry(ctx context.Context, id int64, permanent bool) error
+	BatchCreateEntries(ctx context.Context, entries []*domain.JournalEntry) ([]manager.BatchResult, error)
+	BatchGetEntries(ctx context.Context, ids []int64) ([]manager.BatchResult, error)
+	BatchDeleteEntries(ctx context.Context, ids []int64, permanent bool) ([]manager.BatchResult, error)
+	ListEntries(ctx context.Context, pageSize int32, pageToken string, opts manager.ListOptions) (*manager.ListEntriesResult, error)
+	SearchEntries(ctx context.Context, query string, pageSize int32, pageToken string) (*manager.ListEntriesResult, error)
+	ListTags(ctx context.Context) ([]*domain.Tag, error)
+	RenameTag(ctx context.Context, name, newName string) (*domain.Tag, error)
+	DeleteTag(ctx context.Context, name string) error
+	ListTrash(ctx context.Context, pageSize int32, pageToken string) (*manager.ListEntriesResult, error)
+	RestoreEntry(ctx context.Context, id int64) (*domain.JournalEntry, error)
+	PurgeTrash(ctx context.Context, deletedBefore time.Time) (int64, error)
+	ListRevisions(ctx context.Context, entryID int64, pageSize int32, pageToken string) (*manager.ListRevisionsResult, error)
+	SearchRevisions(ctx context.Context, entryID int64, query string, pageSize int32, pageToken string) (*manager.ListRevisionsResult, error)
+	RestoreRevision(ctx context.Context, entryID, revisionID int64) (*domain.JournalEntry, error)
+	VerifyArchive(ctx context.Context) (*domain.ArchiveReport, error)
+	PushBackup(ctx context.Context) (string, error)
+	ExportEntries(ctx context.Context, fn func(entry *domain.JournalEntry) error) error
+	ImportEntries(ctx context.Context, entries []*domain.JournalEntry) (int64, error)
+	SuggestTitle(ctx context.Context, content string) (string, error)
 }
 
 // JournalService implements the JournalServiceServer interface
@@ -37,11 +65,21 @@ func NewJournalService(manager JournalManager) *JournalService {
 
 // CreateJournalEntry creates a new journal entry
 func (s *JournalService) CreateJournalEntry(ctx context.Context, req *pb.CreateJournalEntryRequest) (*pb.CreateJournalEntryResponse, error) {
-	log.Printf("CreateJournalEntry called with title: %s", req.Title)
+	slog.DebugContext(ctx, "CreateJournalEntry called", "title", req.Title)
+
+	var revealAt time.Time
+	if req.RevealAt != nil {
+		revealAt = req.RevealAt.AsTime()
+	}
 
-	entry, err := s.manager.CreateEntry(ctx, req.Title, req.Content)
+	doc, err := protoToDocument(req.Document)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "failed to create entry: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid document: %v", err)
+	}
+
+	entry, err := s.manager.CreateEntry(ctx, req.Title, req.Content, revealAt, doc, req.Tags)
+	if err != nil {
+		return nil, managerError(ctx, err, "failed to create entry: %v", err)
 	}
 
 	return &pb.CreateJournalEntryResponse{
@@ -49,18 +87,42 @@ func (s *JournalService) CreateJournalEntry(ctx context.Context, req *pb.CreateJ
 	}, nil
 }
 
+// GetJournalEntry returns a single journal entry by ID
+func (s *JournalService) GetJournalEntry(ctx context.Context, req *pb.GetJournalEntryRequest) (*pb.GetJournalEntryResponse, error) {
+	slog.DebugContext(ctx, "GetJournalEntry called", "id", req.Id)
+
+	id, err := strconv.ParseInt(req.Id, 10, 64)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid entry ID: %v", err)
+	}
+
+	entry, err := s.manager.GetEntry(ctx, id)
+	if err != nil {
+		return nil, managerError(ctx, err, "failed to get entry: %v", err)
+	}
+
+	return &pb.GetJournalEntryResponse{
+		Entry: domainToProto(entry),
+	}, nil
+}
+
 // UpdateJournalEntry updates an existing journal entry
 func (s *JournalService) UpdateJournalEntry(ctx context.Context, req *pb.UpdateJournalEntryRequest) (*pb.UpdateJournalEntryResponse, error) {
-	log.Printf("UpdateJournalEntry called for entry ID: %s", req.Id)
+	slog.DebugContext(ctx, "UpdateJournalEntry called", "id", req.Id)
 
 	id, err := strconv.ParseInt(req.Id, 10, 64)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid entry ID: %v", err)
 	}
 
-	entry, err := s.manager.UpdateEntry(ctx, id, req.Title, req.Content)
+	doc, err := protoToDocument(req.Document)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid document: %v", err)
+	}
+
+	entry, err := s.manager.UpdateEntry(ctx, id, req.Title, req.Content, doc, req.Tags, req.ExpectedVersion)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "failed to update entry: %v", err)
+		return nil, managerError(ctx, err, "failed to update entry: %v", err)
 	}
 
 	return &pb.UpdateJournalEntryResponse{
@@ -68,18 +130,18 @@ func (s *JournalService) UpdateJournalEntry(ctx context.Context, req *pb.UpdateJ
 	}, nil
 }
 
-// DeleteJournalEntry deletes a journal entry
+// DeleteJournalEntry moves a journal entry to the trash, or removes it permanently
 func (s *JournalService) DeleteJournalEntry(ctx context.Context, req *pb.DeleteJournalEntryRequest) (*pb.DeleteJournalEntryResponse, error) {
-	log.Printf("DeleteJournalEntry called for entry ID: %s", req.Id)
+	slog.DebugContext(ctx, "DeleteJournalEntry called", "id", req.Id, "permanent", req.Permanent)
 
 	id, err := strconv.ParseInt(req.Id, 10, 64)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid entry ID: %v", err)
 	}
 
-	err = s.manager.DeleteEntry(ctx, id)
+	err = s.manager.DeleteEntry(ctx, id, req.Permanent)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete entry: %v", err)
+		return nil, managerError(ctx, err, "failed to delete entry: %v", err)
 	}
 
 	return &pb.DeleteJournalEntryResponse{
@@ -87,13 +149,112 @@ func (s *JournalService) DeleteJournalEntry(ctx context.Context, req *pb.DeleteJ
 	}, nil
 }
 
+// BatchCreateJournalEntries creates several journal entries in one transaction
+func (s *JournalService) BatchCreateJournalEntries(ctx context.Context, req *pb.BatchCreateJournalEntriesRequest) (*pb.BatchCreateJournalEntriesResponse, error) {
+	slog.DebugContext(ctx, "BatchCreateJournalEntries called", "count", len(req.Requests))
+
+	entries := make([]*domain.JournalEntry, len(req.Requests))
+	for i, create := range req.Requests {
+		doc, err := protoToDocument(create.Document)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid document in request %d: %v", i, err)
+		}
+		entries[i] = &domain.JournalEntry{Title: create.Title, Content: create.Content, Document: doc, Tags: create.Tags}
+		if create.RevealAt != nil {
+			entries[i].RevealAt = create.RevealAt.AsTime()
+		}
+	}
+
+	results, err := s.manager.BatchCreateEntries(ctx, entries)
+	if err != nil {
+		return nil, managerError(ctx, err, "failed to create entries: %v", err)
+	}
+
+	return &pb.BatchCreateJournalEntriesResponse{
+		Results: batchResultsToProto(results),
+	}, nil
+}
+
+// BatchGetJournalEntries returns several journal entries by ID
+func (s *JournalService) BatchGetJournalEntries(ctx context.Context, req *pb.BatchGetJournalEntriesRequest) (*pb.BatchGetJournalEntriesResponse, error) {
+	slog.DebugContext(ctx, "BatchGetJournalEntries called", "count", len(req.Ids))
+
+	ids, err := parseEntryIDs(req.Ids)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := s.manager.BatchGetEntries(ctx, ids)
+	if err != nil {
+		return nil, managerError(ctx, err, "failed to get entries: %v", err)
+	}
+
+	return &pb.BatchGetJournalEntriesResponse{
+		Results: batchResultsToProto(results),
+	}, nil
+}
+
+// BatchDeleteJournalEntries deletes several journal entries in one transaction
+func (s *JournalService) BatchDeleteJournalEntries(ctx context.Context, req *pb.BatchDeleteJournalEntriesRequest) (*pb.BatchDeleteJournalEntriesResponse, error) {
+	slog.DebugContext(ctx, "BatchDeleteJournalEntries called", "count", len(req.Ids), "permanent", req.Permanent)
+
+	ids, err := parseEntryIDs(req.Ids)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := s.manager.BatchDeleteEntries(ctx, ids, req.Permanent)
+	if err != nil {
+		return nil, managerError(ctx, err, "failed to delete entries: %v", err)
+	}
+
+	return &pb.BatchDeleteJournalEntriesResponse{
+		Results: batchResultsToProto(results),
+	}, nil
+}
+
+// parseEntryIDs parses the entry IDs of a batch request, returning an
+// InvalidArgument status naming the first malformed one.
+func parseEntryIDs(ids []string) ([]int64, error) {
+	parsed := make([]int64, len(ids))
+	for i, id := range ids {
+		var err error
+		parsed[i], err = strconv.ParseInt(id, 10, 64)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid entry ID at index %d: %v", i, err)
+		}
+	}
+	return parsed, nil
+}
+
+// batchResultsToProto converts the results of a batch, giving each failed
+// item the status code its error maps to.
+func batchResultsToProto(results []manager.BatchResult) []*pb.BatchResult {
+	protoResults := make([]*pb.BatchResult, len(results))
+	for i, result := range results {
+		protoResults[i] = &pb.BatchResult{}
+		if result.Err != nil {
+			protoResults[i].Code = int32(errorCode(result.Err))
+			protoResults[i].Message = result.Err.Error()
+		} else if result.Entry != nil {
+			protoResults[i].Entry = domainToProto(result.Entry)
+		}
+	}
+	return protoResults
+}
+
 // ListJournalEntries returns paginated journal entries sorted by date descending
 func (s *JournalService) ListJournalEntries(ctx context.Context, req *pb.ListJournalEntriesRequest) (*pb.ListJournalEntriesResponse, error) {
-	log.Printf("ListJournalEntries called with page_size: %d, page_token: %s", req.PageSize, req.PageToken)
+	slog.DebugContext(ctx, "ListJournalEntries called", "page_size", req.PageSize, "page_token", req.PageToken, "date_filter", req.DateFilter)
 
-	result, err := s.manager.ListEntries(ctx, req.PageSize, req.PageToken)
+	opts := manager.ListOptions{
+		DateFilter: req.DateFilter,
+		TimeZone:   req.TimeZone,
+		Tag:        req.Tag,
+	}
+	result, err := s.manager.ListEntries(ctx, req.PageSize, req.PageToken, opts)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "failed to list entries: %v", err)
+		return nil, managerError(ctx, err, "failed to list entries: %v", err)
 	}
 
 	// Convert domain entries to protobuf entries
@@ -109,13 +270,500 @@ func (s *JournalService) ListJournalEntries(ctx context.Context, req *pb.ListJou
 	}, nil
 }
 
+// SearchJournalEntries returns entries matching a full-text query, best match first
+func (s *JournalService) SearchJournalEntries(ctx context.Context, req *pb.SearchJournalEntriesRequest) (*pb.SearchJournalEntriesResponse, error) {
+	slog.DebugContext(ctx, "SearchJournalEntries called", "query", req.Query, "page_size", req.PageSize, "page_token", req.PageToken)
+
+	result, err := s.manager.SearchEntries(ctx, req.Query, req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, managerError(ctx, err, "failed to search entries: %v", err)
+	}
+
+	// Convert domain entries to protobuf entries
+	protoEntries := make([]*pb.JournalEntry, len(result.Entries))
+	for i, entry := range result.Entries {
+		protoEntries[i] = domainToProto(entry)
+	}
+
+	return &pb.SearchJournalEntriesResponse{
+		Entries:       protoEntries,
+		NextPageToken: result.NextPageToken,
+		TotalCount:    int32(result.TotalCount),
+	}, nil
+}
+
+// ListTags returns every tag with the number of entries that use it
+func (s *JournalService) ListTags(ctx context.Context, req *pb.ListTagsRequest) (*pb.ListTagsResponse, error) {
+	slog.DebugContext(ctx, "ListTags called")
+
+	tags, err := s.manager.ListTags(ctx)
+	if err != nil {
+		return nil, managerError(ctx, err, "failed to list tags: %v", err)
+	}
+
+	protoTags := make([]*pb.Tag, len(tags))
+	for i, tag := range tags {
+		protoTags[i] = tagToProto(tag)
+	}
+
+	return &pb.ListTagsResponse{
+		Tags: protoTags,
+	}, nil
+}
+
+// RenameTag renames a tag on every entry
+func (s *JournalService) RenameTag(ctx context.Context, req *pb.RenameTagRequest) (*pb.RenameTagResponse, error) {
+	slog.DebugContext(ctx, "RenameTag called", "name", req.Name, "new_name", req.NewName)
+
+	tag, err := s.manager.RenameTag(ctx, req.Name, req.NewName)
+	if err != nil {
+		return nil, managerError(ctx, err, "failed to rename tag: %v", err)
+	}
+
+	return &pb.RenameTagResponse{
+		Tag: tagToProto(tag),
+	}, nil
+}
+
+// DeleteTag removes a tag from every entry
+func (s *JournalService) DeleteTag(ctx context.Context, req *pb.DeleteTagRequest) (*pb.DeleteTagResponse, error) {
+	slog.DebugContext(ctx, "DeleteTag called", "name", req.Name)
+
+	err := s.manager.DeleteTag(ctx, req.Name)
+	if err != nil {
+		return nil, managerError(ctx, err, "failed to delete tag: %v", err)
+	}
+
+	return &pb.DeleteTagResponse{
+		Success: true,
+	}, nil
+}
+
+// ListTrashedEntries returns paginated entries in the trash, most recently deleted first
+func (s *JournalService) ListTrashedEntries(ctx context.Context, req *pb.ListTrashedEntriesRequest) (*pb.ListTrashedEntriesResponse, error) {
+	slog.DebugContext(ctx, "ListTrashedEntries called", "page_size", req.PageSize, "page_token", req.PageToken)
+
+	result, err := s.manager.ListTrash(ctx, req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, managerError(ctx, err, "failed to list trashed entries: %v", err)
+	}
+
+	// Convert domain entries to protobuf entries
+	protoEntries := make([]*pb.JournalEntry, len(result.Entries))
+	for i, entry := range result.Entries {
+		protoEntries[i] = domainToProto(entry)
+	}
+
+	return &pb.ListTrashedEntriesResponse{
+		Entries:       protoEntries,
+		NextPageToken: result.NextPageToken,
+		TotalCount:    int32(result.TotalCount),
+	}, nil
+}
+
+// RestoreJournalEntry moves a journal entry out of the trash
+func (s *JournalService) RestoreJournalEntry(ctx context.Context, req *pb.RestoreJournalEntryRequest) (*pb.RestoreJournalEntryResponse, error) {
+	slog.DebugContext(ctx, "RestoreJournalEntry called", "id", req.Id)
+
+	id, err := strconv.ParseInt(req.Id, 10, 64)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid entry ID: %v", err)
+	}
+
+	entry, err := s.manager.RestoreEntry(ctx, id)
+	if err != nil {
+		return nil, managerError(ctx, err, "failed to restore entry: %v", err)
+	}
+
+	return &pb.RestoreJournalEntryResponse{
+		Entry: domainToProto(entry),
+	}, nil
+}
+
+// PurgeTrash permanently removes entries in the trash
+func (s *JournalService) PurgeTrash(ctx context.Context, req *pb.PurgeTrashRequest) (*pb.PurgeTrashResponse, error) {
+	slog.DebugContext(ctx, "PurgeTrash called", "deleted_before", req.DeletedBefore)
+
+	var deletedBefore time.Time
+	if req.DeletedBefore != nil {
+		deletedBefore = req.DeletedBefore.AsTime()
+	}
+
+	purged, err := s.manager.PurgeTrash(ctx, deletedBefore)
+	if err != nil {
+		return nil, managerError(ctx, err, "failed to purge trash: %v", err)
+	}
+
+	return &pb.PurgeTrashResponse{
+		PurgedCount: purged,
+	}, nil
+}
+
+// ExportJournal streams every entry in the requested format
+func (s *JournalService) ExportJournal(req *pb.ExportJournalRequest, stream grpc.ServerStreamingServer[pb.ExportJournalResponse]) error {
+	return s.exportJournal(stream.Context(), req, stream.Send)
+}
+
+// exportJournal encodes every entry in the requested format and passes each
+// chunk to send. It is shared by the gRPC and Connect handlers.
+func (s *JournalService) exportJournal(ctx context.Context, req *pb.ExportJournalRequest, send func(*pb.ExportJournalResponse) error) error {
+	slog.DebugContext(ctx, "ExportJournal called", "format", req.Format.String())
+
+	format, err := exportFormat(req.Format)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid format: %v", err)
+	}
+	encoder, err := export.NewEncoder(format)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid format: %v", err)
+	}
+
+	err = s.manager.ExportEntries(ctx, func(entry *domain.JournalEntry) error {
+		chunk, err := encoder.Encode(entry)
+		if err != nil {
+			return err
+		}
+		return send(&pb.ExportJournalResponse{
+			Filename: chunk.Filename,
+			Data:     chunk.Data,
+		})
+	})
+	if err != nil {
+		if ctxErr := contextError(ctx); ctxErr != nil {
+			return ctxErr
+		}
+		return status.Errorf(codes.Internal, "failed to export journal: %v", err)
+	}
+
+	return nil
+}
+
+// contextError returns the status for ctx's error if ctx is done, so an
+// operation abandoned by its client is reported as Canceled or
+// DeadlineExceeded rather than as a failure of its own. Otherwise it returns
+// nil.
+func contextError(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return status.FromContextError(err).Err()
+	}
+	return nil
+}
+
+// managerError returns the status for an error from the manager, formatted
+// with format and args. The code follows the kind of domain error err
+// matches, and an error caused by the client going away is reported as
+// contextError reports it.
+func managerError(ctx context.Context, err error, format string, args ...any) error {
+	if ctxErr := contextError(ctx); ctxErr != nil {
+		return ctxErr
+	}
+	return status.Errorf(errorCode(err), format, args...)
+}
+
+// errorCode returns the status code for the kind of domain error err
+// matches, or Internal if it matches none.
+func errorCode(err error) codes.Code {
+	switch {
+	case errors.Is(err, domain.ErrNotFound):
+		return codes.NotFound
+	case errors.Is(err, domain.ErrValidation):
+		return codes.InvalidArgument
+	case errors.Is(err, domain.ErrFailedPrecondition):
+		return codes.FailedPrecondition
+	case errors.Is(err, domain.ErrConflict):
+		return codes.Aborted
+	default:
+		return codes.Internal
+	}
+}
+
+// exportFormat converts a protobuf ExportFormat to an export.Format.
+func exportFormat(format pb.ExportFormat) (export.Format, error) {
+	switch format {
+	case pb.ExportFormat_EXPORT_FORMAT_UNSPECIFIED, pb.ExportFormat_EXPORT_FORMAT_JSON_LINES:
+		return export.FormatJSONLines, nil
+	case pb.ExportFormat_EXPORT_FORMAT_MARKDOWN:
+		return export.FormatMarkdown, nil
+	case pb.ExportFormat_EXPORT_FORMAT_CSV:
+		return export.FormatCSV, nil
+	default:
+		return 0, fmt.Errorf("unknown export format: %v", format)
+	}
+}
+
+// ImportJournal inserts batches of entries from another journal
+func (s *JournalService) ImportJournal(stream grpc.ClientStreamingServer[pb.ImportJournalRequest, pb.ImportJournalResponse]) error {
+	resp, err := s.importJournal(stream.Context(), stream.Recv)
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(resp)
+}
+
+// importJournal imports each batch returned by recv until it returns io.EOF.
+// Batches before a failed one stay imported. It is shared by the gRPC and
+// Connect handlers.
+func (s *JournalService) importJournal(ctx context.Context, recv func() (*pb.ImportJournalRequest, error)) (*pb.ImportJournalResponse, error) {
+	slog.DebugContext(ctx, "ImportJournal called")
+
+	resp := &pb.ImportJournalResponse{}
+	for batch := 0; ; batch++ {
+		if err := contextError(ctx); err != nil {
+			return nil, err
+		}
+		req, err := recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		entries := make([]*domain.JournalEntry, len(req.Entries))
+		for i, imported := range req.Entries {
+			entries[i], err = importEntryToDomain(imported)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid entry %d in batch %d: %v (%d entries imported before it)", i, batch, err, resp.ImportedCount)
+			}
+		}
+
+		count, err := s.manager.ImportEntries(ctx, entries)
+		if err != nil {
+			return nil, managerError(ctx, err, "failed to import batch %d: %v (%d entries imported before it)", batch, err, resp.ImportedCount)
+		}
+		resp.ImportedCount += count
+		resp.SkippedCount += int64(len(entries)) - count
+	}
+
+	slog.InfoContext(ctx, "Imported entries", "imported", resp.ImportedCount, "skipped", resp.SkippedCount)
+	return resp, nil
+}
+
+// ImportDayOne imports the entries of a Day One JSON export
+func (s *JournalService) ImportDayOne(stream grpc.ClientStreamingServer[pb.ImportDayOneRequest, pb.ImportDayOneResponse]) error {
+	resp, err := s.importDayOne(stream.Context(), stream.Recv)
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(resp)
+}
+
+// importDayOne joins the export chunks returned by recv until it returns
+// io.EOF, then imports the entries. It is shared by the gRPC and Connect
+// handlers.
+func (s *JournalService) importDayOne(ctx context.Context, recv func() (*pb.ImportDayOneRequest, error)) (*pb.ImportDayOneResponse, error) {
+	slog.DebugContext(ctx, "ImportDayOne called")
+
+	var data []byte
+	for {
+		req, err := recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(data)+len(req.Data) > maxDayOneExportBytes {
+			return nil, status.Errorf(codes.InvalidArgument, "export is larger than %d bytes", maxDayOneExportBytes)
+		}
+		data = append(data, req.Data...)
+	}
+
+	entries, err := importer.ParseDayOne(bytes.NewReader(data))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to parse export: %v", err)
+	}
+
+	result, err := importer.Load(ctx, s.manager, entries)
+	if err != nil {
+		return nil, managerError(ctx, err, "failed to import export: %v (%d entries imported before it)", err, result.Imported)
+	}
+
+	slog.InfoContext(ctx, "Imported Day One entries", "imported", result.Imported, "skipped", result.Skipped)
+	return &pb.ImportDayOneResponse{
+		ImportedCount: result.Imported,
+		SkippedCount:  result.Skipped,
+	}, nil
+}
+
+// importEntryToDomain converts a protobuf ImportEntry to a domain entry.
+func importEntryToDomain(imported *pb.ImportEntry) (*domain.JournalEntry, error) {
+	doc, err := protoToDocument(imported.Document)
+	if err != nil {
+		return nil, fmt.Errorf("invalid document: %w", err)
+	}
+
+	entry := &domain.JournalEntry{
+		Title:    imported.Title,
+		Content:  imported.Content,
+		Document: doc,
+		Tags:     imported.Tags,
+	}
+	if imported.CreatedAt != nil {
+		entry.CreatedAt = imported.CreatedAt.AsTime()
+	}
+	return entry, nil
+}
+
+// VerifyArchive checks the hash-chained entry archive
+func (s *JournalService) VerifyArchive(ctx context.Context, req *pb.VerifyArchiveRequest) (*pb.VerifyArchiveResponse, error) {
+	slog.DebugContext(ctx, "VerifyArchive called")
+
+	report, err := s.manager.VerifyArchive(ctx)
+	if err != nil {
+		return nil, managerError(ctx, err, "failed to verify archive: %v", err)
+	}
+
+	return &pb.VerifyArchiveResponse{
+		RecordCount: report.RecordCount,
+		HeadHash:    report.HeadHash,
+		Valid:       len(report.Problems) == 0,
+		Problems:    report.Problems,
+	}, nil
+}
+
+// PushBackup pushes the journal to its backup remote
+func (s *JournalService) PushBackup(ctx context.Context, req *pb.PushBackupRequest) (*pb.PushBackupResponse, error) {
+	slog.DebugContext(ctx, "PushBackup called")
+
+	version, err := s.manager.PushBackup(ctx)
+	if err != nil {
+		return nil, managerError(ctx, err, "failed to push backup: %v", err)
+	}
+
+	return &pb.PushBackupResponse{
+		Version: version,
+	}, nil
+}
+
+// ListJournalEntryRevisions returns the previous versions of an entry, newest first
+func (s *JournalService) ListJournalEntryRevisions(ctx context.Context, req *pb.ListJournalEntryRevisionsRequest) (*pb.ListJournalEntryRevisionsResponse, error) {
+	slog.DebugContext(ctx, "ListJournalEntryRevisions called", "entry_id", req.EntryId, "page_size", req.PageSize, "page_token", req.PageToken)
+
+	entryID, err := strconv.ParseInt(req.EntryId, 10, 64)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid entry ID: %v", err)
+	}
+
+	result, err := s.manager.ListRevisions(ctx, entryID, req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, managerError(ctx, err, "failed to list revisions: %v", err)
+	}
+
+	protoRevisions := make([]*pb.Revision, len(result.Revisions))
+	for i, revision := range result.Revisions {
+		protoRevisions[i] = revisionToProto(revision)
+	}
+
+	return &pb.ListJournalEntryRevisionsResponse{
+		Revisions:     protoRevisions,
+		NextPageToken: result.NextPageToken,
+		TotalCount:    int32(result.TotalCount),
+	}, nil
+}
+
+// SearchJournalEntryRevisions searches the previous versions of an entry, best match first
+func (s *JournalService) SearchJournalEntryRevisions(ctx context.Context, req *pb.SearchJournalEntryRevisionsRequest) (*pb.SearchJournalEntryRevisionsResponse, error) {
+	slog.DebugContext(ctx, "SearchJournalEntryRevisions called", "entry_id", req.EntryId, "query", req.Query, "page_size", req.PageSize, "page_token", req.PageToken)
+
+	entryID, err := strconv.ParseInt(req.EntryId, 10, 64)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid entry ID: %v", err)
+	}
+
+	result, err := s.manager.SearchRevisions(ctx, entryID, req.Query, req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, managerError(ctx, err, "failed to search revisions: %v", err)
+	}
+
+	protoRevisions := make([]*pb.Revision, len(result.Revisions))
+	for i, revision := range result.Revisions {
+		protoRevisions[i] = revisionToProto(revision)
+	}
+
+	return &pb.SearchJournalEntryRevisionsResponse{
+		Revisions:     protoRevisions,
+		NextPageToken: result.NextPageToken,
+		TotalCount:    int32(result.TotalCount),
+	}, nil
+}
+
+// RestoreJournalEntryRevision rolls an entry back to a revision
+func (s *JournalService) RestoreJournalEntryRevision(ctx context.Context, req *pb.RestoreJournalEntryRevisionRequest) (*pb.RestoreJournalEntryRevisionResponse, error) {
+	slog.DebugContext(ctx, "RestoreJournalEntryRevision called", "entry_id", req.EntryId, "revision_id", req.RevisionId)
+
+	entryID, err := strconv.ParseInt(req.EntryId, 10, 64)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid entry ID: %v", err)
+	}
+	revisionID, err := strconv.ParseInt(req.RevisionId, 10, 64)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid revision ID: %v", err)
+	}
+
+	entry, err := s.manager.RestoreRevision(ctx, entryID, revisionID)
+	if err != nil {
+		return nil, managerError(ctx, err, "failed to restore revision: %v", err)
+	}
+
+	return &pb.RestoreJournalEntryRevisionResponse{
+		Entry: domainToProto(entry),
+	}, nil
+}
+
+// SuggestTitle suggests a title derived from entry content
+func (s *JournalService) SuggestTitle(ctx context.Context, req *pb.SuggestTitleRequest) (*pb.SuggestTitleResponse, error) {
+	slog.DebugContext(ctx, "SuggestTitle called", "content_length", len(req.Content))
+
+	title, err := s.manager.SuggestTitle(ctx, req.Content)
+	if err != nil {
+		return nil, managerError(ctx, err, "failed to suggest title: %v", err)
+	}
+
+	return &pb.SuggestTitleResponse{
+		Title: title,
+	}, nil
+}
+
 // domainToProto converts a domain JournalEntry to a protobuf JournalEntry
 func domainToProto(entry *domain.JournalEntry) *pb.JournalEntry {
-	return &pb.JournalEntry{
-		Id:        fmt.Sprintf("%d", entry.ID),
-		Title:     entry.Title,
-		Content:   entry.Content,
-		CreatedAt: timestamppb.New(entry.CreatedAt),
-		UpdatedAt: timestamppb.New(entry.UpdatedAt),
+	protoEntry := &pb.JournalEntry{
+		Id:         fmt.Sprintf("%d", entry.ID),
+		Title:      entry.Title,
+		Content:    entry.Content,
+		CreatedAt:  timestamppb.New(entry.CreatedAt),
+		UpdatedAt:  timestamppb.New(entry.UpdatedAt),
+		Version:    entry.Version,
+		Sealed:     entry.Sealed,
+		Document:   documentToProto(entry.Document),
+		Tags:       entry.Tags,
+		CommitHash: entry.CommitHash,
+	}
+	if !entry.RevealAt.IsZero() {
+		protoEntry.RevealAt = timestamppb.New(entry.RevealAt)
+	}
+	if !entry.DeletedAt.IsZero() {
+		protoEntry.DeletedAt = timestamppb.New(entry.DeletedAt)
+	}
+	return protoEntry
+}
+
+// tagToProto converts a domain Tag to a protobuf Tag
+func tagToProto(tag *domain.Tag) *pb.Tag {
+	return &pb.Tag{
+		Name:       tag.Name,
+		EntryCount: tag.EntryCount,
+	}
+}
+
+// revisionToProto converts a domain Revision to a protobuf Revision
+func revisionToProto(revision *domain.Revision) *pb.Revision {
+	return &pb.Revision{
+		Id:        fmt.Sprintf("%d", revision.ID),
+		EntryId:   fmt.Sprintf("%d", revision.EntryID),
+		Title:     revision.Title,
+		Content:   revision.Content,
+		Document:  documentToProto(revision.Document),
+		CreatedAt: timestamppb.New(revision.CreatedAt),
 	}
 }
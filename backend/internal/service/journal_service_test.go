@@ -3,9 +3,16 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
+	"io"
 	"testing"
 	"time"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
 	pb "github.com/parkernilson/micro-journal/gen/journal/v1"
 	"github.com/parkernilson/micro-journal/internal/domain"
 	"github.com/parkernilson/micro-journal/internal/manager"
@@ -13,16 +20,34 @@ import (
 
 // mockJournalManager is a mock implementation of JournalManager for testing.
 type mockJournalManager struct {
-	createEntryFunc func(ctx context.Context, title, content string) (*domain.JournalEntry, error)
-	getEntryFunc    func(ctx context.Context, id int64) (*domain.JournalEntry, error)
-	updateEntryFunc func(ctx context.Context, id int64, title, content string) (*domain.JournalEntry, error)
-	deleteEntryFunc func(ctx context.Context, id int64) error
-	listEntriesFunc func(ctx context.Context, pageSize int32, pageToken string) (*manager.ListEntriesResult, error)
+	createEntryFunc     func(ctx context.Context, title, content string, revealAt time.Time, doc *domain.Document, tags []string) (*domain.JournalEntry, error)
+	getEntryFunc        func(ctx context.Context, id int64) (*domain.JournalEntry, error)
+	updateEntryFunc     func(ctx context.Context, id int64, title, content string, doc *domain.Document, tags []string, expectedVersion int64) (*domain.JournalEntry, error)
+	deleteEntryFunc     func(ctx context.Context, id int64, permanent bool) error
+	batchCreateFunc     func(ctx context.Context, entries []*domain.JournalEntry) ([]manager.BatchResult, error)
+	batchGetFunc        func(ctx context.Context, ids []int64) ([]manager.BatchResult, error)
+	batchDeleteFunc     func(ctx context.Context, ids []int64, permanent bool) ([]manager.BatchResult, error)
+	listEntriesFunc     func(ctx context.Context, pageSize int32, pageToken string, opts manager.ListOptions) (*manager.ListEntriesResult, error)
+	searchEntriesFunc   func(ctx context.Context, query string, pageSize int32, pageToken string) (*manager.ListEntriesResult, error)
+	listTagsFunc        func(ctx context.Context) ([]*domain.Tag, error)
+	renameTagFunc       func(ctx context.Context, name, newName string) (*domain.Tag, error)
+	deleteTagFunc       func(ctx context.Context, name string) error
+	listTrashFunc       func(ctx context.Context, pageSize int32, pageToken string) (*manager.ListEntriesResult, error)
+	restoreEntryFunc    func(ctx context.Context, id int64) (*domain.JournalEntry, error)
+	purgeTrashFunc      func(ctx context.Context, deletedBefore time.Time) (int64, error)
+	listRevisionsFunc   func(ctx context.Context, entryID int64, pageSize int32, pageToken string) (*manager.ListRevisionsResult, error)
+	searchRevisionsFunc func(ctx context.Context, entryID int64, query string, pageSize int32, pageToken string) (*manager.ListRevisionsResult, error)
+	restoreRevisionFunc func(ctx context.Context, entryID, revisionID int64) (*domain.JournalEntry, error)
+	verifyArchiveFunc   func(ctx context.Context) (*domain.ArchiveReport, error)
+	pushBackupFunc      func(ctx context.Context) (string, error)
+	exportEntriesFunc   func(ctx context.Context, fn func(entry *domain.JournalEntry) error) error
+	importEntriesFunc   func(ctx context.Context, entries []*domain.JournalEntry) (int64, error)
+	suggestTitleFunc    func(ctx context.Context, content string) (string, error)
 }
 
-func (m *mockJournalManager) CreateEntry(ctx context.Context, title, content string) (*domain.JournalEntry, error) {
+func (m *mockJournalManager) CreateEntry(ctx context.Context, title, content string, revealAt time.Time, doc *domain.Document, tags []string) (*domain.JournalEntry, error) {
 	if m.createEntryFunc != nil {
-		return m.createEntryFunc(ctx, title, content)
+		return m.createEntryFunc(ctx, title, content, revealAt, doc, tags)
 	}
 	return nil, errors.New("not implemented")
 }
@@ -34,33 +59,159 @@ func (m *mockJournalManager) GetEntry(ctx context.Context, id int64) (*domain.Jo
 	return nil, errors.New("not implemented")
 }
 
-func (m *mockJournalManager) UpdateEntry(ctx context.Context, id int64, title, content string) (*domain.JournalEntry, error) {
+func (m *mockJournalManager) UpdateEntry(ctx context.Context, id int64, title, content string, doc *domain.Document, tags []string, expectedVersion int64) (*domain.JournalEntry, error) {
 	if m.updateEntryFunc != nil {
-		return m.updateEntryFunc(ctx, id, title, content)
+		return m.updateEntryFunc(ctx, id, title, content, doc, tags, expectedVersion)
 	}
 	return nil, errors.New("not implemented")
 }
 
-func (m *mockJournalManager) DeleteEntry(ctx context.Context, id int64) error {
+func (m *mockJournalManager) DeleteEntry(ctx context.Context, id int64, permanent bool) error {
 	if m.deleteEntryFunc != nil {
-		return m.deleteEntryFunc(ctx, id)
+		return m.deleteEntryFunc(ctx, id, permanent)
 	}
 	return errors.New("not implemented")
 }
 
-func (m *mockJournalManager) ListEntries(ctx context.Context, pageSize int32, pageToken string) (*manager.ListEntriesResult, error) {
+func (m *mockJournalManager) BatchCreateEntries(ctx context.Context, entries []*domain.JournalEntry) ([]manager.BatchResult, error) {
+	if m.batchCreateFunc != nil {
+		return m.batchCreateFunc(ctx, entries)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockJournalManager) BatchGetEntries(ctx context.Context, ids []int64) ([]manager.BatchResult, error) {
+	if m.batchGetFunc != nil {
+		return m.batchGetFunc(ctx, ids)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockJournalManager) BatchDeleteEntries(ctx context.Context, ids []int64, permanent bool) ([]manager.BatchResult, error) {
+	if m.batchDeleteFunc != nil {
+		return m.batchDeleteFunc(ctx, ids, permanent)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockJournalManager) ListEntries(ctx context.Context, pageSize int32, pageToken string, opts manager.ListOptions) (*manager.ListEntriesResult, error) {
 	if m.listEntriesFunc != nil {
-		return m.listEntriesFunc(ctx, pageSize, pageToken)
+		return m.listEntriesFunc(ctx, pageSize, pageToken, opts)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockJournalManager) SearchEntries(ctx context.Context, query string, pageSize int32, pageToken string) (*manager.ListEntriesResult, error) {
+	if m.searchEntriesFunc != nil {
+		return m.searchEntriesFunc(ctx, query, pageSize, pageToken)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockJournalManager) ListTags(ctx context.Context) ([]*domain.Tag, error) {
+	if m.listTagsFunc != nil {
+		return m.listTagsFunc(ctx)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockJournalManager) RenameTag(ctx context.Context, name, newName string) (*domain.Tag, error) {
+	if m.renameTagFunc != nil {
+		return m.renameTagFunc(ctx, name, newName)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockJournalManager) DeleteTag(ctx context.Context, name string) error {
+	if m.deleteTagFunc != nil {
+		return m.deleteTagFunc(ctx, name)
+	}
+	return errors.New("not implemented")
+}
+
+func (m *mockJournalManager) ListTrash(ctx context.Context, pageSize int32, pageToken string) (*manager.ListEntriesResult, error) {
+	if m.listTrashFunc != nil {
+		return m.listTrashFunc(ctx, pageSize, pageToken)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockJournalManager) RestoreEntry(ctx context.Context, id int64) (*domain.JournalEntry, error) {
+	if m.restoreEntryFunc != nil {
+		return m.restoreEntryFunc(ctx, id)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockJournalManager) PurgeTrash(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	if m.purgeTrashFunc != nil {
+		return m.purgeTrashFunc(ctx, deletedBefore)
+	}
+	return 0, errors.New("not implemented")
+}
+
+func (m *mockJournalManager) ListRevisions(ctx context.Context, entryID int64, pageSize int32, pageToken string) (*manager.ListRevisionsResult, error) {
+	if m.listRevisionsFunc != nil {
+		return m.listRevisionsFunc(ctx, entryID, pageSize, pageToken)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockJournalManager) SearchRevisions(ctx context.Context, entryID int64, query string, pageSize int32, pageToken string) (*manager.ListRevisionsResult, error) {
+	if m.searchRevisionsFunc != nil {
+		return m.searchRevisionsFunc(ctx, entryID, query, pageSize, pageToken)
 	}
 	return nil, errors.New("not implemented")
 }
 
+func (m *mockJournalManager) RestoreRevision(ctx context.Context, entryID, revisionID int64) (*domain.JournalEntry, error) {
+	if m.restoreRevisionFunc != nil {
+		return m.restoreRevisionFunc(ctx, entryID, revisionID)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockJournalManager) VerifyArchive(ctx context.Context) (*domain.ArchiveReport, error) {
+	if m.verifyArchiveFunc != nil {
+		return m.verifyArchiveFunc(ctx)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockJournalManager) PushBackup(ctx context.Context) (string, error) {
+	if m.pushBackupFunc != nil {
+		return m.pushBackupFunc(ctx)
+	}
+	return "", errors.New("not implemented")
+}
+
+func (m *mockJournalManager) ExportEntries(ctx context.Context, fn func(entry *domain.JournalEntry) error) error {
+	if m.exportEntriesFunc != nil {
+		return m.exportEntriesFunc(ctx, fn)
+	}
+	return errors.New("not implemented")
+}
+
+func (m *mockJournalManager) ImportEntries(ctx context.Context, entries []*domain.JournalEntry) (int64, error) {
+	if m.importEntriesFunc != nil {
+		return m.importEntriesFunc(ctx, entries)
+	}
+	return 0, errors.New("not implemented")
+}
+
+func (m *mockJournalManager) SuggestTitle(ctx context.Context, content string) (string, error) {
+	if m.suggestTitleFunc != nil {
+		return m.suggestTitleFunc(ctx, content)
+	}
+	return "", errors.New("not implemented")
+}
+
 func TestJournalService_CreateJournalEntry(t *testing.T) {
 	ctx := context.Background()
 
 	t.Run("successful creation", func(t *testing.T) {
 		mockManager := &mockJournalManager{
-			createEntryFunc: func(ctx context.Context, title, content string) (*domain.JournalEntry, error) {
+			createEntryFunc: func(ctx context.Context, title, content string, revealAt time.Time, doc *domain.Document, tags []string) (*domain.JournalEntry, error) {
 				return &domain.JournalEntry{
 					ID:        1,
 					Title:     title,
@@ -91,7 +242,7 @@ func TestJournalService_CreateJournalEntry(t *testing.T) {
 
 	t.Run("manager error", func(t *testing.T) {
 		mockManager := &mockJournalManager{
-			createEntryFunc: func(ctx context.Context, title, content string) (*domain.JournalEntry, error) {
+			createEntryFunc: func(ctx context.Context, title, content string, revealAt time.Time, doc *domain.Document, tags []string) (*domain.JournalEntry, error) {
 				return nil, errors.New("validation error")
 			},
 		}
@@ -109,18 +260,109 @@ func TestJournalService_CreateJournalEntry(t *testing.T) {
 	})
 }
 
+func TestJournalService_CreateJournalEntry_Sealed(t *testing.T) {
+	ctx := context.Background()
+	revealAt := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	mockManager := &mockJournalManager{
+		createEntryFunc: func(ctx context.Context, title, content string, gotRevealAt time.Time, doc *domain.Document, tags []string) (*domain.JournalEntry, error) {
+			if !gotRevealAt.Equal(revealAt) {
+				t.Errorf("Expected revealAt %v, got %v", revealAt, gotRevealAt)
+			}
+			return &domain.JournalEntry{
+				ID:        1,
+				Title:     title,
+				CreatedAt: time.Now(),
+				UpdatedAt: time.Now(),
+				RevealAt:  gotRevealAt,
+				Sealed:    true,
+			}, nil
+		},
+	}
+
+	service := NewJournalService(mockManager)
+	req := &pb.CreateJournalEntryRequest{
+		Title:    "Dear Future Me",
+		Content:  "Sealed Content",
+		RevealAt: timestamppb.New(revealAt),
+	}
+
+	resp, err := service.CreateJournalEntry(ctx, req)
+	if err != nil {
+		t.Fatalf("CreateJournalEntry failed: %v", err)
+	}
+	if !resp.Entry.Sealed {
+		t.Error("Expected entry to be sealed")
+	}
+	if resp.Entry.Content != "" {
+		t.Errorf("Expected empty content, got '%s'", resp.Entry.Content)
+	}
+	if !resp.Entry.RevealAt.AsTime().Equal(revealAt) {
+		t.Errorf("Expected reveal_at %v, got %v", revealAt, resp.Entry.RevealAt.AsTime())
+	}
+}
+
+func TestJournalService_GetJournalEntry(t *testing.T) {
+	ctx := context.Background()
+
+	mockManager := &mockJournalManager{
+		getEntryFunc: func(ctx context.Context, id int64) (*domain.JournalEntry, error) {
+			if id == 1 {
+				return &domain.JournalEntry{
+					ID:        1,
+					Title:     "Test Title",
+					Content:   "Test Content",
+					CreatedAt: time.Now(),
+					UpdatedAt: time.Now(),
+				}, nil
+			}
+			return nil, fmt.Errorf("journal entry 1: %w", domain.ErrNotFound)
+		},
+	}
+
+	service := NewJournalService(mockManager)
+
+	t.Run("successful get", func(t *testing.T) {
+		resp, err := service.GetJournalEntry(ctx, &pb.GetJournalEntryRequest{Id: "1"})
+		if err != nil {
+			t.Fatalf("GetJournalEntry failed: %v", err)
+		}
+		if resp.Entry.Id != "1" {
+			t.Errorf("Expected ID '1', got '%s'", resp.Entry.Id)
+		}
+		if resp.Entry.Title != "Test Title" {
+			t.Errorf("Expected title 'Test Title', got '%s'", resp.Entry.Title)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := service.GetJournalEntry(ctx, &pb.GetJournalEntryRequest{Id: "999"})
+		if status.Code(err) != codes.NotFound {
+			t.Errorf("Expected NotFound, got %v", err)
+		}
+	})
+
+	t.Run("invalid ID", func(t *testing.T) {
+		_, err := service.GetJournalEntry(ctx, &pb.GetJournalEntryRequest{Id: "invalid"})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected InvalidArgument, got %v", err)
+		}
+	})
+}
+
 func TestJournalService_UpdateJournalEntry(t *testing.T) {
 	ctx := context.Background()
 
 	t.Run("successful update", func(t *testing.T) {
 		mockManager := &mockJournalManager{
-			updateEntryFunc: func(ctx context.Context, id int64, title, content string) (*domain.JournalEntry, error) {
+			updateEntryFunc: func(ctx context.Context, id int64, title, content string, doc *domain.Document, tags []string, expectedVersion int64) (*domain.JournalEntry, error) {
 				return &domain.JournalEntry{
 					ID:        id,
 					Title:     title,
 					Content:   content,
 					CreatedAt: time.Now(),
 					UpdatedAt: time.Now(),
+					Version:   2,
 				}, nil
 			},
 		}
@@ -139,6 +381,9 @@ func TestJournalService_UpdateJournalEntry(t *testing.T) {
 		if resp.Entry.Title != "Updated Title" {
 			t.Errorf("Expected title 'Updated Title', got '%s'", resp.Entry.Title)
 		}
+		if resp.Entry.Version != 2 {
+			t.Errorf("Expected version 2, got %d", resp.Entry.Version)
+		}
 	})
 
 	t.Run("invalid ID", func(t *testing.T) {
@@ -155,6 +400,30 @@ func TestJournalService_UpdateJournalEntry(t *testing.T) {
 			t.Error("Expected error for invalid ID, got nil")
 		}
 	})
+
+	t.Run("stale version", func(t *testing.T) {
+		mockManager := &mockJournalManager{
+			updateEntryFunc: func(ctx context.Context, id int64, title, content string, doc *domain.Document, tags []string, expectedVersion int64) (*domain.JournalEntry, error) {
+				if expectedVersion != 1 {
+					t.Errorf("Expected expected version 1, got %d", expectedVersion)
+				}
+				return nil, fmt.Errorf("journal entry %d is at version 2, not %d: %w", id, expectedVersion, domain.ErrConflict)
+			},
+		}
+
+		service := NewJournalService(mockManager)
+		req := &pb.UpdateJournalEntryRequest{
+			Id:              "1",
+			Title:           "Title",
+			Content:         "Content",
+			ExpectedVersion: 1,
+		}
+
+		_, err := service.UpdateJournalEntry(ctx, req)
+		if status.Code(err) != codes.Aborted {
+			t.Errorf("Expected Aborted, got %v", err)
+		}
+	})
 }
 
 func TestJournalService_DeleteJournalEntry(t *testing.T) {
@@ -162,7 +431,10 @@ func TestJournalService_DeleteJournalEntry(t *testing.T) {
 
 	t.Run("successful delete", func(t *testing.T) {
 		mockManager := &mockJournalManager{
-			deleteEntryFunc: func(ctx context.Context, id int64) error {
+			deleteEntryFunc: func(ctx context.Context, id int64, permanent bool) error {
+				if permanent {
+					t.Error("Expected a soft delete")
+				}
 				return nil
 			},
 		}
@@ -195,12 +467,109 @@ func TestJournalService_DeleteJournalEntry(t *testing.T) {
 	})
 }
 
+func TestJournalService_Batch(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("create reports each result", func(t *testing.T) {
+		mockManager := &mockJournalManager{
+			batchCreateFunc: func(ctx context.Context, entries []*domain.JournalEntry) ([]manager.BatchResult, error) {
+				if len(entries) != 2 || entries[0].Title != "First" || entries[1].RevealAt.IsZero() {
+					t.Errorf("Unexpected entries %+v", entries)
+				}
+				return []manager.BatchResult{
+					{Entry: &domain.JournalEntry{ID: 1, Title: "First"}},
+					{Err: fmt.Errorf("content is required: %w", domain.ErrValidation)},
+				}, nil
+			},
+		}
+
+		service := NewJournalService(mockManager)
+		resp, err := service.BatchCreateJournalEntries(ctx, &pb.BatchCreateJournalEntriesRequest{
+			Requests: []*pb.CreateJournalEntryRequest{
+				{Title: "First", Content: "Content"},
+				{Title: "Second", RevealAt: timestamppb.New(time.Now().Add(time.Hour))},
+			},
+		})
+		if err != nil {
+			t.Fatalf("BatchCreateJournalEntries failed: %v", err)
+		}
+		if len(resp.Results) != 2 || resp.Results[0].Entry.GetId() != "1" || resp.Results[0].Code != 0 {
+			t.Errorf("Unexpected first result %+v", resp.Results)
+		}
+		if got := resp.Results[1]; got.Entry != nil || codes.Code(got.Code) != codes.InvalidArgument || got.Message == "" {
+			t.Errorf("Expected an InvalidArgument result, got %+v", got)
+		}
+	})
+
+	t.Run("get maps missing entries to NotFound", func(t *testing.T) {
+		mockManager := &mockJournalManager{
+			batchGetFunc: func(ctx context.Context, ids []int64) ([]manager.BatchResult, error) {
+				return []manager.BatchResult{
+					{Entry: &domain.JournalEntry{ID: ids[0]}},
+					{Err: fmt.Errorf("journal entry %d: %w", ids[1], domain.ErrNotFound)},
+				}, nil
+			},
+		}
+
+		service := NewJournalService(mockManager)
+		resp, err := service.BatchGetJournalEntries(ctx, &pb.BatchGetJournalEntriesRequest{Ids: []string{"3", "4"}})
+		if err != nil {
+			t.Fatalf("BatchGetJournalEntries failed: %v", err)
+		}
+		if resp.Results[0].Entry.GetId() != "3" || codes.Code(resp.Results[1].Code) != codes.NotFound {
+			t.Errorf("Unexpected results %+v", resp.Results)
+		}
+	})
+
+	t.Run("delete passes permanent", func(t *testing.T) {
+		mockManager := &mockJournalManager{
+			batchDeleteFunc: func(ctx context.Context, ids []int64, permanent bool) ([]manager.BatchResult, error) {
+				if !permanent {
+					t.Error("Expected a permanent delete")
+				}
+				return make([]manager.BatchResult, len(ids)), nil
+			},
+		}
+
+		service := NewJournalService(mockManager)
+		resp, err := service.BatchDeleteJournalEntries(ctx, &pb.BatchDeleteJournalEntriesRequest{Ids: []string{"1", "2"}, Permanent: true})
+		if err != nil {
+			t.Fatalf("BatchDeleteJournalEntries failed: %v", err)
+		}
+		if len(resp.Results) != 2 || resp.Results[0].Code != 0 || resp.Results[0].Entry != nil {
+			t.Errorf("Unexpected results %+v", resp.Results)
+		}
+	})
+
+	t.Run("invalid ID fails the whole request", func(t *testing.T) {
+		service := NewJournalService(&mockJournalManager{})
+		_, err := service.BatchDeleteJournalEntries(ctx, &pb.BatchDeleteJournalEntriesRequest{Ids: []string{"1", "invalid"}})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected InvalidArgument, got %v", err)
+		}
+	})
+
+	t.Run("failed batch", func(t *testing.T) {
+		mockManager := &mockJournalManager{
+			batchGetFunc: func(ctx context.Context, ids []int64) ([]manager.BatchResult, error) {
+				return nil, fmt.Errorf("too many entries in batch: %w", domain.ErrValidation)
+			},
+		}
+
+		service := NewJournalService(mockManager)
+		_, err := service.BatchGetJournalEntries(ctx, &pb.BatchGetJournalEntriesRequest{Ids: []string{"1"}})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected InvalidArgument, got %v", err)
+		}
+	})
+}
+
 func TestJournalService_ListJournalEntries(t *testing.T) {
 	ctx := context.Background()
 
 	t.Run("successful list", func(t *testing.T) {
 		mockManager := &mockJournalManager{
-			listEntriesFunc: func(ctx context.Context, pageSize int32, pageToken string) (*manager.ListEntriesResult, error) {
+			listEntriesFunc: func(ctx context.Context, pageSize int32, pageToken string, opts manager.ListOptions) (*manager.ListEntriesResult, error) {
 				entries := []*domain.JournalEntry{
 					{
 						ID:        1,
@@ -246,9 +615,32 @@ func TestJournalService_ListJournalEntries(t *testing.T) {
 		}
 	})
 
+	t.Run("date filter", func(t *testing.T) {
+		mockManager := &mockJournalManager{
+			listEntriesFunc: func(ctx context.Context, pageSize int32, pageToken string, opts manager.ListOptions) (*manager.ListEntriesResult, error) {
+				if opts.DateFilter != "last week" || opts.TimeZone != "Europe/Paris" {
+					return nil, errors.New("unexpected options")
+				}
+				return &manager.ListEntriesResult{}, nil
+			},
+		}
+
+		service := NewJournalService(mockManager)
+		req := &pb.ListJournalEntriesRequest{
+			PageSize:   10,
+			DateFilter: "last week",
+			TimeZone:   "Europe/Paris",
+		}
+
+		_, err := service.ListJournalEntries(ctx, req)
+		if err != nil {
+			t.Fatalf("ListJournalEntries failed: %v", err)
+		}
+	})
+
 	t.Run("manager error", func(t *testing.T) {
 		mockManager := &mockJournalManager{
-			listEntriesFunc: func(ctx context.Context, pageSize int32, pageToken string) (*manager.ListEntriesResult, error) {
+			listEntriesFunc: func(ctx context.Context, pageSize int32, pageToken string, opts manager.ListOptions) (*manager.ListEntriesResult, error) {
 				return nil, errors.New("database error")
 			},
 		}
@@ -265,3 +657,657 @@ func TestJournalService_ListJournalEntries(t *testing.T) {
 		}
 	})
 }
+
+func TestJournalService_SuggestTitle(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("successful suggestion", func(t *testing.T) {
+		mockManager := &mockJournalManager{
+			suggestTitleFunc: func(ctx context.Context, content string) (string, error) {
+				return "Suggested Title", nil
+			},
+		}
+
+		service := NewJournalService(mockManager)
+		req := &pb.SuggestTitleRequest{
+			Content: "Suggested Title. More content.",
+		}
+
+		resp, err := service.SuggestTitle(ctx, req)
+		if err != nil {
+			t.Fatalf("SuggestTitle failed: %v", err)
+		}
+		if resp.Title != "Suggested Title" {
+			t.Errorf("Expected title 'Suggested Title', got '%s'", resp.Title)
+		}
+	})
+
+	t.Run("manager error", func(t *testing.T) {
+		mockManager := &mockJournalManager{
+			suggestTitleFunc: func(ctx context.Context, content string) (string, error) {
+				return "", errors.New("content cannot be empty")
+			},
+		}
+
+		service := NewJournalService(mockManager)
+		req := &pb.SuggestTitleRequest{
+			Content: "",
+		}
+
+		_, err := service.SuggestTitle(ctx, req)
+		if err == nil {
+			t.Error("Expected error, got nil")
+		}
+	})
+}
+
+func TestJournalService_CreateJournalEntry_Document(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("round trip", func(t *testing.T) {
+		mockManager := &mockJournalManager{
+			createEntryFunc: func(ctx context.Context, title, content string, revealAt time.Time, doc *domain.Document, tags []string) (*domain.JournalEntry, error) {
+				if len(doc.Sections) != 4 {
+					t.Fatalf("Expected 4 sections, got %d", len(doc.Sections))
+				}
+				if doc.Sections[1].Type != domain.SectionChecklist || !doc.Sections[1].Items[0].Checked {
+					t.Errorf("Expected checked checklist section, got %+v", doc.Sections[1])
+				}
+				return &domain.JournalEntry{ID: 1, Title: title, Content: doc.PlainText(), Document: doc}, nil
+			},
+		}
+
+		service := NewJournalService(mockManager)
+		req := &pb.CreateJournalEntryRequest{
+			Title: "Structured",
+			Document: &pb.EntryDocument{
+				Sections: []*pb.Section{
+					{Heading: "Notes", Body: &pb.Section_Text{Text: &pb.TextSection{Text: "Hello"}}},
+					{Body: &pb.Section_Checklist{Checklist: &pb.ChecklistSection{Items: []*pb.ChecklistItem{{Text: "Walk", Checked: true}}}}},
+					{Body: &pb.Section_Rating{Rating: &pb.RatingSection{Value: 3, Max: 5}}},
+					{Body: &pb.Section_Photo{Photo: &pb.PhotoSection{Url: "https://example.com/a.jpg", Caption: "Beach"}}},
+				},
+			},
+		}
+
+		resp, err := service.CreateJournalEntry(ctx, req)
+		if err != nil {
+			t.Fatalf("CreateJournalEntry failed: %v", err)
+		}
+		if !proto.Equal(resp.Entry.Document, req.Document) {
+			t.Errorf("Expected document %v, got %v", req.Document, resp.Entry.Document)
+		}
+	})
+
+	t.Run("section without body", func(t *testing.T) {
+		service := NewJournalService(&mockJournalManager{})
+		req := &pb.CreateJournalEntryRequest{
+			Title:    "Structured",
+			Document: &pb.EntryDocument{Sections: []*pb.Section{{Heading: "Empty"}}},
+		}
+
+		_, err := service.CreateJournalEntry(ctx, req)
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected InvalidArgument, got %v", err)
+		}
+	})
+}
+
+func TestJournalService_SearchJournalEntries(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("successful search", func(t *testing.T) {
+		mockManager := &mockJournalManager{
+			searchEntriesFunc: func(ctx context.Context, query string, pageSize int32, pageToken string) (*manager.ListEntriesResult, error) {
+				if query != "coffee" {
+					t.Errorf("Expected query 'coffee', got '%s'", query)
+				}
+				return &manager.ListEntriesResult{
+					Entries:       []*domain.JournalEntry{{ID: 1, Title: "Morning", Content: "Coffee"}},
+					NextPageToken: "next",
+					TotalCount:    2,
+				}, nil
+			},
+		}
+
+		service := NewJournalService(mockManager)
+		resp, err := service.SearchJournalEntries(ctx, &pb.SearchJournalEntriesRequest{Query: "coffee", PageSize: 1})
+		if err != nil {
+			t.Fatalf("SearchJournalEntries failed: %v", err)
+		}
+		if len(resp.Entries) != 1 || resp.Entries[0].Id != "1" {
+			t.Errorf("Expected entry 1, got %v", resp.Entries)
+		}
+		if resp.NextPageToken != "next" || resp.TotalCount != 2 {
+			t.Errorf("Expected next page token and total count 2, got %q %d", resp.NextPageToken, resp.TotalCount)
+		}
+	})
+
+	t.Run("invalid query", func(t *testing.T) {
+		mockManager := &mockJournalManager{
+			searchEntriesFunc: func(ctx context.Context, query string, pageSize int32, pageToken string) (*manager.ListEntriesResult, error) {
+				return nil, fmt.Errorf("search query cannot be empty: %w", domain.ErrValidation)
+			},
+		}
+
+		service := NewJournalService(mockManager)
+		_, err := service.SearchJournalEntries(ctx, &pb.SearchJournalEntriesRequest{})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected InvalidArgument, got %v", err)
+		}
+	})
+}
+
+func TestJournalService_Tags(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("list tags", func(t *testing.T) {
+		mockManager := &mockJournalManager{
+			listTagsFunc: func(ctx context.Context) ([]*domain.Tag, error) {
+				return []*domain.Tag{{Name: "travel", EntryCount: 3}}, nil
+			},
+		}
+
+		service := NewJournalService(mockManager)
+		resp, err := service.ListTags(ctx, &pb.ListTagsRequest{})
+		if err != nil {
+			t.Fatalf("ListTags failed: %v", err)
+		}
+		if len(resp.Tags) != 1 || resp.Tags[0].Name != "travel" || resp.Tags[0].EntryCount != 3 {
+			t.Errorf("Expected tag travel (3), got %v", resp.Tags)
+		}
+	})
+
+	t.Run("rename tag", func(t *testing.T) {
+		mockManager := &mockJournalManager{
+			renameTagFunc: func(ctx context.Context, name, newName string) (*domain.Tag, error) {
+				return &domain.Tag{Name: newName, EntryCount: 1}, nil
+			},
+		}
+
+		service := NewJournalService(mockManager)
+		resp, err := service.RenameTag(ctx, &pb.RenameTagRequest{Name: "work", NewName: "job"})
+		if err != nil {
+			t.Fatalf("RenameTag failed: %v", err)
+		}
+		if resp.Tag.Name != "job" {
+			t.Errorf("Expected tag 'job', got '%s'", resp.Tag.Name)
+		}
+	})
+
+	t.Run("delete tag", func(t *testing.T) {
+		mockManager := &mockJournalManager{
+			deleteTagFunc: func(ctx context.Context, name string) error {
+				return fmt.Errorf("tag %q: %w", name, domain.ErrNotFound)
+			},
+		}
+
+		service := NewJournalService(mockManager)
+		_, err := service.DeleteTag(ctx, &pb.DeleteTagRequest{Name: "work"})
+		if status.Code(err) != codes.NotFound {
+			t.Errorf("Expected NotFound, got %v", err)
+		}
+	})
+
+	t.Run("entry tags", func(t *testing.T) {
+		mockManager := &mockJournalManager{
+			createEntryFunc: func(ctx context.Context, title, content string, revealAt time.Time, doc *domain.Document, tags []string) (*domain.JournalEntry, error) {
+				return &domain.JournalEntry{ID: 1, Title: title, Content: content, Tags: tags}, nil
+			},
+		}
+
+		service := NewJournalService(mockManager)
+		resp, err := service.CreateJournalEntry(ctx, &pb.CreateJournalEntryRequest{Title: "T", Content: "C", Tags: []string{"a", "b"}})
+		if err != nil {
+			t.Fatalf("CreateJournalEntry failed: %v", err)
+		}
+		if len(resp.Entry.Tags) != 2 {
+			t.Errorf("Expected 2 tags, got %v", resp.Entry.Tags)
+		}
+	})
+}
+
+func TestJournalService_Trash(t *testing.T) {
+	ctx := context.Background()
+	deletedAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("list trashed entries", func(t *testing.T) {
+		mockManager := &mockJournalManager{
+			listTrashFunc: func(ctx context.Context, pageSize int32, pageToken string) (*manager.ListEntriesResult, error) {
+				return &manager.ListEntriesResult{
+					Entries:    []*domain.JournalEntry{{ID: 1, Title: "Gone", DeletedAt: deletedAt}},
+					TotalCount: 1,
+				}, nil
+			},
+		}
+
+		service := NewJournalService(mockManager)
+		resp, err := service.ListTrashedEntries(ctx, &pb.ListTrashedEntriesRequest{})
+		if err != nil {
+			t.Fatalf("ListTrashedEntries failed: %v", err)
+		}
+		if len(resp.Entries) != 1 || !resp.Entries[0].DeletedAt.AsTime().Equal(deletedAt) {
+			t.Errorf("Expected trashed entry deleted at %v, got %v", deletedAt, resp.Entries)
+		}
+	})
+
+	t.Run("restore", func(t *testing.T) {
+		mockManager := &mockJournalManager{
+			restoreEntryFunc: func(ctx context.Context, id int64) (*domain.JournalEntry, error) {
+				if id != 1 {
+					return nil, fmt.Errorf("journal entry %d in the trash: %w", id, domain.ErrNotFound)
+				}
+				return &domain.JournalEntry{ID: 1, Title: "Back"}, nil
+			},
+		}
+
+		service := NewJournalService(mockManager)
+		resp, err := service.RestoreJournalEntry(ctx, &pb.RestoreJournalEntryRequest{Id: "1"})
+		if err != nil {
+			t.Fatalf("RestoreJournalEntry failed: %v", err)
+		}
+		if resp.Entry.DeletedAt != nil {
+			t.Errorf("Expected no deleted_at, got %v", resp.Entry.DeletedAt)
+		}
+
+		_, err = service.RestoreJournalEntry(ctx, &pb.RestoreJournalEntryRequest{Id: "2"})
+		if status.Code(err) != codes.NotFound {
+			t.Errorf("Expected NotFound, got %v", err)
+		}
+	})
+
+	t.Run("purge", func(t *testing.T) {
+		mockManager := &mockJournalManager{
+			purgeTrashFunc: func(ctx context.Context, deletedBefore time.Time) (int64, error) {
+				if !deletedBefore.Equal(deletedAt) {
+					t.Errorf("Expected deletedBefore %v, got %v", deletedAt, deletedBefore)
+				}
+				return 3, nil
+			},
+		}
+
+		service := NewJournalService(mockManager)
+		resp, err := service.PurgeTrash(ctx, &pb.PurgeTrashRequest{DeletedBefore: timestamppb.New(deletedAt)})
+		if err != nil {
+			t.Fatalf("PurgeTrash failed: %v", err)
+		}
+		if resp.PurgedCount != 3 {
+			t.Errorf("Expected 3 purged, got %d", resp.PurgedCount)
+		}
+	})
+}
+
+func TestJournalService_Revisions(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("list revisions", func(t *testing.T) {
+		mockManager := &mockJournalManager{
+			listRevisionsFunc: func(ctx context.Context, entryID int64, pageSize int32, pageToken string) (*manager.ListRevisionsResult, error) {
+				return &manager.ListRevisionsResult{
+					Revisions:  []*domain.Revision{{ID: 7, EntryID: entryID, Title: "Old", Content: "Old Content"}},
+					TotalCount: 1,
+				}, nil
+			},
+		}
+
+		service := NewJournalService(mockManager)
+		resp, err := service.ListJournalEntryRevisions(ctx, &pb.ListJournalEntryRevisionsRequest{EntryId: "1"})
+		if err != nil {
+			t.Fatalf("ListJournalEntryRevisions failed: %v", err)
+		}
+		if len(resp.Revisions) != 1 || resp.Revisions[0].Id != "7" || resp.Revisions[0].EntryId != "1" {
+			t.Errorf("Expected revision 7 of entry 1, got %v", resp.Revisions)
+		}
+	})
+
+	t.Run("restore revision", func(t *testing.T) {
+		mockManager := &mockJournalManager{
+			restoreRevisionFunc: func(ctx context.Context, entryID, revisionID int64) (*domain.JournalEntry, error) {
+				if entryID != 1 || revisionID != 7 {
+					t.Errorf("Expected entry 1 revision 7, got %d %d", entryID, revisionID)
+				}
+				return &domain.JournalEntry{ID: 1, Title: "Old"}, nil
+			},
+		}
+
+		service := NewJournalService(mockManager)
+		resp, err := service.RestoreJournalEntryRevision(ctx, &pb.RestoreJournalEntryRevisionRequest{EntryId: "1", RevisionId: "7"})
+		if err != nil {
+			t.Fatalf("RestoreJournalEntryRevision failed: %v", err)
+		}
+		if resp.Entry.Title != "Old" {
+			t.Errorf("Expected title 'Old', got '%s'", resp.Entry.Title)
+		}
+
+		_, err = service.RestoreJournalEntryRevision(ctx, &pb.RestoreJournalEntryRevisionRequest{EntryId: "1", RevisionId: "x"})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected InvalidArgument, got %v", err)
+		}
+	})
+
+	t.Run("search revisions", func(t *testing.T) {
+		mockManager := &mockJournalManager{
+			searchRevisionsFunc: func(ctx context.Context, entryID int64, query string, pageSize int32, pageToken string) (*manager.ListRevisionsResult, error) {
+				if entryID != 1 || query != "lighthouse" {
+					t.Errorf("Expected entry 1 and query 'lighthouse', got %d %q", entryID, query)
+				}
+				return &manager.ListRevisionsResult{
+					Revisions:  []*domain.Revision{{ID: 7, EntryID: entryID, Title: "Old"}},
+					TotalCount: 1,
+				}, nil
+			},
+		}
+
+		service := NewJournalService(mockManager)
+		resp, err := service.SearchJournalEntryRevisions(ctx, &pb.SearchJournalEntryRevisionsRequest{EntryId: "1", Query: "lighthouse"})
+		if err != nil {
+			t.Fatalf("SearchJournalEntryRevisions failed: %v", err)
+		}
+		if len(resp.Revisions) != 1 || resp.Revisions[0].Id != "7" || resp.TotalCount != 1 {
+			t.Errorf("Expected revision 7, got %v", resp)
+		}
+	})
+}
+
+func TestJournalService_VerifyArchive(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("returns the report", func(t *testing.T) {
+		mockManager := &mockJournalManager{
+			verifyArchiveFunc: func(ctx context.Context) (*domain.ArchiveReport, error) {
+				return &domain.ArchiveReport{RecordCount: 3, HeadHash: "abc", Problems: []string{"archive record 2 has been altered"}}, nil
+			},
+		}
+
+		service := NewJournalService(mockManager)
+		resp, err := service.VerifyArchive(ctx, &pb.VerifyArchiveRequest{})
+		if err != nil {
+			t.Fatalf("VerifyArchive failed: %v", err)
+		}
+		if resp.Valid || resp.RecordCount != 3 || resp.HeadHash != "abc" || len(resp.Problems) != 1 {
+			t.Errorf("Expected invalid report of 3 records, got %v", resp)
+		}
+	})
+
+	t.Run("archive unsupported", func(t *testing.T) {
+		mockManager := &mockJournalManager{
+			verifyArchiveFunc: func(ctx context.Context) (*domain.ArchiveReport, error) {
+				return nil, fmt.Errorf("the entry archive is not supported: %w", domain.ErrFailedPrecondition)
+			},
+		}
+
+		service := NewJournalService(mockManager)
+		_, err := service.VerifyArchive(ctx, &pb.VerifyArchiveRequest{})
+		if status.Code(err) != codes.FailedPrecondition {
+			t.Errorf("Expected FailedPrecondition, got %v", err)
+		}
+	})
+}
+
+func TestJournalService_PushBackup(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("returns the pushed version", func(t *testing.T) {
+		service := NewJournalService(&mockJournalManager{
+			pushBackupFunc: func(ctx context.Context) (string, error) {
+				return "abc123", nil
+			},
+		})
+
+		resp, err := service.PushBackup(ctx, &pb.PushBackupRequest{})
+		if err != nil {
+			t.Fatalf("PushBackup failed: %v", err)
+		}
+		if resp.Version != "abc123" {
+			t.Errorf("Expected version 'abc123', got '%s'", resp.Version)
+		}
+	})
+
+	t.Run("backups unavailable", func(t *testing.T) {
+		service := NewJournalService(&mockJournalManager{
+			pushBackupFunc: func(ctx context.Context) (string, error) {
+				return "", fmt.Errorf("no backup remote: %w", domain.ErrFailedPrecondition)
+			},
+		})
+
+		_, err := service.PushBackup(ctx, &pb.PushBackupRequest{})
+		if status.Code(err) != codes.FailedPrecondition {
+			t.Errorf("Expected FailedPrecondition, got %v", err)
+		}
+	})
+
+	t.Run("push failure", func(t *testing.T) {
+		service := NewJournalService(&mockJournalManager{
+			pushBackupFunc: func(ctx context.Context) (string, error) {
+				return "", errors.New("remote hung up")
+			},
+		})
+
+		_, err := service.PushBackup(ctx, &pb.PushBackupRequest{})
+		if status.Code(err) != codes.Internal {
+			t.Errorf("Expected Internal, got %v", err)
+		}
+	})
+}
+
+func TestJournalService_ExportJournal(t *testing.T) {
+	ctx := context.Background()
+
+	mockManager := &mockJournalManager{
+		exportEntriesFunc: func(ctx context.Context, fn func(entry *domain.JournalEntry) error) error {
+			for _, id := range []int64{1, 2} {
+				if err := fn(&domain.JournalEntry{ID: id, Title: "Title", Content: "Content"}); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+	service := NewJournalService(mockManager)
+
+	t.Run("csv", func(t *testing.T) {
+		var chunks []*pb.ExportJournalResponse
+		err := service.exportJournal(ctx, &pb.ExportJournalRequest{Format: pb.ExportFormat_EXPORT_FORMAT_CSV}, func(resp *pb.ExportJournalResponse) error {
+			chunks = append(chunks, resp)
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("ExportJournal failed: %v", err)
+		}
+		if len(chunks) != 2 || chunks[0].Filename != "journal.csv" || chunks[1].Filename != "journal.csv" {
+			t.Errorf("Expected 2 chunks of journal.csv, got %v", chunks)
+		}
+	})
+
+	t.Run("send failure stops the export", func(t *testing.T) {
+		sent := 0
+		err := service.exportJournal(ctx, &pb.ExportJournalRequest{}, func(resp *pb.ExportJournalResponse) error {
+			sent++
+			return errors.New("client gone")
+		})
+		if status.Code(err) != codes.Internal || sent != 1 {
+			t.Errorf("Expected Internal after 1 send, got %v after %d", err, sent)
+		}
+	})
+
+	t.Run("cancelled client", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		sent := 0
+		err := service.exportJournal(ctx, &pb.ExportJournalRequest{}, func(resp *pb.ExportJournalResponse) error {
+			sent++
+			cancel()
+			return ctx.Err()
+		})
+		if status.Code(err) != codes.Canceled || sent != 1 {
+			t.Errorf("Expected Canceled after 1 send, got %v after %d", err, sent)
+		}
+	})
+
+	t.Run("unknown format", func(t *testing.T) {
+		err := service.exportJournal(ctx, &pb.ExportJournalRequest{Format: 99}, func(resp *pb.ExportJournalResponse) error {
+			return nil
+		})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected InvalidArgument, got %v", err)
+		}
+	})
+}
+
+func TestJournalService_ImportJournal(t *testing.T) {
+	ctx := context.Background()
+	written := time.Date(2015, 6, 1, 8, 30, 0, 0, time.UTC)
+
+	// recvBatches returns a recv func yielding batches, then io.EOF
+	recvBatches := func(batches ...*pb.ImportJournalRequest) func() (*pb.ImportJournalRequest, error) {
+		return func() (*pb.ImportJournalRequest, error) {
+			if len(batches) == 0 {
+				return nil, io.EOF
+			}
+			batch := batches[0]
+			batches = batches[1:]
+			return batch, nil
+		}
+	}
+
+	t.Run("imports every batch", func(t *testing.T) {
+		mockManager := &mockJournalManager{
+			importEntriesFunc: func(ctx context.Context, entries []*domain.JournalEntry) (int64, error) {
+				if !entries[0].CreatedAt.Equal(written) {
+					t.Errorf("Expected created_at %v, got %v", written, entries[0].CreatedAt)
+				}
+				// The first entry of each batch is a duplicate
+				return int64(len(entries)) - 1, nil
+			},
+		}
+
+		entry := &pb.ImportEntry{Title: "Old", Content: "Old Content", CreatedAt: timestamppb.New(written)}
+		service := NewJournalService(mockManager)
+		resp, err := service.importJournal(ctx, recvBatches(
+			&pb.ImportJournalRequest{Entries: []*pb.ImportEntry{entry, entry}},
+			&pb.ImportJournalRequest{Entries: []*pb.ImportEntry{entry, entry, entry}},
+		))
+		if err != nil {
+			t.Fatalf("ImportJournal failed: %v", err)
+		}
+		if resp.ImportedCount != 3 || resp.SkippedCount != 2 {
+			t.Errorf("Expected 3 imported and 2 skipped, got %v", resp)
+		}
+	})
+
+	t.Run("invalid batch", func(t *testing.T) {
+		mockManager := &mockJournalManager{
+			importEntriesFunc: func(ctx context.Context, entries []*domain.JournalEntry) (int64, error) {
+				return 0, fmt.Errorf("entry 0: created_at is required: %w", domain.ErrValidation)
+			},
+		}
+
+		service := NewJournalService(mockManager)
+		_, err := service.importJournal(ctx, recvBatches(&pb.ImportJournalRequest{Entries: []*pb.ImportEntry{{Content: "x"}}}))
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected InvalidArgument, got %v", err)
+		}
+	})
+
+	t.Run("client cancels between batches", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		batches := 0
+		mockManager := &mockJournalManager{
+			importEntriesFunc: func(ctx context.Context, entries []*domain.JournalEntry) (int64, error) {
+				batches++
+				cancel()
+				return int64(len(entries)), nil
+			},
+		}
+
+		entry := &pb.ImportEntry{Title: "Old", Content: "Old Content", CreatedAt: timestamppb.New(written)}
+		service := NewJournalService(mockManager)
+		_, err := service.importJournal(ctx, recvBatches(
+			&pb.ImportJournalRequest{Entries: []*pb.ImportEntry{entry}},
+			&pb.ImportJournalRequest{Entries: []*pb.ImportEntry{entry}},
+		))
+		if status.Code(err) != codes.Canceled || batches != 1 {
+			t.Errorf("Expected Canceled after 1 batch, got %v after %d", err, batches)
+		}
+	})
+}
+
+func TestJournalService_ImportDayOne(t *testing.T) {
+	ctx := context.Background()
+
+	var imported []*domain.JournalEntry
+	mockManager := &mockJournalManager{
+		importEntriesFunc: func(ctx context.Context, entries []*domain.JournalEntry) (int64, error) {
+			imported = append(imported, entries...)
+			return int64(len(entries)), nil
+		},
+	}
+	service := NewJournalService(mockManager)
+
+	// recvChunks returns a recv func yielding data split into chunks, then io.EOF
+	recvChunks := func(chunks ...string) func() (*pb.ImportDayOneRequest, error) {
+		return func() (*pb.ImportDayOneRequest, error) {
+			if len(chunks) == 0 {
+				return nil, io.EOF
+			}
+			chunk := chunks[0]
+			chunks = chunks[1:]
+			return &pb.ImportDayOneRequest{Data: []byte(chunk)}, nil
+		}
+	}
+
+	t.Run("joins chunks", func(t *testing.T) {
+		resp, err := service.importDayOne(ctx, recvChunks(
+			`{"entries": [{"creationDate": "2019-07-05T08:00:00Z", `,
+			`"text": "Slow morning."}]}`,
+		))
+		if err != nil {
+			t.Fatalf("ImportDayOne failed: %v", err)
+		}
+		if resp.ImportedCount != 1 || len(imported) != 1 || imported[0].Content != "Slow morning." {
+			t.Errorf("Expected 1 imported entry, got %v and %v", resp, imported)
+		}
+	})
+
+	t.Run("invalid export", func(t *testing.T) {
+		_, err := service.importDayOne(ctx, recvChunks(`{"entries": [`))
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected InvalidArgument, got %v", err)
+		}
+	})
+}
+
+func TestManagerError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want codes.Code
+	}{
+		{"not found", fmt.Errorf("journal entry 1: %w", domain.ErrNotFound), codes.NotFound},
+		{"invalid input", fmt.Errorf("title cannot be empty: %w", domain.ErrValidation), codes.InvalidArgument},
+		{"failed precondition", fmt.Errorf("journal entry is sealed: %w", domain.ErrFailedPrecondition), codes.FailedPrecondition},
+		{"version conflict", fmt.Errorf("journal entry 1 is at version 2, not 1: %w", domain.ErrConflict), codes.Aborted},
+		{"unexpected", errors.New("database is locked"), codes.Internal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := managerError(context.Background(), tt.err, "failed to get entry: %v", tt.err)
+			if status.Code(err) != tt.want {
+				t.Errorf("Expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+
+	t.Run("cancelled client", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		err := managerError(ctx, errors.New("interrupted"), "failed to get entry: %v", errors.New("interrupted"))
+		if status.Code(err) != codes.Canceled {
+			t.Errorf("Expected Canceled, got %v", err)
+		}
+	})
+}
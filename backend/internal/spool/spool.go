@@ -0,0 +1,214 @@
+// Package spool queues entries captured from the command line in a local
+// file until they reach the server, so capturing works offline.
+package spool
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// Entry is a captured entry waiting to be sent.
+type Entry struct {
+	Title   string   `json:"title,omitempty"`
+	Content string   `json:"content"`
+	Tags    []string `json:"tags,omitempty"`
+	// CreatedAt is when the entry was captured, which it keeps once sent.
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Spool is a file of entries in JSON Lines format, oldest first. Changes
+// hold an exclusive lock on a file next to it, so captures running at the
+// same time do not lose each other's entries.
+type Spool struct {
+	path string
+}
+
+// NewSpool creates a new instance of Spool backed by the file at path,
+// which is created when the first entry is added.
+func NewSpool(path string) *Spool {
+	return &Spool{path: path}
+}
+
+// Path returns the path of the spool file.
+func (s *Spool) Path() string {
+	return s.path
+}
+
+// RejectedPath returns the path of the file entries the server rejected are
+// moved to, next to the spool file.
+func (s *Spool) RejectedPath() string {
+	ext := filepath.Ext(s.path)
+	return strings.TrimSuffix(s.path, ext) + ".rejected" + ext
+}
+
+// Add appends entry to the spool.
+func (s *Spool) Add(entry Entry) error {
+	unlock, err := s.lock()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	return appendEntries(s.path, []Entry{entry})
+}
+
+// Reject appends entries to the rejected file, where they are kept for the
+// user to fix instead of being sent again. They must still be removed from
+// the spool with Remove.
+func (s *Spool) Reject(entries []Entry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	unlock, err := s.lock()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	return appendEntries(s.RejectedPath(), entries)
+}
+
+// Pending returns the queued entries, oldest first.
+func (s *Spool) Pending() ([]Entry, error) {
+	unlock, err := s.lock()
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	return s.pending()
+}
+
+// Remove drops entries from the spool, keeping any added since they were
+// read. The spool file is deleted once it is empty.
+func (s *Spool) Remove(entries []Entry) error {
+	unlock, err := s.lock()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	// Entries are matched by their encoding, which includes the capture time
+	remove := make(map[string]int, len(entries))
+	for _, entry := range entries {
+		line, err := json.Marshal(entry)
+		if err != nil {
+			return fmt.Errorf("failed to encode entry: %w", err)
+		}
+		remove[string(line)]++
+	}
+
+	pending, err := s.pending()
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	for _, entry := range pending {
+		line, err := json.Marshal(entry)
+		if err != nil {
+			return fmt.Errorf("failed to encode entry: %w", err)
+		}
+		if remove[string(line)] > 0 {
+			remove[string(line)]--
+			continue
+		}
+		buf.Write(append(line, '\n'))
+	}
+
+	if buf.Len() == 0 {
+		if err := os.Remove(s.path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("failed to remove spool: %w", err)
+		}
+		return nil
+	}
+
+	// Replace the file in one step so a crash cannot lose queued entries
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, buf.Bytes(), 0o600); err != nil {
+		return fmt.Errorf("failed to write spool: %w", err)
+	}
+	if err := os.Rename(tmp, s.path); err != nil {
+		return fmt.Errorf("failed to replace spool: %w", err)
+	}
+	return nil
+}
+
+// pending reads the queued entries. The caller must hold the lock.
+func (s *Spool) pending() ([]Entry, error) {
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read spool: %w", err)
+	}
+
+	var entries []Entry
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(nil, len(data)+1)
+	for line := 1; scanner.Scan(); line++ {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		var entry Entry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return nil, fmt.Errorf("invalid entry on line %d of %s: %w", line, s.path, err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, scanner.Err()
+}
+
+// lock takes an exclusive lock on the spool, waiting for other processes to
+// release it, and returns a function that releases it. The lock is on a
+// separate file because Remove replaces the spool file.
+func (s *Spool) lock() (func(), error) {
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create spool directory: %w", err)
+	}
+	f, err := os.OpenFile(s.path+".lock", os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open spool lock: %w", err)
+	}
+	if err := syscall.Flock(int(f.Fd()), syscall.LOCK_EX); err != nil {
+		f.Close()
+		return nil, fmt.Errorf("failed to lock spool: %w", err)
+	}
+	return func() {
+		syscall.Flock(int(f.Fd()), syscall.LOCK_UN)
+		f.Close()
+	}, nil
+}
+
+// appendEntries appends entries to the JSON Lines file at path, creating it
+// if needed.
+func appendEntries(path string, entries []Entry) error {
+	var buf bytes.Buffer
+	for _, entry := range entries {
+		line, err := json.Marshal(entry)
+		if err != nil {
+			return fmt.Errorf("failed to encode entry: %w", err)
+		}
+		buf.Write(append(line, '\n'))
+	}
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	if _, err := f.Write(buf.Bytes()); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return f.Close()
+}
@@ -0,0 +1,129 @@
+package spool
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestSpool(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state", "spool.jsonl")
+	spool := NewSpool(path)
+	captured := time.Date(2024, 3, 1, 9, 30, 0, 0, time.UTC)
+
+	t.Run("empty until an entry is added", func(t *testing.T) {
+		entries, err := spool.Pending()
+		if err != nil {
+			t.Fatalf("Pending failed: %v", err)
+		}
+		if len(entries) != 0 {
+			t.Errorf("Expected no entries, got %v", entries)
+		}
+	})
+
+	for _, content := range []string{"first", "second", "third"} {
+		if err := spool.Add(Entry{Content: content, Tags: []string{"work"}, CreatedAt: captured}); err != nil {
+			t.Fatalf("Add failed: %v", err)
+		}
+	}
+
+	t.Run("keeps entries in order", func(t *testing.T) {
+		entries, err := spool.Pending()
+		if err != nil {
+			t.Fatalf("Pending failed: %v", err)
+		}
+		if len(entries) != 3 || entries[0].Content != "first" || entries[2].Content != "third" {
+			t.Fatalf("Expected 3 entries in order, got %+v", entries)
+		}
+		if !entries[0].CreatedAt.Equal(captured) || !reflect.DeepEqual(entries[0].Tags, []string{"work"}) {
+			t.Errorf("Expected capture time and tags to round-trip, got %+v", entries[0])
+		}
+		if info, err := os.Stat(path); err != nil || info.Mode().Perm() != 0o600 {
+			t.Errorf("Expected a private spool file, got %v, %v", info, err)
+		}
+	})
+
+	t.Run("removes the given entries", func(t *testing.T) {
+		entries, _ := spool.Pending()
+		if err := spool.Remove(entries[:2]); err != nil {
+			t.Fatalf("Remove failed: %v", err)
+		}
+		entries, _ = spool.Pending()
+		if len(entries) != 1 || entries[0].Content != "third" {
+			t.Errorf("Expected only the third entry, got %+v", entries)
+		}
+	})
+
+	t.Run("keeps entries added since they were read", func(t *testing.T) {
+		entries, _ := spool.Pending()
+		if err := spool.Add(Entry{Content: "fourth", CreatedAt: captured}); err != nil {
+			t.Fatalf("Add failed: %v", err)
+		}
+		if err := spool.Remove(entries); err != nil {
+			t.Fatalf("Remove failed: %v", err)
+		}
+		entries, _ = spool.Pending()
+		if len(entries) != 1 || entries[0].Content != "fourth" {
+			t.Errorf("Expected only the fourth entry, got %+v", entries)
+		}
+	})
+
+	t.Run("moves rejected entries aside", func(t *testing.T) {
+		entries, _ := spool.Pending()
+		if err := spool.Reject(entries); err != nil {
+			t.Fatalf("Reject failed: %v", err)
+		}
+		rejected, err := NewSpool(spool.RejectedPath()).Pending()
+		if err != nil || len(rejected) != 1 || rejected[0].Content != "fourth" {
+			t.Errorf("Expected the fourth entry in %s, got %+v (%v)", spool.RejectedPath(), rejected, err)
+		}
+	})
+
+	t.Run("deletes the file once empty", func(t *testing.T) {
+		entries, _ := spool.Pending()
+		if err := spool.Remove(entries); err != nil {
+			t.Fatalf("Remove failed: %v", err)
+		}
+		if _, err := os.Stat(path); !os.IsNotExist(err) {
+			t.Errorf("Expected the spool file to be deleted, got %v", err)
+		}
+	})
+
+	t.Run("concurrent adds are kept", func(t *testing.T) {
+		var wg sync.WaitGroup
+		for i := range 20 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if err := spool.Add(Entry{Content: fmt.Sprint(i), CreatedAt: captured}); err != nil {
+					t.Errorf("Add failed: %v", err)
+				}
+				if i%5 == 0 {
+					if err := spool.Remove(nil); err != nil {
+						t.Errorf("Remove failed: %v", err)
+					}
+				}
+			}()
+		}
+		wg.Wait()
+
+		entries, err := spool.Pending()
+		if err != nil || len(entries) != 20 {
+			t.Errorf("Expected 20 entries, got %d (%v)", len(entries), err)
+		}
+		spool.Remove(entries)
+	})
+
+	t.Run("reports a corrupt line", func(t *testing.T) {
+		if err := os.WriteFile(path, []byte("{not json}\n"), 0o600); err != nil {
+			t.Fatalf("WriteFile failed: %v", err)
+		}
+		if _, err := spool.Pending(); err == nil {
+			t.Error("Expected error for a corrupt spool, got nil")
+		}
+	})
+}
@@ -0,0 +1,233 @@
+package store
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/parkernilson/micro-journal/internal/domain"
+)
+
+// genesisHash is the prev_hash of the first record in the archive.
+var genesisHash = strings.Repeat("0", 64)
+
+// archiveRecord is the hashed form of an entry_archive row. Its JSON encoding
+// is what the hash covers, so the field order must not change.
+type archiveRecord struct {
+	EntryID    int64   `json:"entry_id"`
+	Title      string  `json:"title"`
+	Content    string  `json:"content"`
+	Document   *string `json:"document"`
+	RecordedAt string  `json:"recorded_at"`
+	PrevHash   string  `json:"prev_hash"`
+	// Deleted marks a tombstone recording that the entry was purged. It is
+	// omitted when false so records written before it existed keep their
+	// hashes.
+	Deleted bool `json:"deleted,omitempty"`
+}
+
+// hash returns the hex SHA-256 of the record.
+func (r *archiveRecord) hash() (string, error) {
+	data, err := json.Marshal(r)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode archive record: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// appendArchive records the current version of an entry in the archive, if
+// the archive is enabled. It must run in the transaction that wrote the
+// version so the record cannot be skipped or reordered.
+func (s *JournalStore) appendArchive(ctx context.Context, tx *sql.Tx, id int64) error {
+	if !s.archive {
+		return nil
+	}
+
+	record := &archiveRecord{EntryID: id}
+
+	var document sql.NullString
+	query := `SELECT title, content, document FROM journal_entries WHERE id = ?`
+	if err := tx.QueryRowContext(ctx, query, id).Scan(&record.Title, &record.Content, &document); err != nil {
+		return fmt.Errorf("failed to read journal entry for archive: %w", err)
+	}
+	if document.Valid {
+		record.Document = &document.String
+	}
+
+	return appendRecord(ctx, tx, record)
+}
+
+// appendTombstones records in the archive that the entries ids were purged,
+// if the archive is enabled. It must run in the transaction that purged them.
+func (s *JournalStore) appendTombstones(ctx context.Context, tx *sql.Tx, ids ...int64) error {
+	if !s.archive {
+		return nil
+	}
+
+	for _, id := range ids {
+		if err := appendRecord(ctx, tx, &archiveRecord{EntryID: id, Deleted: true}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// appendRecord links record to the head of the archive and inserts it.
+func appendRecord(ctx context.Context, tx *sql.Tx, record *archiveRecord) error {
+	record.RecordedAt = formatTimestamp(time.Now())
+
+	err := tx.QueryRowContext(ctx, `SELECT hash FROM entry_archive ORDER BY seq DESC LIMIT 1`).Scan(&record.PrevHash)
+	if err == sql.ErrNoRows {
+		record.PrevHash = genesisHash
+	} else if err != nil {
+		return fmt.Errorf("failed to get archive head: %w", err)
+	}
+
+	hash, err := record.hash()
+	if err != nil {
+		return err
+	}
+
+	insertQuery := `
+		INSERT INTO entry_archive (entry_id, title, content, document, recorded_at, prev_hash, deleted, hash)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+	`
+	_, err = tx.ExecContext(ctx, insertQuery, record.EntryID, record.Title, record.Content, record.Document, record.RecordedAt, record.PrevHash, record.Deleted, hash)
+	if err != nil {
+		return fmt.Errorf("failed to append to archive: %w", err)
+	}
+
+	return nil
+}
+
+// VerifyArchive recomputes the archive's hash chain and checks that every
+// archived entry still matches its newest archived version, and that every
+// archived entry that is gone was purged. Entries written while the archive
+// was disabled are not checked.
+func (s *JournalStore) VerifyArchive(ctx context.Context) (*domain.ArchiveReport, error) {
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	report := &domain.ArchiveReport{}
+	latest, err := verifyChain(ctx, tx, report)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.QueryContext(ctx, `SELECT id, title, content, document FROM journal_entries ORDER BY id`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query journal entries: %w", err)
+	}
+	defer rows.Close()
+
+	present := map[int64]bool{}
+	for rows.Next() {
+		var id int64
+		var title, content string
+		var document sql.NullString
+		if err := rows.Scan(&id, &title, &content, &document); err != nil {
+			return nil, fmt.Errorf("failed to scan journal entry: %w", err)
+		}
+
+		present[id] = true
+
+		record, ok := latest[id]
+		if !ok {
+			continue
+		}
+		if record.Deleted {
+			report.Problems = append(report.Problems, fmt.Sprintf("entry %d was purged but is present", id))
+			continue
+		}
+		if record.Title != title || record.Content != content || !sameDocument(record.Document, document) {
+			report.Problems = append(report.Problems, fmt.Sprintf("entry %d differs from its archived version", id))
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	ids := make([]int64, 0, len(latest))
+	for id := range latest {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	for _, id := range ids {
+		if !present[id] && !latest[id].Deleted {
+			report.Problems = append(report.Problems, fmt.Sprintf("entry %d was removed without being purged", id))
+		}
+	}
+
+	return report, nil
+}
+
+// verifyChain walks the archive in order, recording broken links and altered
+// records in report. Returns the newest record of each archived entry.
+func verifyChain(ctx context.Context, q querier, report *domain.ArchiveReport) (map[int64]*archiveRecord, error) {
+	query := `
+		SELECT seq, entry_id, title, content, document, recorded_at, prev_hash, deleted, hash
+		FROM entry_archive
+		ORDER BY seq
+	`
+	rows, err := q.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query archive: %w", err)
+	}
+	defer rows.Close()
+
+	latest := map[int64]*archiveRecord{}
+	head := genesisHash
+	for rows.Next() {
+		var seq int64
+		var storedHash string
+		var document sql.NullString
+		record := &archiveRecord{}
+		if err := rows.Scan(&seq, &record.EntryID, &record.Title, &record.Content, &document, &record.RecordedAt, &record.PrevHash, &record.Deleted, &storedHash); err != nil {
+			return nil, fmt.Errorf("failed to scan archive record: %w", err)
+		}
+		if document.Valid {
+			record.Document = &document.String
+		}
+
+		if record.PrevHash != head {
+			report.Problems = append(report.Problems, fmt.Sprintf("archive record %d does not follow the record before it", seq))
+		}
+		hash, err := record.hash()
+		if err != nil {
+			return nil, err
+		}
+		if hash != storedHash {
+			report.Problems = append(report.Problems, fmt.Sprintf("archive record %d has been altered", seq))
+		}
+
+		head = storedHash
+		latest[record.EntryID] = record
+		report.RecordCount++
+		report.HeadHash = storedHash
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return latest, nil
+}
+
+// sameDocument reports whether an archived document matches a stored one.
+func sameDocument(archived *string, stored sql.NullString) bool {
+	if archived == nil {
+		return !stored.Valid
+	}
+	return stored.Valid && *archived == stored.String
+}
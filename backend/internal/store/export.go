@@ -0,0 +1,77 @@
+package store
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/parkernilson/micro-journal/internal/domain"
+)
+
+// exportBatchSize is the number of entries read per query while exporting.
+const exportBatchSize = 100
+
+// Export calls fn for every entry that is not in the trash, oldest first,
+// stopping at the first error fn returns or when ctx is done.
+// Entries are read in batches and no query is open while fn runs, so fn may
+// use the store and a slow consumer does not hold a read open.
+func (s *JournalStore) Export(ctx context.Context, fn func(entry *domain.JournalEntry) error) error {
+	query := `
+		SELECT ` + entryColumns + `
+		FROM journal_entries
+		WHERE deleted_at IS NULL AND (created_at, id) > (?, ?)
+		ORDER BY created_at, id
+		LIMIT ?
+	`
+
+	lastCreatedAt, lastID := "", int64(0)
+	for {
+		entries, err := s.exportBatch(ctx, query, lastCreatedAt, lastID)
+		if err != nil {
+			return err
+		}
+
+		for _, entry := range entries {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := fn(entry); err != nil {
+				return err
+			}
+		}
+
+		if len(entries) < exportBatchSize {
+			return nil
+		}
+		last := entries[len(entries)-1]
+		lastCreatedAt, lastID = formatTimestamp(last.CreatedAt), last.ID
+	}
+}
+
+// exportBatch reads the batch of entries following the given position.
+func (s *JournalStore) exportBatch(ctx context.Context, query, createdAt string, id int64) ([]*domain.JournalEntry, error) {
+	rows, err := s.db.QueryContext(ctx, query, createdAt, id, exportBatchSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query journal entries: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []*domain.JournalEntry
+	for rows.Next() {
+		entry, err := scanEntry(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan journal entry: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+	rows.Close()
+
+	if err := loadTags(ctx, s.db, entries); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
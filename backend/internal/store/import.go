@@ -0,0 +1,56 @@
+package store
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/parkernilson/micro-journal/internal/domain"
+)
+
+// Import inserts entries in one transaction, keeping each entry's CreatedAt,
+// RevealAt, Document, and Tags. Tags must already be normalized.
+// An entry is skipped as a duplicate if an entry with the same created_at,
+// title, and content already exists, including in the trash, so an import
+// can be retried without bringing back entries that were deleted since.
+// Returns the number of entries inserted.
+func (s *JournalStore) Import(ctx context.Context, entries []*domain.JournalEntry) (int64, error) {
+	existsQuery := `
+		SELECT EXISTS (
+			SELECT 1 FROM journal_entries
+			WHERE created_at = ? AND title = ? AND content = ?
+		)
+	`
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var imported int64
+	for _, entry := range entries {
+		var exists bool
+		err := tx.QueryRowContext(ctx, existsQuery, formatTimestamp(entry.CreatedAt), entry.Title, entry.Content).Scan(&exists)
+		if err != nil {
+			return 0, fmt.Errorf("failed to check for duplicate journal entry: %w", err)
+		}
+		if exists {
+			continue
+		}
+
+		document, err := marshalDocument(entry.Document)
+		if err != nil {
+			return 0, err
+		}
+		if _, err := s.createWithTimestamp(ctx, tx, entry.Title, entry.Content, entry.CreatedAt, entry.RevealAt, document, entry.Tags); err != nil {
+			return 0, err
+		}
+		imported++
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return imported, nil
+}
@@ -3,98 +3,268 @@ package store
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/parkernilson/micro-journal/internal/domain"
 )
 
+// timestampLayout is the format timestamps are stored in: RFC 3339 in UTC
+// with fixed millisecond precision, so lexical order matches time order.
+const timestampLayout = "2006-01-02T15:04:05.000Z07:00"
+
+// entryColumns is the column list scanned by scanEntry.
+const entryColumns = "id, title, content, created_at, updated_at, version, reveal_at, document, deleted_at"
+
+// querier is satisfied by both *sql.DB and *sql.Tx, so reads can run either
+// standalone or inside the transaction that performed a write.
+type querier interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// execer is satisfied by both *sql.DB and *sql.Tx, so writes that need no
+// transaction of their own can also run inside one.
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
 // JournalStore handles data access operations for journal entries.
+//
+// Deleted entries are moved to the trash: they are hidden from every read
+// except ListTrash until they are restored or purged.
+//
+// Writes are read-after-write consistent: Create and Update read the row back
+// inside the same transaction that wrote it, so the returned entry is exactly
+// what later reads will observe.
+//
+// With the archive enabled, every version an entry is created or updated to
+// is also appended to the hash-chained entry archive in the same transaction.
 type JournalStore struct {
-	db *sql.DB
+	db      *sql.DB
+	archive bool
+}
+
+// Option configures a JournalStore.
+type Option func(*JournalStore)
+
+// WithArchive enables appending every entry version to the entry archive.
+func WithArchive() Option {
+	return func(s *JournalStore) {
+		s.archive = true
+	}
 }
 
 // NewJournalStore creates a new instance of JournalStore.
-func NewJournalStore(db *sql.DB) *JournalStore {
-	return &JournalStore{db: db}
+func NewJournalStore(db *sql.DB, opts ...Option) *JournalStore {
+	s := &JournalStore{db: db}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 // Create inserts a new journal entry into the database.
-func (s *JournalStore) Create(ctx context.Context, title, content string) (*domain.JournalEntry, error) {
+// A zero revealAt stores an entry that is readable immediately, and a nil doc
+// stores a plain-text entry. tags must already be normalized.
+// The insert and the read of the created row happen in one transaction.
+func (s *JournalStore) Create(ctx context.Context, title, content string, revealAt time.Time, doc *domain.Document, tags []string) (*domain.JournalEntry, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	entry, err := s.create(ctx, tx, title, content, revealAt, doc, tags)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return entry, nil
+}
+
+// create inserts a new journal entry using tx and reads it back.
+func (s *JournalStore) create(ctx context.Context, tx *sql.Tx, title, content string, revealAt time.Time, doc *domain.Document, tags []string) (*domain.JournalEntry, error) {
+	document, err := marshalDocument(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := s.createWithTimestamp(ctx, tx, title, content, time.Now(), revealAt, document, tags)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch the created entry to get accurate timestamps
+	return getByID(ctx, tx, id)
+}
+
+// createWithTimestamp inserts an entry written at createdAt, sets its tags,
+// and archives it. Returns the new entry's ID.
+func (s *JournalStore) createWithTimestamp(ctx context.Context, tx *sql.Tx, title, content string, createdAt, revealAt time.Time, document sql.NullString, tags []string) (int64, error) {
 	query := `
-		INSERT INTO journal_entries (title, content, created_at, updated_at)
-		VALUES (?, ?, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+		INSERT INTO journal_entries (title, content, created_at, updated_at, reveal_at, document)
+		VALUES (?, ?, ?, ?, ?, ?)
 	`
+	created := formatTimestamp(createdAt)
 
-	result, err := s.db.ExecContext(ctx, query, title, content)
+	result, err := tx.ExecContext(ctx, query, title, content, created, created, nullTimestamp(revealAt), document)
 	if err != nil {
-		return nil, fmt.Errorf("failed to insert journal entry: %w", err)
+		return 0, fmt.Errorf("failed to insert journal entry: %w", err)
 	}
 
 	id, err := result.LastInsertId()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get last insert id: %w", err)
+		return 0, fmt.Errorf("failed to get last insert id: %w", err)
 	}
 
-	// Fetch the created entry to get accurate timestamps
-	return s.GetByID(ctx, id)
+	if err := setTags(ctx, tx, id, tags); err != nil {
+		return 0, err
+	}
+
+	if err := s.appendArchive(ctx, tx, id); err != nil {
+		return 0, err
+	}
+
+	return id, nil
 }
 
 // GetByID retrieves a journal entry by its ID.
 func (s *JournalStore) GetByID(ctx context.Context, id int64) (*domain.JournalEntry, error) {
+	return getByID(ctx, s.db, id)
+}
+
+// getByID retrieves a journal entry that is not in the trash by its ID using
+// the given querier.
+func getByID(ctx context.Context, q querier, id int64) (*domain.JournalEntry, error) {
 	query := `
-		SELECT id, title, content, created_at, updated_at
+		SELECT ` + entryColumns + `
 		FROM journal_entries
-		WHERE id = ?
+		WHERE id = ? AND deleted_at IS NULL
 	`
 
-	entry := &domain.JournalEntry{}
-	err := s.db.QueryRowContext(ctx, query, id).Scan(
-		&entry.ID,
-		&entry.Title,
-		&entry.Content,
-		&entry.CreatedAt,
-		&entry.UpdatedAt,
-	)
-
+	entry, err := scanEntry(q.QueryRowContext(ctx, query, id))
 	if err == sql.ErrNoRows {
-		return nil, fmt.Errorf("journal entry not found: %d", id)
+		return nil, fmt.Errorf("journal entry %d: %w", id, domain.ErrNotFound)
 	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to get journal entry: %w", err)
 	}
 
+	if err := loadTags(ctx, q, []*domain.JournalEntry{entry}); err != nil {
+		return nil, err
+	}
+
 	return entry, nil
 }
 
-// Update modifies an existing journal entry.
-func (s *JournalStore) Update(ctx context.Context, id int64, title, content string) (*domain.JournalEntry, error) {
-	query := `
-		UPDATE journal_entries
-		SET title = ?, content = ?, updated_at = CURRENT_TIMESTAMP
-		WHERE id = ?
-	`
+// Update modifies an existing journal entry. A nil doc turns the entry back
+// into plain text, and tags replace the entry's existing tags. The previous
+// title, content, and document are saved as a revision. A non-zero
+// expectedVersion must match the entry's version.
+// The update and the read of the modified row happen in one transaction.
+func (s *JournalStore) Update(ctx context.Context, id int64, title, content string, doc *domain.Document, tags []string, expectedVersion int64) (*domain.JournalEntry, error) {
+	document, err := marshalDocument(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := updateContent(ctx, tx, id, expectedVersion, title, content, document); err != nil {
+		return nil, err
+	}
+
+	if err := s.appendArchive(ctx, tx, id); err != nil {
+		return nil, err
+	}
+
+	if err := setTags(ctx, tx, id, tags); err != nil {
+		return nil, err
+	}
+
+	entry, err := getByID(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
 
-	result, err := s.db.ExecContext(ctx, query, title, content, id)
+	return entry, nil
+}
+
+// updateContent saves the current version of an entry as a revision and then
+// replaces its title, content, and document, incrementing its version. A
+// non-zero expectedVersion must match the entry's version.
+func updateContent(ctx context.Context, tx *sql.Tx, id, expectedVersion int64, title, content string, document sql.NullString) error {
+	saveQuery := `
+		INSERT INTO entry_revisions (entry_id, title, content, document, created_at)
+		SELECT id, title, content, document, updated_at
+		FROM journal_entries
+		WHERE id = ? AND deleted_at IS NULL AND (? = 0 OR version = ?)
+	`
+	result, err := tx.ExecContext(ctx, saveQuery, id, expectedVersion, expectedVersion)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update journal entry: %w", err)
+		return fmt.Errorf("failed to save journal entry revision: %w", err)
 	}
 
 	rows, err := result.RowsAffected()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get rows affected: %w", err)
+		return fmt.Errorf("failed to get rows affected: %w", err)
 	}
 	if rows == 0 {
-		return nil, fmt.Errorf("journal entry not found: %d", id)
+		return versionConflict(ctx, tx, id, expectedVersion)
 	}
 
-	return s.GetByID(ctx, id)
+	updateQuery := `
+		UPDATE journal_entries
+		SET title = ?, content = ?, document = ?, updated_at = ?, version = version + 1
+		WHERE id = ?
+	`
+	now := formatTimestamp(time.Now())
+	if _, err := tx.ExecContext(ctx, updateQuery, title, content, document, now, id); err != nil {
+		return fmt.Errorf("failed to update journal entry: %w", err)
+	}
+
+	return nil
 }
 
-// Delete removes a journal entry from the database.
+// versionConflict returns why an update of the entry with id matched no row:
+// the entry does not exist, or it is not at expectedVersion.
+func versionConflict(ctx context.Context, tx *sql.Tx, id, expectedVersion int64) error {
+	var version int64
+	err := tx.QueryRowContext(ctx, `SELECT version FROM journal_entries WHERE id = ? AND deleted_at IS NULL`, id).Scan(&version)
+	if err == sql.ErrNoRows {
+		return fmt.Errorf("journal entry %d: %w", id, domain.ErrNotFound)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get journal entry version: %w", err)
+	}
+	return fmt.Errorf("journal entry %d is at version %d, not %d: %w", id, version, expectedVersion, domain.ErrConflict)
+}
+
+// Delete moves a journal entry to the trash.
 func (s *JournalStore) Delete(ctx context.Context, id int64) error {
-	query := `DELETE FROM journal_entries WHERE id = ?`
+	return deleteEntry(ctx, s.db, id)
+}
+
+// deleteEntry moves a journal entry to the trash using the given execer.
+func deleteEntry(ctx context.Context, e execer, id int64) error {
+	query := `UPDATE journal_entries SET deleted_at = ? WHERE id = ? AND deleted_at IS NULL`
 
-	result, err := s.db.ExecContext(ctx, query, id)
+	result, err := e.ExecContext(ctx, query, formatTimestamp(time.Now()), id)
 	if err != nil {
 		return fmt.Errorf("failed to delete journal entry: %w", err)
 	}
@@ -104,32 +274,34 @@ func (s *JournalStore) Delete(ctx context.Context, id int64) error {
 		return fmt.Errorf("failed to get rows affected: %w", err)
 	}
 	if rows == 0 {
-		return fmt.Errorf("journal entry not found: %d", id)
+		return fmt.Errorf("journal entry %d: %w", id, domain.ErrNotFound)
 	}
 
 	return nil
 }
 
-// List retrieves journal entries with pagination.
-// Returns the entries and the total count of all entries.
-func (s *JournalStore) List(ctx context.Context, limit, offset int) ([]*domain.JournalEntry, int64, error) {
+// List retrieves journal entries matching filter with pagination.
+// Returns the entries and the total count of all matching entries.
+func (s *JournalStore) List(ctx context.Context, filter domain.EntryFilter, limit, offset int) ([]*domain.JournalEntry, int64, error) {
+	where, args := filterClause(filter)
+
 	// Get total count
 	var totalCount int64
-	countQuery := `SELECT COUNT(*) FROM journal_entries`
-	err := s.db.QueryRowContext(ctx, countQuery).Scan(&totalCount)
+	countQuery := `SELECT COUNT(*) FROM journal_entries` + where
+	err := s.db.QueryRowContext(ctx, countQuery, args...).Scan(&totalCount)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to count journal entries: %w", err)
 	}
 
 	// Get paginated entries
 	query := `
-		SELECT id, title, content, created_at, updated_at
-		FROM journal_entries
+		SELECT ` + entryColumns + `
+		FROM journal_entries` + where + `
 		ORDER BY created_at DESC, id DESC
 		LIMIT ? OFFSET ?
 	`
 
-	rows, err := s.db.QueryContext(ctx, query, limit, offset)
+	rows, err := s.db.QueryContext(ctx, query, append(args, limit, offset)...)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to query journal entries: %w", err)
 	}
@@ -137,14 +309,63 @@ func (s *JournalStore) List(ctx context.Context, limit, offset int) ([]*domain.J
 
 	var entries []*domain.JournalEntry
 	for rows.Next() {
-		entry := &domain.JournalEntry{}
-		err := rows.Scan(
-			&entry.ID,
-			&entry.Title,
-			&entry.Content,
-			&entry.CreatedAt,
-			&entry.UpdatedAt,
-		)
+		entry, err := scanEntry(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan journal entry: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	if err := loadTags(ctx, s.db, entries); err != nil {
+		return nil, 0, err
+	}
+
+	return entries, totalCount, nil
+}
+
+// Search retrieves journal entries matching an FTS5 query, best match first.
+// Title matches are weighted above content matches. Entries that are still
+// sealed are excluded so a match cannot reveal what they contain.
+// Returns the entries and the total count of all matching entries.
+func (s *JournalStore) Search(ctx context.Context, query string, limit, offset int) ([]*domain.JournalEntry, int64, error) {
+	matches := `
+		FROM journal_entries
+		JOIN (
+			SELECT rowid, bm25(journal_entries_fts, 10.0, 1.0) AS score
+			FROM journal_entries_fts
+			WHERE journal_entries_fts MATCH ?
+		) AS matches ON matches.rowid = journal_entries.id
+		WHERE deleted_at IS NULL AND (reveal_at IS NULL OR reveal_at <= ?)
+	`
+	now := formatTimestamp(time.Now())
+
+	// Get total count
+	var totalCount int64
+	err := s.db.QueryRowContext(ctx, `SELECT COUNT(*)`+matches, query, now).Scan(&totalCount)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count matching journal entries: %w", err)
+	}
+
+	// Get paginated entries
+	selectQuery := `
+		SELECT ` + entryColumns + matches + `
+		ORDER BY matches.score, created_at DESC, id DESC
+		LIMIT ? OFFSET ?
+	`
+
+	rows, err := s.db.QueryContext(ctx, selectQuery, query, now, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to search journal entries: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []*domain.JournalEntry
+	for rows.Next() {
+		entry, err := scanEntry(rows)
 		if err != nil {
 			return nil, 0, fmt.Errorf("failed to scan journal entry: %w", err)
 		}
@@ -155,5 +376,116 @@ func (s *JournalStore) List(ctx context.Context, limit, offset int) ([]*domain.J
 		return nil, 0, fmt.Errorf("error iterating rows: %w", err)
 	}
 
+	if err := loadTags(ctx, s.db, entries); err != nil {
+		return nil, 0, err
+	}
+
 	return entries, totalCount, nil
 }
+
+// filterClause builds a WHERE clause and its arguments for filter. Entries in
+// the trash never match.
+// Timestamps are stored in a fixed-width format, so they compare as text.
+func filterClause(filter domain.EntryFilter) (string, []any) {
+	conditions := []string{"deleted_at IS NULL"}
+	var args []any
+
+	if !filter.CreatedFrom.IsZero() {
+		conditions = append(conditions, "created_at >= ?")
+		args = append(args, formatTimestamp(filter.CreatedFrom))
+	}
+	if !filter.CreatedUntil.IsZero() {
+		conditions = append(conditions, "created_at < ?")
+		args = append(args, formatTimestamp(filter.CreatedUntil))
+	}
+	if filter.Tag != "" {
+		conditions = append(conditions, `id IN (
+			SELECT entry_tags.entry_id FROM entry_tags
+			JOIN tags ON tags.id = entry_tags.tag_id
+			WHERE tags.name = ?)`)
+		args = append(args, filter.Tag)
+	}
+
+	return "\n\t\tWHERE " + strings.Join(conditions, " AND "), args
+}
+
+// formatTimestamp formats t for storage in a timestamp column.
+func formatTimestamp(t time.Time) string {
+	return t.UTC().Format(timestampLayout)
+}
+
+// nullTimestamp formats t for a nullable timestamp column, mapping the zero
+// time to NULL.
+func nullTimestamp(t time.Time) sql.NullString {
+	if t.IsZero() {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: formatTimestamp(t), Valid: true}
+}
+
+// marshalDocument encodes doc for the document column, mapping nil to NULL.
+func marshalDocument(doc *domain.Document) (sql.NullString, error) {
+	if doc == nil {
+		return sql.NullString{}, nil
+	}
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return sql.NullString{}, fmt.Errorf("failed to encode document: %w", err)
+	}
+	return sql.NullString{String: string(data), Valid: true}, nil
+}
+
+// scanner is satisfied by both *sql.Row and *sql.Rows.
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+// scanEntry scans a journal_entries row selected with entryColumns.
+func scanEntry(row scanner) (*domain.JournalEntry, error) {
+	entry := &domain.JournalEntry{}
+	var createdAt, updatedAt string
+	var revealAt, document, deletedAt sql.NullString
+	err := row.Scan(
+		&entry.ID,
+		&entry.Title,
+		&entry.Content,
+		&createdAt,
+		&updatedAt,
+		&entry.Version,
+		&revealAt,
+		&document,
+		&deletedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	entry.CreatedAt, err = time.Parse(timestampLayout, createdAt)
+	if err != nil {
+		return nil, fmt.Errorf("invalid created_at %q: %w", createdAt, err)
+	}
+	entry.UpdatedAt, err = time.Parse(timestampLayout, updatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("invalid updated_at %q: %w", updatedAt, err)
+	}
+	if revealAt.Valid {
+		entry.RevealAt, err = time.Parse(timestampLayout, revealAt.String)
+		if err != nil {
+			return nil, fmt.Errorf("invalid reveal_at %q: %w", revealAt.String, err)
+		}
+	}
+	if deletedAt.Valid {
+		entry.DeletedAt, err = time.Parse(timestampLayout, deletedAt.String)
+		if err != nil {
+			return nil, fmt.Errorf("invalid deleted_at %q: %w", deletedAt.String, err)
+		}
+	}
+	if document.Valid {
+		entry.Document = &domain.Document{}
+		if err := json.Unmarshal([]byte(document.String), entry.Document); err != nil {
+			return nil, fmt.Errorf("invalid document: %w", err)
+		}
+	}
+
+	return entry, nil
+}
@@ -3,12 +3,20 @@ package store
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"reflect"
 	"testing"
+	"time"
 
 	_ "modernc.org/sqlite"
+
+	"github.com/parkernilson/micro-journal/internal/domain"
+	"github.com/parkernilson/micro-journal/migrations"
 )
 
-// setupTestDB creates an in-memory SQLite database with the schema initialized.
+// setupTestDB creates an in-memory SQLite database with all migrations applied.
 func setupTestDB(t *testing.T) *sql.DB {
 	t.Helper()
 
@@ -17,22 +25,11 @@ func setupTestDB(t *testing.T) *sql.DB {
 		t.Fatalf("failed to open in-memory database: %v", err)
 	}
 
-	// Create the schema
-	schema := `
-		CREATE TABLE journal_entries (
-			id INTEGER PRIMARY KEY AUTOINCREMENT,
-			title TEXT NOT NULL,
-			content TEXT NOT NULL,
-			created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
-			updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
-		);
-
-		CREATE INDEX idx_journal_entries_created_at ON journal_entries(created_at DESC);
-	`
+	// Each connection to :memory: is a separate database
+	db.SetMaxOpenConns(1)
 
-	_, err = db.Exec(schema)
-	if err != nil {
-		t.Fatalf("failed to create schema: %v", err)
+	if _, err := migrations.Apply(context.Background(), db); err != nil {
+		t.Fatalf("failed to apply migrations: %v", err)
 	}
 
 	return db
@@ -45,7 +42,7 @@ func TestJournalStore_Create(t *testing.T) {
 	store := NewJournalStore(db)
 	ctx := context.Background()
 
-	entry, err := store.Create(ctx, "Test Title", "Test Content")
+	entry, err := store.Create(ctx, "Test Title", "Test Content", time.Time{}, nil, nil)
 	if err != nil {
 		t.Fatalf("Create failed: %v", err)
 	}
@@ -67,6 +64,122 @@ func TestJournalStore_Create(t *testing.T) {
 	}
 }
 
+func TestJournalStore_Create_RejectsEmptyFields(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	store := NewJournalStore(db)
+	ctx := context.Background()
+
+	// The schema enforces non-empty title and content even without the manager
+	if _, err := store.Create(ctx, "", "Test Content", time.Time{}, nil, nil); err == nil {
+		t.Error("Expected error for empty title, got nil")
+	}
+	if _, err := store.Create(ctx, "Test Title", "", time.Time{}, nil, nil); err == nil {
+		t.Error("Expected error for empty content, got nil")
+	}
+}
+
+func TestJournalStore_Create_StoresRFC3339UTC(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	store := NewJournalStore(db)
+	ctx := context.Background()
+
+	created, err := store.Create(ctx, "Test Title", "Test Content", time.Time{}, nil, nil)
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	var createdAt, updatedAt string
+	err = db.QueryRow(`SELECT created_at, updated_at FROM journal_entries WHERE id = ?`, created.ID).Scan(&createdAt, &updatedAt)
+	if err != nil {
+		t.Fatalf("failed to read raw timestamps: %v", err)
+	}
+
+	for _, raw := range []string{createdAt, updatedAt} {
+		parsed, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			t.Errorf("Expected RFC 3339 timestamp, got '%s': %v", raw, err)
+			continue
+		}
+		if parsed.Location() != time.UTC {
+			t.Errorf("Expected UTC timestamp, got '%s'", raw)
+		}
+	}
+	if created.CreatedAt.Location() != time.UTC {
+		t.Errorf("Expected CreatedAt in UTC, got %v", created.CreatedAt.Location())
+	}
+}
+
+func TestJournalStore_Create_WithRevealAt(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	store := NewJournalStore(db)
+	ctx := context.Background()
+
+	revealAt := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	created, err := store.Create(ctx, "Dear Future Me", "Sealed Content", revealAt, nil, nil)
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if !created.RevealAt.Equal(revealAt) {
+		t.Errorf("Expected RevealAt %v, got %v", revealAt, created.RevealAt)
+	}
+
+	// The store returns content as-is; sealing is enforced by the manager
+	retrieved, err := store.GetByID(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("GetByID failed: %v", err)
+	}
+	if retrieved.Content != "Sealed Content" {
+		t.Errorf("Expected content 'Sealed Content', got '%s'", retrieved.Content)
+	}
+	if !retrieved.RevealAt.Equal(revealAt) {
+		t.Errorf("Expected RevealAt %v, got %v", revealAt, retrieved.RevealAt)
+	}
+}
+
+func TestJournalStore_Create_WithDocument(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	store := NewJournalStore(db)
+	ctx := context.Background()
+
+	doc := &domain.Document{
+		Version: domain.DocumentVersion,
+		Sections: []domain.Section{
+			{Type: domain.SectionText, Heading: "Today", Text: "A good day"},
+			{Type: domain.SectionChecklist, Items: []domain.ChecklistItem{{Text: "Run", Checked: true}}},
+			{Type: domain.SectionRating, Rating: 4, MaxRating: 5},
+		},
+	}
+	created, err := store.Create(ctx, "Structured", doc.PlainText(), time.Time{}, doc, nil)
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	retrieved, err := store.GetByID(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("GetByID failed: %v", err)
+	}
+	if !reflect.DeepEqual(retrieved.Document, doc) {
+		t.Errorf("Expected document %+v, got %+v", doc, retrieved.Document)
+	}
+
+	// Updating without a document turns the entry back into plain text
+	updated, err := store.Update(ctx, created.ID, "Plain", "Plain Content", nil, nil, 0)
+	if err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	if updated.Document != nil {
+		t.Errorf("Expected nil document, got %+v", updated.Document)
+	}
+}
+
 func TestJournalStore_GetByID(t *testing.T) {
 	db := setupTestDB(t)
 	defer db.Close()
@@ -75,7 +188,7 @@ func TestJournalStore_GetByID(t *testing.T) {
 	ctx := context.Background()
 
 	// Create an entry first
-	created, err := store.Create(ctx, "Test Title", "Test Content")
+	created, err := store.Create(ctx, "Test Title", "Test Content", time.Time{}, nil, nil)
 	if err != nil {
 		t.Fatalf("Create failed: %v", err)
 	}
@@ -105,8 +218,8 @@ func TestJournalStore_GetByID_NotFound(t *testing.T) {
 	ctx := context.Background()
 
 	_, err := store.GetByID(ctx, 999)
-	if err == nil {
-		t.Error("Expected error for non-existent ID, got nil")
+	if !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("Expected not found error for non-existent ID, got %v", err)
 	}
 }
 
@@ -118,13 +231,13 @@ func TestJournalStore_Update(t *testing.T) {
 	ctx := context.Background()
 
 	// Create an entry first
-	created, err := store.Create(ctx, "Original Title", "Original Content")
+	created, err := store.Create(ctx, "Original Title", "Original Content", time.Time{}, nil, nil)
 	if err != nil {
 		t.Fatalf("Create failed: %v", err)
 	}
 
 	// Update it
-	updated, err := store.Update(ctx, created.ID, "Updated Title", "Updated Content")
+	updated, err := store.Update(ctx, created.ID, "Updated Title", "Updated Content", nil, nil, 0)
 	if err != nil {
 		t.Fatalf("Update failed: %v", err)
 	}
@@ -143,6 +256,62 @@ func TestJournalStore_Update(t *testing.T) {
 	}
 }
 
+func TestJournalStore_Update_Version(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	store := NewJournalStore(db)
+	ctx := context.Background()
+
+	created, err := store.Create(ctx, "First Title", "First Content", time.Time{}, nil, nil)
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if created.Version != 1 {
+		t.Errorf("Expected version 1, got %d", created.Version)
+	}
+
+	updated, err := store.Update(ctx, created.ID, "Second Title", "Second Content", nil, nil, created.Version)
+	if err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	if updated.Version != 2 {
+		t.Errorf("Expected version 2, got %d", updated.Version)
+	}
+
+	// A client still holding version 1 must not overwrite the second version
+	_, err = store.Update(ctx, created.ID, "Stale Title", "Stale Content", nil, nil, created.Version)
+	if !errors.Is(err, domain.ErrConflict) {
+		t.Fatalf("Expected conflict for stale version, got %v", err)
+	}
+	got, err := store.GetByID(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("GetByID failed: %v", err)
+	}
+	if got.Title != "Second Title" || got.Version != 2 {
+		t.Errorf("Expected the second version to be kept, got %+v", got)
+	}
+	if _, total, _ := store.ListRevisions(ctx, created.ID, 10, 0); total != 1 {
+		t.Errorf("Expected no revision saved for the rejected update, got %d", total)
+	}
+
+	revisions, _, err := store.ListRevisions(ctx, created.ID, 10, 0)
+	if err != nil {
+		t.Fatalf("ListRevisions failed: %v", err)
+	}
+	restored, err := store.RestoreRevision(ctx, created.ID, revisions[0].ID)
+	if err != nil {
+		t.Fatalf("RestoreRevision failed: %v", err)
+	}
+	if restored.Version != 3 {
+		t.Errorf("Expected restoring a revision to increment the version to 3, got %d", restored.Version)
+	}
+
+	if _, err := store.Update(ctx, 999, "Title", "Content", nil, nil, 1); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("Expected not found error for non-existent ID, got %v", err)
+	}
+}
+
 func TestJournalStore_Update_NotFound(t *testing.T) {
 	db := setupTestDB(t)
 	defer db.Close()
@@ -150,9 +319,9 @@ func TestJournalStore_Update_NotFound(t *testing.T) {
 	store := NewJournalStore(db)
 	ctx := context.Background()
 
-	_, err := store.Update(ctx, 999, "Title", "Content")
-	if err == nil {
-		t.Error("Expected error for non-existent ID, got nil")
+	_, err := store.Update(ctx, 999, "Title", "Content", nil, nil, 0)
+	if !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("Expected not found error for non-existent ID, got %v", err)
 	}
 }
 
@@ -164,7 +333,7 @@ func TestJournalStore_Delete(t *testing.T) {
 	ctx := context.Background()
 
 	// Create an entry first
-	created, err := store.Create(ctx, "Test Title", "Test Content")
+	created, err := store.Create(ctx, "Test Title", "Test Content", time.Time{}, nil, nil)
 	if err != nil {
 		t.Fatalf("Create failed: %v", err)
 	}
@@ -190,9 +359,70 @@ func TestJournalStore_Delete_NotFound(t *testing.T) {
 	ctx := context.Background()
 
 	err := store.Delete(ctx, 999)
-	if err == nil {
-		t.Error("Expected error for non-existent ID, got nil")
+	if !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("Expected not found error for non-existent ID, got %v", err)
+	}
+}
+
+func TestJournalStore_WithTx(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	store := NewJournalStore(db)
+	ctx := context.Background()
+
+	existing, err := store.Create(ctx, "Existing", "Content", time.Time{}, nil, nil)
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
 	}
+
+	t.Run("commits when fn succeeds", func(t *testing.T) {
+		var created *domain.JournalEntry
+		err := store.WithTx(ctx, func(tx domain.JournalTx) error {
+			var err error
+			if created, err = tx.Create(ctx, "Batch", "Content", time.Time{}, nil, []string{"work"}); err != nil {
+				return err
+			}
+			if _, err := tx.GetByID(ctx, created.ID); err != nil {
+				return err
+			}
+			return tx.Delete(ctx, existing.ID)
+		})
+		if err != nil {
+			t.Fatalf("WithTx failed: %v", err)
+		}
+
+		if got, err := store.GetByID(ctx, created.ID); err != nil || !reflect.DeepEqual(got.Tags, []string{"work"}) {
+			t.Errorf("Expected the created entry, got %+v (%v)", got, err)
+		}
+		if _, err := store.GetByID(ctx, existing.ID); !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("Expected the deleted entry to be gone, got %v", err)
+		}
+	})
+
+	t.Run("rolls back when fn fails", func(t *testing.T) {
+		var created *domain.JournalEntry
+		err := store.WithTx(ctx, func(tx domain.JournalTx) error {
+			var err error
+			if created, err = tx.Create(ctx, "Rolled back", "Content", time.Time{}, nil, nil); err != nil {
+				return err
+			}
+			if err := tx.Purge(ctx, existing.ID); err != nil {
+				return err
+			}
+			return tx.Delete(ctx, 999)
+		})
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Fatalf("Expected not found error, got %v", err)
+		}
+
+		if _, err := store.GetByID(ctx, created.ID); !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("Expected the created entry to be rolled back, got %v", err)
+		}
+		if _, total, _ := store.ListTrash(ctx, 10, 0); total != 1 {
+			t.Errorf("Expected the purge to be rolled back, got %d entries in the trash", total)
+		}
+	})
 }
 
 func TestJournalStore_List(t *testing.T) {
@@ -204,14 +434,14 @@ func TestJournalStore_List(t *testing.T) {
 
 	// Create multiple entries
 	for i := 1; i <= 5; i++ {
-		_, err := store.Create(ctx, "Title "+string(rune('0'+i)), "Content "+string(rune('0'+i)))
+		_, err := store.Create(ctx, "Title "+string(rune('0'+i)), "Content "+string(rune('0'+i)), time.Time{}, nil, nil)
 		if err != nil {
 			t.Fatalf("Create failed: %v", err)
 		}
 	}
 
 	// Test pagination - first page
-	entries, total, err := store.List(ctx, 2, 0)
+	entries, total, err := store.List(ctx, domain.EntryFilter{}, 2, 0)
 	if err != nil {
 		t.Fatalf("List failed: %v", err)
 	}
@@ -224,7 +454,7 @@ func TestJournalStore_List(t *testing.T) {
 	}
 
 	// Test pagination - second page
-	entries, total, err = store.List(ctx, 2, 2)
+	entries, total, err = store.List(ctx, domain.EntryFilter{}, 2, 2)
 	if err != nil {
 		t.Fatalf("List failed: %v", err)
 	}
@@ -237,7 +467,7 @@ func TestJournalStore_List(t *testing.T) {
 	}
 
 	// Test pagination - last page
-	entries, total, err = store.List(ctx, 2, 4)
+	entries, total, err = store.List(ctx, domain.EntryFilter{}, 2, 4)
 	if err != nil {
 		t.Fatalf("List failed: %v", err)
 	}
@@ -250,6 +480,56 @@ func TestJournalStore_List(t *testing.T) {
 	}
 }
 
+func TestJournalStore_List_CreatedRange(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	store := NewJournalStore(db)
+	ctx := context.Background()
+
+	// Backdate entries to known days
+	for i, day := range []string{"2024-03-01", "2024-03-15", "2024-04-01"} {
+		created, err := store.Create(ctx, "Title "+string(rune('1'+i)), "Content", time.Time{}, nil, nil)
+		if err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+		_, err = db.Exec(`UPDATE journal_entries SET created_at = ? WHERE id = ?`, day+"T12:00:00.000Z", created.ID)
+		if err != nil {
+			t.Fatalf("failed to backdate entry: %v", err)
+		}
+	}
+
+	filter := domain.EntryFilter{
+		CreatedFrom:  time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		CreatedUntil: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC),
+	}
+	entries, total, err := store.List(ctx, filter, 10, 0)
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+
+	if total != 2 {
+		t.Errorf("Expected total count 2, got %d", total)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("Expected 2 entries, got %d", len(entries))
+	}
+	if entries[0].Title != "Title 2" || entries[1].Title != "Title 1" {
+		t.Errorf("Expected March entries newest first, got '%s' and '%s'", entries[0].Title, entries[1].Title)
+	}
+
+	// Bounds are compared in UTC regardless of the filter's location
+	loc := time.FixedZone("UTC+2", 2*60*60)
+	filter = domain.EntryFilter{CreatedFrom: time.Date(2024, 4, 1, 14, 0, 0, 0, loc)}
+	_, total, err = store.List(ctx, filter, 10, 0)
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if total != 1 {
+		t.Errorf("Expected total count 1, got %d", total)
+	}
+}
+
 func TestJournalStore_List_Empty(t *testing.T) {
 	db := setupTestDB(t)
 	defer db.Close()
@@ -257,7 +537,7 @@ func TestJournalStore_List_Empty(t *testing.T) {
 	store := NewJournalStore(db)
 	ctx := context.Background()
 
-	entries, total, err := store.List(ctx, 10, 0)
+	entries, total, err := store.List(ctx, domain.EntryFilter{}, 10, 0)
 	if err != nil {
 		t.Fatalf("List failed: %v", err)
 	}
@@ -278,12 +558,12 @@ func TestJournalStore_List_OrderedByCreatedAtDesc(t *testing.T) {
 	ctx := context.Background()
 
 	// Create entries in order
-	entry1, _ := store.Create(ctx, "First", "Content 1")
-	entry2, _ := store.Create(ctx, "Second", "Content 2")
-	entry3, _ := store.Create(ctx, "Third", "Content 3")
+	entry1, _ := store.Create(ctx, "First", "Content 1", time.Time{}, nil, nil)
+	entry2, _ := store.Create(ctx, "Second", "Content 2", time.Time{}, nil, nil)
+	entry3, _ := store.Create(ctx, "Third", "Content 3", time.Time{}, nil, nil)
 
 	// List should return in reverse order (newest first)
-	entries, _, err := store.List(ctx, 10, 0)
+	entries, _, err := store.List(ctx, domain.EntryFilter{}, 10, 0)
 	if err != nil {
 		t.Fatalf("List failed: %v", err)
 	}
@@ -303,3 +583,818 @@ func TestJournalStore_List_OrderedByCreatedAtDesc(t *testing.T) {
 		t.Errorf("Expected third entry to be entry1 (ID %d), got ID %d", entry1.ID, entries[2].ID)
 	}
 }
+
+func TestJournalStore_Create_ReadAfterWrite(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	store := NewJournalStore(db)
+	ctx := context.Background()
+
+	created, err := store.Create(ctx, "Test Title", "Test Content", time.Time{}, nil, []string{"morning", "work"})
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	// The entry returned by Create must match what subsequent reads observe
+	retrieved, err := store.GetByID(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("GetByID failed: %v", err)
+	}
+	if !reflect.DeepEqual(retrieved, created) {
+		t.Errorf("Expected GetByID to return %+v, got %+v", created, retrieved)
+	}
+
+	entries, total, err := store.List(ctx, domain.EntryFilter{}, 10, 0)
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if total != 1 || len(entries) != 1 {
+		t.Fatalf("Expected 1 entry, got %d (total %d)", len(entries), total)
+	}
+	if !reflect.DeepEqual(entries[0], created) {
+		t.Errorf("Expected List to return %+v, got %+v", created, entries[0])
+	}
+}
+
+func TestJournalStore_Update_ReadAfterWrite(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	store := NewJournalStore(db)
+	ctx := context.Background()
+
+	created, err := store.Create(ctx, "Original Title", "Original Content", time.Time{}, nil, nil)
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	updated, err := store.Update(ctx, created.ID, "Updated Title", "Updated Content", nil, nil, 0)
+	if err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+
+	retrieved, err := store.GetByID(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("GetByID failed: %v", err)
+	}
+	if !reflect.DeepEqual(retrieved, updated) {
+		t.Errorf("Expected GetByID to return %+v, got %+v", updated, retrieved)
+	}
+}
+
+func TestJournalStore_Search(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	store := NewJournalStore(db)
+	ctx := context.Background()
+
+	coffee, _ := store.Create(ctx, "Coffee", "Tried a new roast", time.Time{}, nil, nil)
+	morning, _ := store.Create(ctx, "Morning", "Walked to get coffee", time.Time{}, nil, nil)
+	store.Create(ctx, "Evening", "Read a book", time.Time{}, nil, nil)
+	store.Create(ctx, "Sealed", "Secret coffee plans", time.Now().Add(time.Hour), nil, nil)
+
+	t.Run("ranks title matches first and hides sealed entries", func(t *testing.T) {
+		entries, total, err := store.Search(ctx, `"coffee"`, 10, 0)
+		if err != nil {
+			t.Fatalf("Search failed: %v", err)
+		}
+		if total != 2 || len(entries) != 2 {
+			t.Fatalf("Expected 2 matches, got %d (total %d)", len(entries), total)
+		}
+		if entries[0].ID != coffee.ID || entries[1].ID != morning.ID {
+			t.Errorf("Expected entries [%d %d], got [%d %d]", coffee.ID, morning.ID, entries[0].ID, entries[1].ID)
+		}
+	})
+
+	t.Run("stemming and prefixes", func(t *testing.T) {
+		entries, _, err := store.Search(ctx, `"walking"`, 10, 0)
+		if err != nil {
+			t.Fatalf("Search failed: %v", err)
+		}
+		if len(entries) != 1 || entries[0].ID != morning.ID {
+			t.Errorf("Expected stemmed match on entry %d, got %v", morning.ID, entries)
+		}
+
+		entries, _, err = store.Search(ctx, `"boo"*`, 10, 0)
+		if err != nil {
+			t.Fatalf("Search failed: %v", err)
+		}
+		if len(entries) != 1 {
+			t.Errorf("Expected 1 prefix match, got %d", len(entries))
+		}
+	})
+
+	t.Run("index follows updates and deletes", func(t *testing.T) {
+		if _, err := store.Update(ctx, coffee.ID, "Tea", "Switched to tea", nil, nil, 0); err != nil {
+			t.Fatalf("Update failed: %v", err)
+		}
+		if err := store.Delete(ctx, morning.ID); err != nil {
+			t.Fatalf("Delete failed: %v", err)
+		}
+
+		_, total, err := store.Search(ctx, `"coffee"`, 10, 0)
+		if err != nil {
+			t.Fatalf("Search failed: %v", err)
+		}
+		if total != 0 {
+			t.Errorf("Expected no coffee matches, got %d", total)
+		}
+
+		entries, _, err := store.Search(ctx, `"tea"`, 10, 0)
+		if err != nil {
+			t.Fatalf("Search failed: %v", err)
+		}
+		if len(entries) != 1 || entries[0].ID != coffee.ID {
+			t.Errorf("Expected updated entry %d, got %v", coffee.ID, entries)
+		}
+	})
+}
+
+func TestJournalStore_Tags(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	store := NewJournalStore(db)
+	ctx := context.Background()
+
+	paris, err := store.Create(ctx, "Paris", "Content", time.Time{}, nil, []string{"travel", "work"})
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	rome, _ := store.Create(ctx, "Rome", "Content", time.Time{}, nil, []string{"travel"})
+	store.Create(ctx, "Home", "Content", time.Time{}, nil, nil)
+
+	t.Run("filter by tag", func(t *testing.T) {
+		entries, total, err := store.List(ctx, domain.EntryFilter{Tag: "travel"}, 10, 0)
+		if err != nil {
+			t.Fatalf("List failed: %v", err)
+		}
+		if total != 2 || len(entries) != 2 {
+			t.Fatalf("Expected 2 travel entries, got %d (total %d)", len(entries), total)
+		}
+		if !reflect.DeepEqual(entries[1].Tags, []string{"travel", "work"}) {
+			t.Errorf("Expected tags [travel work], got %v", entries[1].Tags)
+		}
+	})
+
+	t.Run("list tags", func(t *testing.T) {
+		tags, err := store.ListTags(ctx)
+		if err != nil {
+			t.Fatalf("ListTags failed: %v", err)
+		}
+		want := []*domain.Tag{{Name: "travel", EntryCount: 2}, {Name: "work", EntryCount: 1}}
+		if !reflect.DeepEqual(tags, want) {
+			t.Errorf("Expected %v, got %v", want, tags)
+		}
+	})
+
+	t.Run("rename merges into existing tag", func(t *testing.T) {
+		tag, err := store.RenameTag(ctx, "work", "travel")
+		if err != nil {
+			t.Fatalf("RenameTag failed: %v", err)
+		}
+		if tag.EntryCount != 2 {
+			t.Errorf("Expected merged tag with 2 entries, got %d", tag.EntryCount)
+		}
+
+		tag, err = store.RenameTag(ctx, "travel", "trips")
+		if err != nil {
+			t.Fatalf("RenameTag failed: %v", err)
+		}
+		if tag.Name != "trips" || tag.EntryCount != 2 {
+			t.Errorf("Expected trips (2), got %+v", tag)
+		}
+
+		if _, err := store.RenameTag(ctx, "missing", "other"); err == nil {
+			t.Error("Expected error for missing tag, got nil")
+		}
+	})
+
+	t.Run("unused tags are pruned", func(t *testing.T) {
+		if _, err := store.Update(ctx, rome.ID, "Rome", "Content", nil, []string{"italy"}, 0); err != nil {
+			t.Fatalf("Update failed: %v", err)
+		}
+		if err := store.Purge(ctx, paris.ID); err != nil {
+			t.Fatalf("Purge failed: %v", err)
+		}
+
+		tags, err := store.ListTags(ctx)
+		if err != nil {
+			t.Fatalf("ListTags failed: %v", err)
+		}
+		want := []*domain.Tag{{Name: "italy", EntryCount: 1}}
+		if !reflect.DeepEqual(tags, want) {
+			t.Errorf("Expected %v, got %v", want, tags)
+		}
+	})
+
+	t.Run("delete tag", func(t *testing.T) {
+		if err := store.DeleteTag(ctx, "italy"); err != nil {
+			t.Fatalf("DeleteTag failed: %v", err)
+		}
+
+		entry, err := store.GetByID(ctx, rome.ID)
+		if err != nil {
+			t.Fatalf("GetByID failed: %v", err)
+		}
+		if len(entry.Tags) != 0 {
+			t.Errorf("Expected no tags, got %v", entry.Tags)
+		}
+	})
+}
+
+func TestJournalStore_Trash(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	store := NewJournalStore(db)
+	ctx := context.Background()
+
+	kept, _ := store.Create(ctx, "Kept", "Content", time.Time{}, nil, []string{"travel"})
+	trashed, _ := store.Create(ctx, "Trashed", "Content", time.Time{}, nil, []string{"travel"})
+
+	if err := store.Delete(ctx, trashed.ID); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+
+	t.Run("trashed entries are hidden", func(t *testing.T) {
+		if _, err := store.GetByID(ctx, trashed.ID); err == nil {
+			t.Error("Expected error when retrieving trashed entry, got nil")
+		}
+		if _, err := store.Update(ctx, trashed.ID, "Title", "Content", nil, nil, 0); err == nil {
+			t.Error("Expected error when updating trashed entry, got nil")
+		}
+		if err := store.Delete(ctx, trashed.ID); err == nil {
+			t.Error("Expected error when deleting trashed entry again, got nil")
+		}
+
+		entries, total, err := store.List(ctx, domain.EntryFilter{}, 10, 0)
+		if err != nil {
+			t.Fatalf("List failed: %v", err)
+		}
+		if total != 1 || entries[0].ID != kept.ID {
+			t.Errorf("Expected only entry %d, got %v (total %d)", kept.ID, entries, total)
+		}
+
+		_, total, err = store.Search(ctx, `"trashed"`, 10, 0)
+		if err != nil {
+			t.Fatalf("Search failed: %v", err)
+		}
+		if total != 0 {
+			t.Errorf("Expected no search matches, got %d", total)
+		}
+
+		tags, err := store.ListTags(ctx)
+		if err != nil {
+			t.Fatalf("ListTags failed: %v", err)
+		}
+		if len(tags) != 1 || tags[0].EntryCount != 1 {
+			t.Errorf("Expected travel tag with 1 entry, got %v", tags)
+		}
+	})
+
+	t.Run("list trash", func(t *testing.T) {
+		entries, total, err := store.ListTrash(ctx, 10, 0)
+		if err != nil {
+			t.Fatalf("ListTrash failed: %v", err)
+		}
+		if total != 1 || len(entries) != 1 || entries[0].ID != trashed.ID {
+			t.Fatalf("Expected trashed entry %d, got %v (total %d)", trashed.ID, entries, total)
+		}
+		if entries[0].DeletedAt.IsZero() {
+			t.Error("Expected DeletedAt to be set")
+		}
+	})
+
+	t.Run("restore", func(t *testing.T) {
+		restored, err := store.Restore(ctx, trashed.ID)
+		if err != nil {
+			t.Fatalf("Restore failed: %v", err)
+		}
+		if !restored.DeletedAt.IsZero() || restored.Title != "Trashed" {
+			t.Errorf("Expected restored entry, got %+v", restored)
+		}
+		if _, err := store.Restore(ctx, trashed.ID); err == nil {
+			t.Error("Expected error when restoring entry not in trash, got nil")
+		}
+	})
+
+	t.Run("purge trash", func(t *testing.T) {
+		store.Delete(ctx, kept.ID)
+		store.Delete(ctx, trashed.ID)
+
+		purged, err := store.PurgeTrash(ctx, time.Now().Add(-time.Hour))
+		if err != nil {
+			t.Fatalf("PurgeTrash failed: %v", err)
+		}
+		if purged != 0 {
+			t.Errorf("Expected no entries purged, got %d", purged)
+		}
+
+		purged, err = store.PurgeTrash(ctx, time.Time{})
+		if err != nil {
+			t.Fatalf("PurgeTrash failed: %v", err)
+		}
+		if purged != 2 {
+			t.Errorf("Expected 2 entries purged, got %d", purged)
+		}
+
+		tags, err := store.ListTags(ctx)
+		if err != nil {
+			t.Fatalf("ListTags failed: %v", err)
+		}
+		if len(tags) != 0 {
+			t.Errorf("Expected unused tags to be pruned, got %v", tags)
+		}
+	})
+}
+
+func TestJournalStore_Revisions(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	store := NewJournalStore(db)
+	ctx := context.Background()
+
+	created, err := store.Create(ctx, "First Title", "First Content", time.Time{}, nil, nil)
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if _, err := store.Update(ctx, created.ID, "Second Title", "Second Content", nil, nil, 0); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	if _, err := store.Update(ctx, created.ID, "Third Title", "Third Content", nil, nil, 0); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+
+	revisions, total, err := store.ListRevisions(ctx, created.ID, 10, 0)
+	if err != nil {
+		t.Fatalf("ListRevisions failed: %v", err)
+	}
+	if total != 2 || len(revisions) != 2 {
+		t.Fatalf("Expected 2 revisions, got %d (total %d)", len(revisions), total)
+	}
+	if revisions[0].Title != "Second Title" || revisions[1].Title != "First Title" {
+		t.Errorf("Expected newest revision first, got %q then %q", revisions[0].Title, revisions[1].Title)
+	}
+	if !revisions[1].CreatedAt.Equal(created.UpdatedAt) {
+		t.Errorf("Expected first revision written at %v, got %v", created.UpdatedAt, revisions[1].CreatedAt)
+	}
+
+	restored, err := store.RestoreRevision(ctx, created.ID, revisions[1].ID)
+	if err != nil {
+		t.Fatalf("RestoreRevision failed: %v", err)
+	}
+	if restored.Title != "First Title" || restored.Content != "First Content" {
+		t.Errorf("Expected first version, got %q %q", restored.Title, restored.Content)
+	}
+
+	// The version replaced by the restore is saved too
+	_, total, err = store.ListRevisions(ctx, created.ID, 10, 0)
+	if err != nil {
+		t.Fatalf("ListRevisions failed: %v", err)
+	}
+	if total != 3 {
+		t.Errorf("Expected 3 revisions after restore, got %d", total)
+	}
+
+	if _, err := store.RestoreRevision(ctx, created.ID+1, revisions[0].ID); err == nil {
+		t.Error("Expected error for revision of another entry, got nil")
+	}
+
+	// Purging the entry removes its history
+	if err := store.Purge(ctx, created.ID); err != nil {
+		t.Fatalf("Purge failed: %v", err)
+	}
+	_, total, err = store.ListRevisions(ctx, created.ID, 10, 0)
+	if err != nil {
+		t.Fatalf("ListRevisions failed: %v", err)
+	}
+	if total != 0 {
+		t.Errorf("Expected no revisions after purge, got %d", total)
+	}
+}
+
+func TestJournalStore_Archive(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	store := NewJournalStore(db, WithArchive())
+	ctx := context.Background()
+
+	created, err := store.Create(ctx, "First Title", "First Content", time.Time{}, nil, nil)
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if _, err := store.Update(ctx, created.ID, "Second Title", "Second Content", nil, nil, 0); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+
+	report, err := store.VerifyArchive(ctx)
+	if err != nil {
+		t.Fatalf("VerifyArchive failed: %v", err)
+	}
+	if report.RecordCount != 2 || len(report.HeadHash) != 64 || len(report.Problems) != 0 {
+		t.Fatalf("Expected 2 clean records, got %+v", report)
+	}
+
+	// Archive records cannot be changed or removed through SQL
+	if _, err := db.ExecContext(ctx, `DELETE FROM entry_archive`); err == nil {
+		t.Error("Expected error deleting from archive, got nil")
+	}
+
+	t.Run("detects altered entry", func(t *testing.T) {
+		if _, err := db.ExecContext(ctx, `UPDATE journal_entries SET content = 'Forged' WHERE id = ?`, created.ID); err != nil {
+			t.Fatalf("failed to alter entry: %v", err)
+		}
+		report, err := store.VerifyArchive(ctx)
+		if err != nil {
+			t.Fatalf("VerifyArchive failed: %v", err)
+		}
+		if len(report.Problems) != 1 {
+			t.Errorf("Expected 1 problem, got %v", report.Problems)
+		}
+	})
+
+	t.Run("detects altered record", func(t *testing.T) {
+		if _, err := db.ExecContext(ctx, `DROP TRIGGER entry_archive_no_update`); err != nil {
+			t.Fatalf("failed to drop trigger: %v", err)
+		}
+		if _, err := db.ExecContext(ctx, `UPDATE entry_archive SET content = 'Forged' WHERE seq = 2`); err != nil {
+			t.Fatalf("failed to alter record: %v", err)
+		}
+		report, err := store.VerifyArchive(ctx)
+		if err != nil {
+			t.Fatalf("VerifyArchive failed: %v", err)
+		}
+		if len(report.Problems) != 1 || report.Problems[0] != "archive record 2 has been altered" {
+			t.Errorf("Expected altered record 2, got %v", report.Problems)
+		}
+	})
+}
+
+func TestJournalStore_Archive_Purge(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	store := NewJournalStore(db, WithArchive())
+	ctx := context.Background()
+
+	var ids []int64
+	for _, title := range []string{"Purged", "Trashed", "Removed"} {
+		entry, err := store.Create(ctx, title, "Content", time.Time{}, nil, nil)
+		if err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+		ids = append(ids, entry.ID)
+	}
+
+	if err := store.Purge(ctx, ids[0]); err != nil {
+		t.Fatalf("Purge failed: %v", err)
+	}
+	if err := store.Delete(ctx, ids[1]); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if _, err := store.PurgeTrash(ctx, time.Time{}); err != nil {
+		t.Fatalf("PurgeTrash failed: %v", err)
+	}
+
+	report, err := store.VerifyArchive(ctx)
+	if err != nil {
+		t.Fatalf("VerifyArchive failed: %v", err)
+	}
+	if report.RecordCount != 5 || len(report.Problems) != 0 {
+		t.Fatalf("Expected 5 clean records after purging, got %+v", report)
+	}
+
+	t.Run("detects entry removed out of band", func(t *testing.T) {
+		if _, err := db.ExecContext(ctx, `DELETE FROM journal_entries WHERE id = ?`, ids[2]); err != nil {
+			t.Fatalf("failed to remove entry: %v", err)
+		}
+		report, err := store.VerifyArchive(ctx)
+		if err != nil {
+			t.Fatalf("VerifyArchive failed: %v", err)
+		}
+		want := fmt.Sprintf("entry %d was removed without being purged", ids[2])
+		if len(report.Problems) != 1 || report.Problems[0] != want {
+			t.Errorf("Expected %q, got %v", want, report.Problems)
+		}
+	})
+}
+
+func TestJournalStore_Export(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	store := NewJournalStore(db)
+	ctx := context.Background()
+
+	// More than one batch, with a trashed entry that must be skipped
+	total := exportBatchSize + 5
+	for i := 0; i < total; i++ {
+		if _, err := store.Create(ctx, "Title", "Content", time.Time{}, nil, []string{"tag"}); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+	}
+	if err := store.Delete(ctx, 3); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+
+	var ids []int64
+	err := store.Export(ctx, func(entry *domain.JournalEntry) error {
+		if len(entry.Tags) != 1 {
+			t.Errorf("Expected tags on entry %d, got %v", entry.ID, entry.Tags)
+		}
+		ids = append(ids, entry.ID)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Export failed: %v", err)
+	}
+
+	if len(ids) != total-1 {
+		t.Fatalf("Expected %d entries, got %d", total-1, len(ids))
+	}
+	for i := 1; i < len(ids); i++ {
+		if ids[i] <= ids[i-1] || ids[i] == 3 {
+			t.Fatalf("Expected each entry once, oldest first, without the trashed entry; got %v", ids)
+		}
+	}
+}
+
+func TestJournalStore_Export_Cancelled(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	store := NewJournalStore(db)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	for i := 0; i < 3; i++ {
+		if _, err := store.Create(ctx, "Title", "Content", time.Time{}, nil, nil); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+	}
+
+	// The client goes away after the first entry
+	exported := 0
+	err := store.Export(ctx, func(entry *domain.JournalEntry) error {
+		exported++
+		cancel()
+		return nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+	if exported != 1 {
+		t.Errorf("Expected the export to stop after 1 entry, got %d", exported)
+	}
+}
+
+func TestJournalStore_Import_Cancelled(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	store := NewJournalStore(db)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	written := time.Date(2015, 6, 1, 8, 30, 0, 0, time.UTC)
+	_, err := store.Import(ctx, []*domain.JournalEntry{
+		{Title: "Old Entry", Content: "From the old journal", CreatedAt: written},
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+
+	_, total, err := store.List(context.Background(), domain.EntryFilter{}, 10, 0)
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if total != 0 {
+		t.Errorf("Expected nothing imported, got %d entries", total)
+	}
+}
+
+func TestJournalStore_Import(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	store := NewJournalStore(db)
+	ctx := context.Background()
+
+	written := time.Date(2015, 6, 1, 8, 30, 0, 0, time.UTC)
+	entries := []*domain.JournalEntry{
+		{Title: "Old Entry", Content: "From the old journal", CreatedAt: written, Tags: []string{"imported"}},
+		{Title: "Older Entry", Content: "Even older", CreatedAt: written.AddDate(-1, 0, 0)},
+		// Duplicate within the same batch
+		{Title: "Old Entry", Content: "From the old journal", CreatedAt: written},
+	}
+
+	imported, err := store.Import(ctx, entries)
+	if err != nil {
+		t.Fatalf("Import failed: %v", err)
+	}
+	if imported != 2 {
+		t.Errorf("Expected 2 entries imported, got %d", imported)
+	}
+
+	entry, err := store.GetByID(ctx, 1)
+	if err != nil {
+		t.Fatalf("GetByID failed: %v", err)
+	}
+	if !entry.CreatedAt.Equal(written) || !entry.UpdatedAt.Equal(written) {
+		t.Errorf("Expected original timestamps %v, got %v and %v", written, entry.CreatedAt, entry.UpdatedAt)
+	}
+	if !reflect.DeepEqual(entry.Tags, []string{"imported"}) {
+		t.Errorf("Expected tags [imported], got %v", entry.Tags)
+	}
+
+	// Retrying the import skips everything, even entries moved to the trash
+	if err := store.Delete(ctx, 2); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	imported, err = store.Import(ctx, entries)
+	if err != nil {
+		t.Fatalf("Import failed: %v", err)
+	}
+	if imported != 0 {
+		t.Errorf("Expected no entries imported on retry, got %d", imported)
+	}
+
+	// A failing entry rolls back the whole batch
+	_, err = store.Import(ctx, []*domain.JournalEntry{
+		{Title: "New Entry", Content: "Fine", CreatedAt: written.AddDate(1, 0, 0)},
+		{Title: "", Content: "No title", CreatedAt: written},
+	})
+	if err == nil {
+		t.Fatal("Expected error for entry without title, got nil")
+	}
+	_, total, err := store.List(ctx, domain.EntryFilter{}, 10, 0)
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if total != 1 {
+		t.Errorf("Expected the failed batch to be rolled back, got %d entries", total)
+	}
+}
+
+func TestJournalStore_SearchRevisions(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	store := NewJournalStore(db)
+	ctx := context.Background()
+
+	entry, err := store.Create(ctx, "Draft", "We walked out to the lighthouse at dusk", time.Time{}, nil, nil)
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if _, err := store.Update(ctx, entry.ID, "Draft", "We walked along the beach", nil, nil, 0); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	if _, err := store.Update(ctx, entry.ID, "Final", "A quiet evening", nil, nil, 0); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+
+	// Another entry's history must not match
+	other, err := store.Create(ctx, "Other", "The lighthouse again", time.Time{}, nil, nil)
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if _, err := store.Update(ctx, other.ID, "Other", "Edited", nil, nil, 0); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+
+	revisions, total, err := store.SearchRevisions(ctx, entry.ID, `"lighthouse"`, 10, 0)
+	if err != nil {
+		t.Fatalf("SearchRevisions failed: %v", err)
+	}
+	if total != 1 || len(revisions) != 1 {
+		t.Fatalf("Expected 1 matching revision, got %d (total %d)", len(revisions), total)
+	}
+	if revisions[0].EntryID != entry.ID || revisions[0].Content != "We walked out to the lighthouse at dusk" {
+		t.Errorf("Unexpected revision %+v", revisions[0])
+	}
+
+	// Stemming matches "walk" in both saved versions
+	_, total, err = store.SearchRevisions(ctx, entry.ID, `"walk"`, 10, 0)
+	if err != nil {
+		t.Fatalf("SearchRevisions failed: %v", err)
+	}
+	if total != 2 {
+		t.Errorf("Expected 2 revisions matching 'walk', got %d", total)
+	}
+}
+
+func TestOpen(t *testing.T) {
+	db, err := Open(filepath.Join(t.TempDir(), "journal.db"), 3*time.Second)
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	defer db.Close()
+
+	ctx := context.Background()
+	if _, err := migrations.Apply(ctx, db); err != nil {
+		t.Fatalf("failed to apply migrations: %v", err)
+	}
+
+	var journalMode string
+	var busyTimeout, foreignKeys int
+	if err := db.QueryRowContext(ctx, `PRAGMA journal_mode`).Scan(&journalMode); err != nil {
+		t.Fatalf("failed to read journal_mode: %v", err)
+	}
+	if err := db.QueryRowContext(ctx, `PRAGMA busy_timeout`).Scan(&busyTimeout); err != nil {
+		t.Fatalf("failed to read busy_timeout: %v", err)
+	}
+	if err := db.QueryRowContext(ctx, `PRAGMA foreign_keys`).Scan(&foreignKeys); err != nil {
+		t.Fatalf("failed to read foreign_keys: %v", err)
+	}
+	if journalMode != "wal" || busyTimeout != 3000 || foreignKeys != 1 {
+		t.Errorf("Unexpected journal_mode %q, busy_timeout %d, foreign_keys %d", journalMode, busyTimeout, foreignKeys)
+	}
+
+	t.Run("enforces foreign keys", func(t *testing.T) {
+		if _, err := db.ExecContext(ctx, `INSERT INTO entry_tags (entry_id, tag_id) VALUES (999, 999)`); err == nil {
+			t.Error("Expected foreign key error, got nil")
+		}
+	})
+}
+
+func TestSetSearchTokenizer(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	store := NewJournalStore(db)
+	ctx := context.Background()
+
+	entry, _ := store.Create(ctx, "Morning", "Walked to get coffee", time.Time{}, nil, nil)
+	store.Update(ctx, entry.ID, "Morning", "Walked to the café", nil, nil, 0)
+
+	search := func(query string) int {
+		t.Helper()
+		_, total, err := store.Search(ctx, query, 10, 0)
+		if err != nil {
+			t.Fatalf("Search failed: %v", err)
+		}
+		return int(total)
+	}
+
+	tokenizer, err := SearchTokenizer(ctx, db)
+	if err != nil {
+		t.Fatalf("SearchTokenizer failed: %v", err)
+	}
+	if tokenizer != "porter unicode61" {
+		t.Errorf("Expected the migrations' tokenizer, got %q", tokenizer)
+	}
+
+	t.Run("unchanged tokenizer is not rebuilt", func(t *testing.T) {
+		rebuilt, err := SetSearchTokenizer(ctx, db, "porter unicode61")
+		if err != nil {
+			t.Fatalf("SetSearchTokenizer failed: %v", err)
+		}
+		if rebuilt {
+			t.Error("Expected no rebuild")
+		}
+	})
+
+	t.Run("invalid tokenizer leaves the indexes", func(t *testing.T) {
+		if _, err := SetSearchTokenizer(ctx, db, "klingon"); err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+		if search(`"walking"`) != 1 {
+			t.Error("Expected the stemming index to still work")
+		}
+	})
+
+	t.Run("changed tokenizer rebuilds both indexes", func(t *testing.T) {
+		rebuilt, err := SetSearchTokenizer(ctx, db, "unicode61 remove_diacritics 2")
+		if err != nil {
+			t.Fatalf("SetSearchTokenizer failed: %v", err)
+		}
+		if !rebuilt {
+			t.Error("Expected a rebuild")
+		}
+
+		if search(`"walking"`) != 0 || search(`"walked"`) != 1 || search(`"cafe"`) != 1 {
+			t.Error("Expected unstemmed matches that ignore diacritics")
+		}
+		revisions, _, err := store.SearchRevisions(ctx, entry.ID, `"walked"`, 10, 0)
+		if err != nil || len(revisions) != 1 {
+			t.Errorf("Expected the revision index to be rebuilt, got %v, %v", revisions, err)
+		}
+
+		tokenizer, _ := SearchTokenizer(ctx, db)
+		if tokenizer != "unicode61 remove_diacritics 2" {
+			t.Errorf("Expected the new tokenizer, got %q", tokenizer)
+		}
+
+		// New entries are indexed by the triggers as before
+		store.Create(ctx, "Evening", "Walked home", time.Time{}, nil, nil)
+		if search(`"walked"`) != 2 {
+			t.Error("Expected new entries to be indexed")
+		}
+	})
+}
@@ -0,0 +1,229 @@
+package mdstore
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/parkernilson/micro-journal/internal/domain"
+)
+
+// timestampLayout is the format timestamps are written in, matching the
+// SQLite store's millisecond precision.
+const timestampLayout = "2006-01-02T15:04:05.000Z07:00"
+
+// frontMatter is the YAML front matter of an entry or revision file. The
+// file's body is the entry's content.
+type frontMatter struct {
+	ID        int64    `yaml:"id"`
+	EntryID   int64    `yaml:"entry_id,omitempty"`
+	Title     string   `yaml:"title"`
+	CreatedAt string   `yaml:"created_at"`
+	UpdatedAt string   `yaml:"updated_at,omitempty"`
+	Version   int64    `yaml:"version,omitempty"`
+	RevealAt  string   `yaml:"reveal_at,omitempty"`
+	DeletedAt string   `yaml:"deleted_at,omitempty"`
+	Tags      []string `yaml:"tags,omitempty,flow"`
+	// Document is the entry's structured content in its JSON form, written
+	// as YAML
+	Document any `yaml:"document,omitempty"`
+}
+
+// marshalEntry renders an entry file.
+func marshalEntry(entry *domain.JournalEntry) ([]byte, error) {
+	document, err := documentValue(entry.Document)
+	if err != nil {
+		return nil, err
+	}
+
+	return marshalFile(frontMatter{
+		ID:        entry.ID,
+		Title:     entry.Title,
+		CreatedAt: formatTimestamp(entry.CreatedAt),
+		UpdatedAt: formatTimestamp(entry.UpdatedAt),
+		Version:   entry.Version,
+		RevealAt:  formatTimestamp(entry.RevealAt),
+		DeletedAt: formatTimestamp(entry.DeletedAt),
+		Tags:      entry.Tags,
+		Document:  document,
+	}, entry.Content)
+}
+
+// marshalRevision renders a revision file.
+func marshalRevision(revision *domain.Revision) []byte {
+	// Revision documents were already encoded once when their entry was
+	// written, so this cannot fail
+	document, _ := documentValue(revision.Document)
+
+	data, _ := marshalFile(frontMatter{
+		ID:        revision.ID,
+		EntryID:   revision.EntryID,
+		Title:     revision.Title,
+		CreatedAt: formatTimestamp(revision.CreatedAt),
+		Document:  document,
+	}, revision.Content)
+	return data
+}
+
+// marshalFile renders front matter followed by content. A newline is added
+// after the content and removed again when the file is read.
+func marshalFile(meta frontMatter, content string) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString("---\n")
+
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(meta); err != nil {
+		return nil, fmt.Errorf("failed to encode front matter: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, fmt.Errorf("failed to encode front matter: %w", err)
+	}
+
+	buf.WriteString("---\n")
+	buf.WriteString(content)
+	buf.WriteString("\n")
+	return buf.Bytes(), nil
+}
+
+// readEntry reads an entry file. Entries in the trash must record when they
+// were deleted.
+func readEntry(path string, trashed bool) (*domain.JournalEntry, error) {
+	meta, content, err := readFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &domain.JournalEntry{ID: meta.ID, Title: meta.Title, Content: content, Version: max(meta.Version, 1), Tags: meta.Tags}
+	if entry.CreatedAt, err = parseTimestamp(meta.CreatedAt); err != nil || entry.CreatedAt.IsZero() {
+		return nil, fmt.Errorf("%s: invalid created_at %q", path, meta.CreatedAt)
+	}
+	if entry.UpdatedAt, err = parseTimestamp(meta.UpdatedAt); err != nil {
+		return nil, fmt.Errorf("%s: invalid updated_at %q", path, meta.UpdatedAt)
+	}
+	if entry.UpdatedAt.IsZero() {
+		entry.UpdatedAt = entry.CreatedAt
+	}
+	if entry.RevealAt, err = parseTimestamp(meta.RevealAt); err != nil {
+		return nil, fmt.Errorf("%s: invalid reveal_at %q", path, meta.RevealAt)
+	}
+	if trashed {
+		if entry.DeletedAt, err = parseTimestamp(meta.DeletedAt); err != nil || entry.DeletedAt.IsZero() {
+			return nil, fmt.Errorf("%s: invalid deleted_at %q", path, meta.DeletedAt)
+		}
+	}
+	if entry.Document, err = documentFromValue(meta.Document); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return entry, nil
+}
+
+// readRevision reads a revision file.
+func readRevision(path string) (*domain.Revision, error) {
+	meta, content, err := readFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	revision := &domain.Revision{ID: meta.ID, EntryID: meta.EntryID, Title: meta.Title, Content: content}
+	if revision.EntryID <= 0 {
+		return nil, fmt.Errorf("%s: missing entry_id", path)
+	}
+	if revision.CreatedAt, err = parseTimestamp(meta.CreatedAt); err != nil {
+		return nil, fmt.Errorf("%s: invalid created_at %q", path, meta.CreatedAt)
+	}
+	if revision.Document, err = documentFromValue(meta.Document); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return revision, nil
+}
+
+// readFile splits a file into its front matter and content.
+func readFile(path string) (*frontMatter, string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	rest, ok := strings.CutPrefix(string(data), "---\n")
+	if !ok {
+		return nil, "", fmt.Errorf("%s: missing front matter", path)
+	}
+	header, content, ok := strings.Cut(rest, "\n---\n")
+	if !ok {
+		return nil, "", fmt.Errorf("%s: unterminated front matter", path)
+	}
+
+	meta := &frontMatter{}
+	if err := yaml.Unmarshal([]byte(header), meta); err != nil {
+		return nil, "", fmt.Errorf("%s: invalid front matter: %w", path, err)
+	}
+	if meta.ID <= 0 {
+		return nil, "", fmt.Errorf("%s: missing id", path)
+	}
+
+	return meta, strings.TrimSuffix(content, "\n"), nil
+}
+
+// documentValue converts doc to the generic form of its JSON encoding, so it
+// is written to the front matter with the same field names as in JSON.
+func documentValue(doc *domain.Document) (any, error) {
+	if doc == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode document: %w", err)
+	}
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, fmt.Errorf("failed to encode document: %w", err)
+	}
+	return value, nil
+}
+
+// documentFromValue converts a document read from front matter back into a
+// Document.
+func documentFromValue(value any) (*domain.Document, error) {
+	if value == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid document: %w", err)
+	}
+	doc := &domain.Document{}
+	if err := json.Unmarshal(data, doc); err != nil {
+		return nil, fmt.Errorf("invalid document: %w", err)
+	}
+	return doc, nil
+}
+
+// formatTimestamp formats t for the front matter, mapping the zero time to
+// an empty string.
+func formatTimestamp(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(timestampLayout)
+}
+
+// parseTimestamp parses an RFC 3339 timestamp from the front matter, mapping
+// an empty string to the zero time.
+func parseTimestamp(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return t.UTC().Truncate(time.Millisecond), nil
+}
@@ -0,0 +1,182 @@
+package mdstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+const (
+	// commitAuthor and commitEmail sign the commits the store makes
+	commitAuthor = "Micro Journal"
+	commitEmail  = "micro-journal@localhost"
+	// entryTrailer prefixes the commit message lines naming the entries a
+	// commit changed
+	entryTrailer = "Entry: "
+)
+
+// gitRepo commits the journal files to a git repository in the journal
+// directory and remembers the newest commit that changed each entry.
+type gitRepo struct {
+	repo   *git.Repository
+	remote string
+	// commits maps entry IDs to the hash of the newest commit that changed
+	// them
+	commits map[int64]string
+}
+
+// openGit opens the git repository in dir, creating it if there is none,
+// and reads which commit last changed each entry from its history.
+func openGit(dir, remote string) (*gitRepo, error) {
+	repo, err := git.PlainOpen(dir)
+	if errors.Is(err, git.ErrRepositoryNotExists) {
+		repo, err = git.PlainInit(dir, false)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open git repository: %w", err)
+	}
+
+	g := &gitRepo{repo: repo, remote: remote, commits: map[int64]string{}}
+	if err := g.loadCommits(); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// loadCommits walks the history from HEAD, newest first, recording the
+// first commit found for each entry named in a commit message trailer.
+func (g *gitRepo) loadCommits() error {
+	head, err := g.repo.Head()
+	if errors.Is(err, plumbing.ErrReferenceNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read git HEAD: %w", err)
+	}
+
+	commits, err := g.repo.Log(&git.LogOptions{From: head.Hash()})
+	if err != nil {
+		return fmt.Errorf("failed to read git history: %w", err)
+	}
+	return commits.ForEach(func(commit *object.Commit) error {
+		for _, id := range entryTrailers(commit.Message) {
+			if _, ok := g.commits[id]; !ok {
+				g.commits[id] = commit.Hash.String()
+			}
+		}
+		return nil
+	})
+}
+
+// commit stages every changed file and commits it with message, followed by
+// a trailer naming each entry whose files changed. Does nothing if no file
+// changed.
+func (g *gitRepo) commit(message string) error {
+	worktree, err := g.repo.Worktree()
+	if err != nil {
+		return fmt.Errorf("failed to open git worktree: %w", err)
+	}
+	status, err := worktree.Status()
+	if err != nil {
+		return fmt.Errorf("failed to read git status: %w", err)
+	}
+	if status.IsClean() {
+		return nil
+	}
+
+	var ids []int64
+	for _, file := range slices.Sorted(maps.Keys(status)) {
+		if status[file].Worktree == git.Deleted {
+			_, err = worktree.Remove(file)
+		} else {
+			_, err = worktree.Add(file)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to stage %s: %w", file, err)
+		}
+		if id, ok := entryIDOf(file); ok && !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	slices.Sort(ids)
+
+	var b strings.Builder
+	b.WriteString(message)
+	if len(ids) > 0 {
+		b.WriteString("\n\n")
+	}
+	for _, id := range ids {
+		fmt.Fprintf(&b, "%s%d\n", entryTrailer, id)
+	}
+
+	hash, err := worktree.Commit(b.String(), &git.CommitOptions{
+		Author: &object.Signature{Name: commitAuthor, Email: commitEmail, When: time.Now()},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to commit: %w", err)
+	}
+	for _, id := range ids {
+		g.commits[id] = hash.String()
+	}
+	return nil
+}
+
+// push pushes every branch to the remote and returns the hash of HEAD.
+func (g *gitRepo) push(ctx context.Context) (string, error) {
+	head, err := g.repo.Head()
+	if err != nil {
+		return "", fmt.Errorf("nothing has been committed yet: %w", err)
+	}
+
+	err = g.repo.PushContext(ctx, &git.PushOptions{RemoteName: g.remote})
+	if err != nil && !errors.Is(err, git.NoErrAlreadyUpToDate) {
+		return "", fmt.Errorf("failed to push to %s: %w", g.remote, err)
+	}
+	return head.Hash().String(), nil
+}
+
+// entryTrailers returns the entry IDs named in a commit message's trailers.
+func entryTrailers(message string) []int64 {
+	var ids []int64
+	for _, line := range strings.Split(message, "\n") {
+		value, ok := strings.CutPrefix(line, entryTrailer)
+		if !ok {
+			continue
+		}
+		if id, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// entryIDOf returns the ID of the entry a file in the journal directory
+// belongs to: YYYY-MM-DD-<id>.md in the directory or the trash, or any file
+// in .revisions/<id>/.
+func entryIDOf(file string) (int64, bool) {
+	parts := strings.Split(file, "/")
+	if len(parts) == 3 && parts[0] == revisionsDir {
+		id, err := strconv.ParseInt(parts[1], 10, 64)
+		return id, err == nil
+	}
+	if len(parts) > 2 || (len(parts) == 2 && parts[0] != trashDir) {
+		return 0, false
+	}
+
+	name, ok := strings.CutSuffix(path.Base(file), ".md")
+	if !ok {
+		return 0, false
+	}
+	id, err := strconv.ParseInt(name[strings.LastIndex(name, "-")+1:], 10, 64)
+	return id, err == nil
+}
@@ -0,0 +1,174 @@
+package mdstore
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+
+	"github.com/parkernilson/micro-journal/internal/domain"
+)
+
+func TestJournalStore_Git(t *testing.T) {
+	dir := t.TempDir()
+	ctx := context.Background()
+
+	store, err := Open(dir, Options{Git: true, GitRemote: "backup"})
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+
+	created, err := store.Create(ctx, "Title", "Content", time.Time{}, nil, nil)
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	other, err := store.Create(ctx, "Other", "Content", time.Time{}, nil, nil)
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	updated, err := store.Update(ctx, created.ID, "Title", "Edited", nil, nil, 0)
+	if err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+
+	head, err := store.git.repo.Head()
+	if err != nil {
+		t.Fatalf("Expected a commit: %v", err)
+	}
+	if created.CommitHash == "" || updated.CommitHash == created.CommitHash {
+		t.Errorf("Expected a new commit for the update, got '%s' and '%s'", created.CommitHash, updated.CommitHash)
+	}
+	if updated.CommitHash != head.Hash().String() {
+		t.Errorf("Expected the update to be HEAD %s, got '%s'", head.Hash(), updated.CommitHash)
+	}
+
+	commit, err := store.git.repo.CommitObject(head.Hash())
+	if err != nil {
+		t.Fatalf("CommitObject failed: %v", err)
+	}
+	if commit.Message != "Update entry 1\n\nEntry: 1\n" {
+		t.Errorf("Unexpected commit message %q", commit.Message)
+	}
+
+	t.Run("reopening reads commit hashes from the history", func(t *testing.T) {
+		reopened, err := Open(dir, Options{Git: true})
+		if err != nil {
+			t.Fatalf("Open failed: %v", err)
+		}
+
+		entries, _, err := reopened.List(ctx, domain.EntryFilter{}, 10, 0)
+		if err != nil {
+			t.Fatalf("List failed: %v", err)
+		}
+		hashes := map[int64]string{}
+		for _, entry := range entries {
+			hashes[entry.ID] = entry.CommitHash
+		}
+		if hashes[created.ID] != updated.CommitHash || hashes[other.ID] != other.CommitHash {
+			t.Errorf("Expected the newest commit of each entry, got %v", hashes)
+		}
+	})
+
+	t.Run("files edited while stopped are committed on open", func(t *testing.T) {
+		path := store.paths[other.ID]
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("ReadFile failed: %v", err)
+		}
+		if err := os.WriteFile(path, append(data, " by hand"...), 0o644); err != nil {
+			t.Fatalf("WriteFile failed: %v", err)
+		}
+
+		reopened, err := Open(dir, Options{Git: true})
+		if err != nil {
+			t.Fatalf("Open failed: %v", err)
+		}
+		entry, err := reopened.GetByID(ctx, other.ID)
+		if err != nil {
+			t.Fatalf("GetByID failed: %v", err)
+		}
+		if entry.CommitHash == other.CommitHash {
+			t.Error("Expected the hand edit to be committed")
+		}
+	})
+
+	t.Run("push backup", func(t *testing.T) {
+		remoteDir := t.TempDir()
+		remote, err := git.PlainInit(remoteDir, true)
+		if err != nil {
+			t.Fatalf("PlainInit failed: %v", err)
+		}
+		if _, err := store.git.repo.CreateRemote(&config.RemoteConfig{Name: "backup", URLs: []string{remoteDir}}); err != nil {
+			t.Fatalf("CreateRemote failed: %v", err)
+		}
+
+		version, err := store.PushBackup(ctx)
+		if err != nil {
+			t.Fatalf("PushBackup failed: %v", err)
+		}
+		head, _ := store.git.repo.Head()
+		ref, err := remote.Reference(head.Name(), true)
+		if err != nil {
+			t.Fatalf("Expected the branch on the remote: %v", err)
+		}
+		if version != ref.Hash().String() {
+			t.Errorf("Expected pushed version %s, got %s", ref.Hash(), version)
+		}
+
+		if _, err := store.PushBackup(ctx); err != nil {
+			t.Errorf("Expected pushing again to succeed, got %v", err)
+		}
+	})
+}
+
+func TestJournalStore_PushBackupWithoutGit(t *testing.T) {
+	store, err := Open(t.TempDir(), Options{})
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	if _, err := store.PushBackup(context.Background()); !errors.Is(err, errGitDisabled) {
+		t.Errorf("Expected errGitDisabled, got %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(store.dir, git.GitDirName)); err == nil {
+		t.Error("Expected no git repository")
+	}
+}
+
+func TestJournalStore_VerifyArchive(t *testing.T) {
+	store, err := Open(t.TempDir(), Options{})
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	if _, err := store.VerifyArchive(context.Background()); !errors.Is(err, domain.ErrFailedPrecondition) {
+		t.Errorf("Expected ErrFailedPrecondition, got %v", err)
+	}
+}
+
+func TestEntryIDOf(t *testing.T) {
+	tests := []struct {
+		file string
+		id   int64
+		ok   bool
+	}{
+		{"2024-01-02-12.md", 12, true},
+		{".trash/2024-01-02-7.md", 7, true},
+		{".revisions/3/9.md", 3, true},
+		{"notes/2024-01-02-12.md", 0, false},
+		{"README.txt", 0, false},
+		{".gitignore", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			id, ok := entryIDOf(tt.file)
+			if id != tt.id || ok != tt.ok {
+				t.Errorf("Expected %d, %v, got %d, %v", tt.id, tt.ok, id, ok)
+			}
+		})
+	}
+}
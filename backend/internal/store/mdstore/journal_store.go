@@ -0,0 +1,649 @@
+// Package mdstore implements the journal store as a directory of Markdown
+// files with YAML front matter, one file per entry, so the journal can be
+// searched with grep and synced with tools like Obsidian or Syncthing.
+package mdstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/parkernilson/micro-journal/internal/domain"
+	"github.com/parkernilson/micro-journal/internal/store/memstore"
+)
+
+// errArchiveUnsupported is returned by VerifyArchive. The files can be
+// edited freely, so there is nothing to verify them against.
+var errArchiveUnsupported = fmt.Errorf("the entry archive is not supported by the Markdown store: %w", domain.ErrFailedPrecondition)
+
+// errGitDisabled is returned by PushBackup when the store does not keep the
+// journal in git.
+var errGitDisabled = fmt.Errorf("backups can only be pushed when the Markdown store keeps the journal in git: %w", domain.ErrFailedPrecondition)
+
+const (
+	// trashDir holds the files of entries in the trash
+	trashDir = ".trash"
+	// revisionsDir holds one directory of revision files per entry
+	revisionsDir = ".revisions"
+)
+
+// JournalStore keeps each journal entry in a Markdown file in a directory
+// and serves reads from an in-memory index built when the store is opened.
+//
+// Entries are stored as YYYY-MM-DD-<id>.md, named by creation date. Entries
+// in the trash are moved to .trash/, and revisions are kept in
+// .revisions/<entry id>/<revision id>.md. Files changed by other programs
+// while the server runs are not seen until it is restarted.
+//
+// Every write updates the index and then the files. If writing a file fails,
+// the index is rebuilt from the files so the two never disagree.
+//
+// With Options.Git, every write is also committed to a git repository in the
+// directory. A failed commit is logged rather than returned, since the change
+// is already saved; the next commit picks it up.
+type JournalStore struct {
+	dir   string
+	index *memstore.JournalStore
+	// git is nil unless the journal is kept in git
+	git *gitRepo
+
+	// mu serializes writes so the files change in the same order as the
+	// index, and guards paths and git
+	mu sync.Mutex
+	// paths is the file each entry was loaded from or last written to
+	paths map[int64]string
+	// backup records the files a batch changes, so they can be restored if
+	// it fails partway. It is nil outside of WithTx
+	backup fileBackup
+	// writeFile is the function files are written with
+	writeFile func(path string, data []byte) error
+}
+
+// Options controls how the Markdown store keeps its files.
+type Options struct {
+	// Git commits every change to a git repository in the directory,
+	// creating the repository if needed.
+	Git bool
+	// GitRemote is the name of the remote PushBackup pushes to.
+	GitRemote string
+}
+
+// Open opens the Markdown store in dir, creating the directory if needed and
+// loading every entry file into the index. With opts.Git, files changed
+// while the server was stopped are committed first.
+func Open(dir string, opts Options) (*JournalStore, error) {
+	for _, sub := range []string{dir, filepath.Join(dir, trashDir), filepath.Join(dir, revisionsDir)} {
+		if err := os.MkdirAll(sub, 0o755); err != nil {
+			return nil, fmt.Errorf("failed to create journal directory: %w", err)
+		}
+	}
+
+	s := &JournalStore{dir: dir, index: memstore.NewJournalStore(), writeFile: writeFile}
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+
+	if opts.Git {
+		repo, err := openGit(dir, opts.GitRemote)
+		if err != nil {
+			return nil, err
+		}
+		if err := repo.commit("Add changes made outside the server"); err != nil {
+			return nil, err
+		}
+		s.git = repo
+	}
+	return s, nil
+}
+
+// load rebuilds the index from the files. s.mu must be held, or s not yet
+// shared.
+func (s *JournalStore) load() error {
+	paths := map[int64]string{}
+	var entries []*domain.JournalEntry
+
+	for _, sub := range []string{"", trashDir} {
+		files, err := filepath.Glob(filepath.Join(s.dir, sub, "*.md"))
+		if err != nil {
+			return fmt.Errorf("failed to list entry files: %w", err)
+		}
+
+		for _, path := range files {
+			entry, err := readEntry(path, sub == trashDir)
+			if err != nil {
+				return err
+			}
+			if other, ok := paths[entry.ID]; ok {
+				return fmt.Errorf("entry %d is in both %s and %s", entry.ID, other, path)
+			}
+			paths[entry.ID] = path
+			entries = append(entries, entry)
+		}
+	}
+
+	files, err := filepath.Glob(filepath.Join(s.dir, revisionsDir, "*", "*.md"))
+	if err != nil {
+		return fmt.Errorf("failed to list revision files: %w", err)
+	}
+	var revisions []*domain.Revision
+	for _, path := range files {
+		revision, err := readRevision(path)
+		if err != nil {
+			return err
+		}
+		revisions = append(revisions, revision)
+	}
+
+	s.index.Load(entries, revisions)
+	s.paths = paths
+	return nil
+}
+
+// Create inserts a new journal entry and writes its file.
+func (s *JournalStore) Create(ctx context.Context, title, content string, revealAt time.Time, doc *domain.Document, tags []string) (*domain.JournalEntry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, err := s.index.Create(ctx, title, content, revealAt, doc, tags)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.writeEntry(entry); err != nil {
+		return nil, s.rollback(err)
+	}
+	s.commit(ctx, fmt.Sprintf("Create entry %d", entry.ID), "entry_id", entry.ID)
+	s.setCommitHashes(entry)
+	return entry, nil
+}
+
+// GetByID retrieves a journal entry by its ID.
+func (s *JournalStore) GetByID(ctx context.Context, id int64) (*domain.JournalEntry, error) {
+	entry, err := s.index.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	s.lockedSetCommitHashes(entry)
+	return entry, nil
+}
+
+// Update modifies an existing journal entry, saving the previous version as
+// a revision file. A non-zero expectedVersion must match the entry's version.
+func (s *JournalStore) Update(ctx context.Context, id int64, title, content string, doc *domain.Document, tags []string, expectedVersion int64) (*domain.JournalEntry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, err := s.index.Update(ctx, id, title, content, doc, tags, expectedVersion)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.writeUpdate(ctx, entry); err != nil {
+		return nil, s.rollback(err)
+	}
+	s.commit(ctx, fmt.Sprintf("Update entry %d", entry.ID), "entry_id", entry.ID)
+	s.setCommitHashes(entry)
+	return entry, nil
+}
+
+// Delete moves a journal entry's file to the trash.
+func (s *JournalStore) Delete(ctx context.Context, id int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.index.Delete(ctx, id); err != nil {
+		return err
+	}
+	entry, _ := s.index.Entry(id)
+	if err := s.writeEntry(entry); err != nil {
+		return s.rollback(err)
+	}
+	s.commit(ctx, fmt.Sprintf("Move entry %d to the trash", id), "entry_id", id)
+	return nil
+}
+
+// Purge permanently removes a journal entry's file and its revisions.
+func (s *JournalStore) Purge(ctx context.Context, id int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.index.Purge(ctx, id); err != nil {
+		return err
+	}
+	if err := s.removeEntry(id); err != nil {
+		return s.rollback(err)
+	}
+	s.commit(ctx, fmt.Sprintf("Purge entry %d", id), "entry_id", id)
+	return nil
+}
+
+// List retrieves journal entries matching filter with pagination.
+func (s *JournalStore) List(ctx context.Context, filter domain.EntryFilter, limit, offset int) ([]*domain.JournalEntry, int64, error) {
+	entries, total, err := s.index.List(ctx, filter, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	s.lockedSetCommitHashes(entries...)
+	return entries, total, nil
+}
+
+// Search retrieves journal entries matching a search query, best match
+// first.
+func (s *JournalStore) Search(ctx context.Context, query string, limit, offset int) ([]*domain.JournalEntry, int64, error) {
+	entries, total, err := s.index.Search(ctx, query, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	s.lockedSetCommitHashes(entries...)
+	return entries, total, nil
+}
+
+// ListTags retrieves every tag with the number of entries that use it.
+func (s *JournalStore) ListTags(ctx context.Context) ([]*domain.Tag, error) {
+	return s.index.ListTags(ctx)
+}
+
+// RenameTag renames a tag on every entry that uses it, rewriting their
+// files.
+func (s *JournalStore) RenameTag(ctx context.Context, name, newName string) (*domain.Tag, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	before := s.index.Entries()
+	tag, err := s.index.RenameTag(ctx, name, newName)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.writeChanged(before); err != nil {
+		return nil, s.rollback(err)
+	}
+	s.commit(ctx, fmt.Sprintf("Rename tag %s to %s", name, tag.Name), "tag", name)
+	return tag, nil
+}
+
+// DeleteTag removes a tag from every entry that uses it, rewriting their
+// files.
+func (s *JournalStore) DeleteTag(ctx context.Context, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	before := s.index.Entries()
+	if err := s.index.DeleteTag(ctx, name); err != nil {
+		return err
+	}
+	if err := s.writeChanged(before); err != nil {
+		return s.rollback(err)
+	}
+	s.commit(ctx, fmt.Sprintf("Delete tag %s", name), "tag", name)
+	return nil
+}
+
+// ListTrash retrieves entries in the trash, most recently deleted first.
+func (s *JournalStore) ListTrash(ctx context.Context, limit, offset int) ([]*domain.JournalEntry, int64, error) {
+	entries, total, err := s.index.ListTrash(ctx, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	s.lockedSetCommitHashes(entries...)
+	return entries, total, nil
+}
+
+// Restore moves a journal entry's file out of the trash.
+func (s *JournalStore) Restore(ctx context.Context, id int64) (*domain.JournalEntry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, err := s.index.Restore(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.writeEntry(entry); err != nil {
+		return nil, s.rollback(err)
+	}
+	s.commit(ctx, fmt.Sprintf("Restore entry %d from the trash", id), "entry_id", id)
+	s.setCommitHashes(entry)
+	return entry, nil
+}
+
+// PurgeTrash permanently removes the files of entries that were moved to the
+// trash before deletedBefore, or of every entry in the trash if
+// deletedBefore is zero. Returns the number of entries removed.
+func (s *JournalStore) PurgeTrash(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	purged, err := s.index.PurgeTrash(ctx, deletedBefore)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, id := range slices.Sorted(maps.Keys(s.paths)) {
+		if _, ok := s.index.Entry(id); ok {
+			continue
+		}
+		if err := s.removeEntry(id); err != nil {
+			return 0, s.rollback(err)
+		}
+	}
+	s.commit(ctx, fmt.Sprintf("Purge %d entries from the trash", purged))
+	return purged, nil
+}
+
+// ListRevisions retrieves the saved revisions of an entry, newest first.
+func (s *JournalStore) ListRevisions(ctx context.Context, entryID int64, limit, offset int) ([]*domain.Revision, int64, error) {
+	return s.index.ListRevisions(ctx, entryID, limit, offset)
+}
+
+// SearchRevisions retrieves the revisions of an entry matching a search
+// query, best match first.
+func (s *JournalStore) SearchRevisions(ctx context.Context, entryID int64, query string, limit, offset int) ([]*domain.Revision, int64, error) {
+	return s.index.SearchRevisions(ctx, entryID, query, limit, offset)
+}
+
+// RestoreRevision replaces an entry's title, content, and document with
+// those of one of its revisions, saving the replaced version as a new
+// revision file.
+func (s *JournalStore) RestoreRevision(ctx context.Context, entryID, revisionID int64) (*domain.JournalEntry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, err := s.index.RestoreRevision(ctx, entryID, revisionID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.writeUpdate(ctx, entry); err != nil {
+		return nil, s.rollback(err)
+	}
+	s.commit(ctx, fmt.Sprintf("Restore revision %d of entry %d", revisionID, entryID), "entry_id", entryID)
+	s.setCommitHashes(entry)
+	return entry, nil
+}
+
+// VerifyArchive always fails, because the Markdown store does not keep the
+// entry archive.
+func (s *JournalStore) VerifyArchive(ctx context.Context) (*domain.ArchiveReport, error) {
+	return nil, errArchiveUnsupported
+}
+
+// Export calls fn for every entry that is not in the trash, oldest first.
+func (s *JournalStore) Export(ctx context.Context, fn func(entry *domain.JournalEntry) error) error {
+	return s.index.Export(ctx, func(entry *domain.JournalEntry) error {
+		s.lockedSetCommitHashes(entry)
+		return fn(entry)
+	})
+}
+
+// Import inserts entries that are not duplicates and writes their files.
+// Returns the number of entries inserted.
+func (s *JournalStore) Import(ctx context.Context, entries []*domain.JournalEntry) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	before := s.index.Entries()
+	imported, err := s.index.Import(ctx, entries)
+	if err != nil {
+		return 0, err
+	}
+	if err := s.writeChanged(before); err != nil {
+		return 0, s.rollback(err)
+	}
+	s.commit(ctx, fmt.Sprintf("Import %d entries", imported))
+	return imported, nil
+}
+
+// PushBackup pushes the git repository to the configured remote and returns
+// the hash of the commit pushed. Writes wait until the push finishes.
+func (s *JournalStore) PushBackup(ctx context.Context) (string, error) {
+	if s.git == nil {
+		return "", errGitDisabled
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.git.push(ctx)
+}
+
+// commit commits the files changed by a write with message, if the journal
+// is kept in git. Failures are logged with attrs, such as the entry ID, since
+// the change is saved either way. s.mu must be held.
+func (s *JournalStore) commit(ctx context.Context, message string, attrs ...any) {
+	if s.git == nil {
+		return
+	}
+	if err := s.git.commit(message); err != nil {
+		attrs = append(attrs, "message", message, "error", err)
+		slog.ErrorContext(ctx, "failed to commit journal change to git", attrs...)
+	}
+}
+
+// setCommitHashes sets the CommitHash of each entry, if the journal is kept
+// in git. s.mu must be held.
+func (s *JournalStore) setCommitHashes(entries ...*domain.JournalEntry) {
+	if s.git == nil {
+		return
+	}
+	for _, entry := range entries {
+		entry.CommitHash = s.git.commits[entry.ID]
+	}
+}
+
+// lockedSetCommitHashes is setCommitHashes for callers not holding s.mu.
+func (s *JournalStore) lockedSetCommitHashes(entries ...*domain.JournalEntry) {
+	if s.git == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.setCommitHashes(entries...)
+}
+
+// rollback rebuilds the index from the files after a failed write, so it no
+// longer reflects the change that could not be saved, and returns err.
+func (s *JournalStore) rollback(err error) error {
+	if loadErr := s.load(); loadErr != nil {
+		return errors.Join(err, fmt.Errorf("failed to reload journal files: %w", loadErr))
+	}
+	return err
+}
+
+// writeUpdate writes an updated entry and the revision the update saved.
+func (s *JournalStore) writeUpdate(ctx context.Context, entry *domain.JournalEntry) error {
+	revisions, _, err := s.index.ListRevisions(ctx, entry.ID, 1, 0)
+	if err != nil {
+		return err
+	}
+	for _, revision := range revisions {
+		if err := s.writeFile(s.revisionPath(revision), marshalRevision(revision)); err != nil {
+			return err
+		}
+	}
+	return s.writeEntry(entry)
+}
+
+// writeChanged writes every entry that is not in before or whose tags or
+// trash state changed since, and removes the files of entries in before that
+// no longer exist.
+func (s *JournalStore) writeChanged(before []*domain.JournalEntry) error {
+	old := make(map[int64]*domain.JournalEntry, len(before))
+	for _, entry := range before {
+		old[entry.ID] = entry
+	}
+
+	for _, entry := range s.index.Entries() {
+		prev, ok := old[entry.ID]
+		delete(old, entry.ID)
+		if ok && slices.Equal(prev.Tags, entry.Tags) && prev.DeletedAt.Equal(entry.DeletedAt) {
+			continue
+		}
+		if err := s.writeEntry(entry); err != nil {
+			return err
+		}
+	}
+
+	for id := range old {
+		if err := s.removeEntry(id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeEntry writes an entry's file, moving it between the journal directory
+// and the trash if needed.
+func (s *JournalStore) writeEntry(entry *domain.JournalEntry) error {
+	data, err := marshalEntry(entry)
+	if err != nil {
+		return err
+	}
+
+	path := s.entryPath(entry)
+	if err := s.backup.save(path); err != nil {
+		return err
+	}
+	if err := s.writeFile(path, data); err != nil {
+		return err
+	}
+
+	if old, ok := s.paths[entry.ID]; ok && old != path {
+		if err := s.backup.save(old); err != nil {
+			return err
+		}
+		if err := os.Remove(old); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to remove old entry file: %w", err)
+		}
+	}
+	s.paths[entry.ID] = path
+	return nil
+}
+
+// removeEntry deletes an entry's file and its revisions.
+func (s *JournalStore) removeEntry(id int64) error {
+	if path, ok := s.paths[id]; ok {
+		if err := s.backup.save(path); err != nil {
+			return err
+		}
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to remove entry file: %w", err)
+		}
+		delete(s.paths, id)
+	}
+
+	revisions := filepath.Join(s.dir, revisionsDir, fmt.Sprint(id))
+	if err := s.backup.saveDir(revisions); err != nil {
+		return err
+	}
+	if err := os.RemoveAll(revisions); err != nil {
+		return fmt.Errorf("failed to remove entry revisions: %w", err)
+	}
+	return nil
+}
+
+// entryPath returns where an entry's file belongs.
+func (s *JournalStore) entryPath(entry *domain.JournalEntry) string {
+	name := fmt.Sprintf("%s-%d.md", entry.CreatedAt.UTC().Format("2006-01-02"), entry.ID)
+	if !entry.DeletedAt.IsZero() {
+		return filepath.Join(s.dir, trashDir, name)
+	}
+	return filepath.Join(s.dir, name)
+}
+
+// revisionPath returns where a revision's file belongs.
+func (s *JournalStore) revisionPath(revision *domain.Revision) string {
+	return filepath.Join(s.dir, revisionsDir, fmt.Sprint(revision.EntryID), fmt.Sprintf("%d.md", revision.ID))
+}
+
+// fileBackup maps each file a batch changed to its contents before the
+// batch, or to nil if it did not exist. A nil fileBackup records nothing.
+type fileBackup map[string][]byte
+
+// save records the contents of the file at path, unless already recorded.
+func (b fileBackup) save(path string) error {
+	if b == nil {
+		return nil
+	}
+	if _, ok := b[path]; ok {
+		return nil
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		b[path] = nil
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to back up %s: %w", path, err)
+	}
+	if data == nil {
+		data = []byte{}
+	}
+	b[path] = data
+	return nil
+}
+
+// saveDir records the contents of every file under dir.
+func (b fileBackup) saveDir(dir string) error {
+	if b == nil {
+		return nil
+	}
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		return b.save(path)
+	})
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// restore puts every recorded file back as it was, removing the files that
+// did not exist.
+func (b fileBackup) restore() error {
+	var errs []error
+	for path, data := range b {
+		if data == nil {
+			if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+				errs = append(errs, fmt.Errorf("failed to remove %s: %w", path, err))
+			}
+			continue
+		}
+		if err := writeFile(path, data); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// writeFile atomically replaces the file at path with data, creating its
+// directory if needed.
+func writeFile(path string, data []byte) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(dir, ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,276 @@
+package mdstore
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/parkernilson/micro-journal/internal/domain"
+)
+
+func TestJournalStore(t *testing.T) {
+	dir := t.TempDir()
+	ctx := context.Background()
+
+	store, err := Open(dir, Options{})
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+
+	doc := &domain.Document{Version: 1, Sections: []domain.Section{
+		{Type: domain.SectionChecklist, Items: []domain.ChecklistItem{{Text: "Water plants", Checked: true}}},
+	}}
+	first, err := store.Create(ctx, "Title: with colon", "Line one\n---\nLine two\n", time.Time{}, doc, []string{"home"})
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	second, err := store.Create(ctx, "Second", "Content", time.Time{}, nil, []string{"work"})
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	path := filepath.Join(dir, first.CreatedAt.Format("2006-01-02")+"-1.md")
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Expected entry file: %v", err)
+	}
+	if !strings.HasPrefix(string(data), "---\nid: 1\ntitle: 'Title: with colon'\n") || !strings.Contains(string(data), "tags: [home]") {
+		t.Errorf("Unexpected entry file:\n%s", data)
+	}
+
+	if _, err := store.Update(ctx, second.ID, "Second", "Edited", nil, []string{"work", "home"}, 0); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	if _, err := store.RenameTag(ctx, "home", "house"); err != nil {
+		t.Fatalf("RenameTag failed: %v", err)
+	}
+	if err := store.Delete(ctx, first.ID); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, trashDir, filepath.Base(path))); err != nil {
+		t.Errorf("Expected deleted entry file in the trash: %v", err)
+	}
+
+	t.Run("reopening reads the same journal", func(t *testing.T) {
+		reopened, err := Open(dir, Options{})
+		if err != nil {
+			t.Fatalf("Open failed: %v", err)
+		}
+
+		trash, _, err := reopened.ListTrash(ctx, 10, 0)
+		if err != nil || len(trash) != 1 {
+			t.Fatalf("Expected 1 entry in the trash, got %+v (%v)", trash, err)
+		}
+		got := trash[0]
+		if got.Title != first.Title || got.Content != first.Content || !reflect.DeepEqual(got.Document, doc) {
+			t.Errorf("Entry did not round-trip: %+v", got)
+		}
+		if !got.CreatedAt.Equal(first.CreatedAt) || !reflect.DeepEqual(got.Tags, []string{"house"}) {
+			t.Errorf("Unexpected created_at %v or tags %v", got.CreatedAt, got.Tags)
+		}
+
+		edited, err := reopened.GetByID(ctx, second.ID)
+		if err != nil {
+			t.Fatalf("GetByID failed: %v", err)
+		}
+		if edited.Content != "Edited" || edited.Version != 2 || !reflect.DeepEqual(edited.Tags, []string{"house", "work"}) {
+			t.Errorf("Unexpected edited entry %+v", edited)
+		}
+
+		revisions, total, err := reopened.ListRevisions(ctx, second.ID, 10, 0)
+		if err != nil {
+			t.Fatalf("ListRevisions failed: %v", err)
+		}
+		if total != 1 || revisions[0].Content != "Content" {
+			t.Errorf("Expected the original version as a revision, got %+v", revisions)
+		}
+
+		next, err := reopened.Create(ctx, "Third", "Content", time.Time{}, nil, nil)
+		if err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+		if next.ID != 3 {
+			t.Errorf("Expected IDs to continue after the loaded entries, got %d", next.ID)
+		}
+	})
+
+	t.Run("purging removes files", func(t *testing.T) {
+		if err := store.Purge(ctx, second.ID); err != nil {
+			t.Fatalf("Purge failed: %v", err)
+		}
+		if _, err := os.Stat(filepath.Join(dir, revisionsDir, "2")); !os.IsNotExist(err) {
+			t.Errorf("Expected revisions to be removed, got %v", err)
+		}
+
+		purged, err := store.PurgeTrash(ctx, time.Time{})
+		if err != nil {
+			t.Fatalf("PurgeTrash failed: %v", err)
+		}
+		if purged != 1 {
+			t.Errorf("Expected 1 purged entry, got %d", purged)
+		}
+		if files, _ := filepath.Glob(filepath.Join(dir, trashDir, "*.md")); len(files) != 0 {
+			t.Errorf("Expected an empty trash directory, got %v", files)
+		}
+	})
+
+	t.Run("hand-written file", func(t *testing.T) {
+		dir := t.TempDir()
+		file := "---\nid: 7\ntitle: Notes\ncreated_at: 2024-03-01T09:00:00Z\ntags: [ideas]\n---\nWritten in an editor\n"
+		if err := os.WriteFile(filepath.Join(dir, "notes.md"), []byte(file), 0o644); err != nil {
+			t.Fatal(err)
+		}
+
+		store, err := Open(dir, Options{})
+		if err != nil {
+			t.Fatalf("Open failed: %v", err)
+		}
+		entry, err := store.GetByID(ctx, 7)
+		if err != nil {
+			t.Fatalf("GetByID failed: %v", err)
+		}
+		if entry.Content != "Written in an editor" || !entry.UpdatedAt.Equal(entry.CreatedAt) {
+			t.Errorf("Unexpected entry %+v", entry)
+		}
+	})
+
+	t.Run("invalid files", func(t *testing.T) {
+		tests := map[string]string{
+			"no front matter": "Just text\n",
+			"no id":           "---\ntitle: Notes\ncreated_at: 2024-03-01T09:00:00Z\n---\nText\n",
+			"bad timestamp":   "---\nid: 1\ntitle: Notes\ncreated_at: yesterday\n---\nText\n",
+		}
+		for name, file := range tests {
+			t.Run(name, func(t *testing.T) {
+				dir := t.TempDir()
+				if err := os.WriteFile(filepath.Join(dir, "entry.md"), []byte(file), 0o644); err != nil {
+					t.Fatal(err)
+				}
+				if _, err := Open(dir, Options{}); err == nil {
+					t.Error("Expected error, got nil")
+				}
+			})
+		}
+	})
+}
+
+func TestJournalStore_WithTx(t *testing.T) {
+	dir := t.TempDir()
+	ctx := context.Background()
+
+	store, err := Open(dir, Options{})
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	trashed, _ := store.Create(ctx, "Trashed", "Content", time.Time{}, nil, nil)
+	purged, _ := store.Create(ctx, "Purged", "Content", time.Time{}, nil, nil)
+
+	err = store.WithTx(ctx, func(tx domain.JournalTx) error {
+		if _, err := tx.Create(ctx, "Created", "Content", time.Time{}, nil, []string{"work"}); err != nil {
+			return err
+		}
+		if err := tx.Delete(ctx, trashed.ID); err != nil {
+			return err
+		}
+		return tx.Purge(ctx, purged.ID)
+	})
+	if err != nil {
+		t.Fatalf("WithTx failed: %v", err)
+	}
+
+	t.Run("rolled back batch writes nothing", func(t *testing.T) {
+		err := store.WithTx(ctx, func(tx domain.JournalTx) error {
+			tx.Create(ctx, "Rolled back", "Content", time.Time{}, nil, nil)
+			return tx.Delete(ctx, 999)
+		})
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+		if files, _ := filepath.Glob(filepath.Join(dir, "*.md")); len(files) != 1 {
+			t.Errorf("Expected only the created entry's file, got %v", files)
+		}
+	})
+
+	reopened, err := Open(dir, Options{})
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	entries, total, err := reopened.List(ctx, domain.EntryFilter{}, 10, 0)
+	if err != nil || total != 1 || entries[0].Title != "Created" {
+		t.Errorf("Expected only the created entry, got %+v (%v)", entries, err)
+	}
+	trash, _, _ := reopened.ListTrash(ctx, 10, 0)
+	if len(trash) != 1 || trash[0].ID != trashed.ID {
+		t.Errorf("Expected the deleted entry in the trash, got %+v", trash)
+	}
+	if _, err := reopened.GetByID(ctx, purged.ID); err == nil {
+		t.Error("Expected the purged entry to be gone")
+	}
+}
+
+func TestJournalStore_WithTx_WriteFailure(t *testing.T) {
+	dir := t.TempDir()
+	ctx := context.Background()
+
+	store, err := Open(dir, Options{})
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	trashed, _ := store.Create(ctx, "Trashed", "Content", time.Time{}, nil, nil)
+	purged, _ := store.Create(ctx, "Purged", "Content", time.Time{}, nil, nil)
+
+	// Fail the second file write of the batch
+	writes := 0
+	store.writeFile = func(path string, data []byte) error {
+		writes++
+		if writes == 2 {
+			return errors.New("disk full")
+		}
+		return writeFile(path, data)
+	}
+
+	err = store.WithTx(ctx, func(tx domain.JournalTx) error {
+		if err := tx.Delete(ctx, trashed.ID); err != nil {
+			return err
+		}
+		if err := tx.Purge(ctx, purged.ID); err != nil {
+			return err
+		}
+		_, err := tx.Create(ctx, "Created", "Content", time.Time{}, nil, nil)
+		return err
+	})
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+	if writes != 2 {
+		t.Fatalf("Expected the batch to stop at the second write, got %d writes", writes)
+	}
+
+	for name, s := range map[string]*JournalStore{"store": store, "reopened": mustOpen(t, dir)} {
+		entries, total, err := s.List(ctx, domain.EntryFilter{}, 10, 0)
+		if err != nil || total != 2 {
+			t.Errorf("%s: expected only the entries from before the batch, got %+v (%v)", name, entries, err)
+		}
+		if trash, _, _ := s.ListTrash(ctx, 10, 0); len(trash) != 0 {
+			t.Errorf("%s: expected an empty trash, got %+v", name, trash)
+		}
+	}
+	if files, _ := filepath.Glob(filepath.Join(dir, trashDir, "*")); len(files) != 0 {
+		t.Errorf("Expected no files in the trash, got %v", files)
+	}
+}
+
+// mustOpen opens the Markdown store in dir, failing the test on error.
+func mustOpen(t *testing.T, dir string) *JournalStore {
+	t.Helper()
+	store, err := Open(dir, Options{})
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	return store
+}
@@ -0,0 +1,65 @@
+package mdstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/parkernilson/micro-journal/internal/domain"
+)
+
+// WithTx runs fn against the index in one transaction and then writes the
+// files of every entry it changed, committing them to git together. If fn
+// returns an error, the index is rolled back and no file is written. If
+// writing the files fails, the files already written are restored.
+func (s *JournalStore) WithTx(ctx context.Context, fn func(tx domain.JournalTx) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	before := s.index.Entries()
+	var returned []*domain.JournalEntry
+	err := s.index.WithTx(ctx, func(tx domain.JournalTx) error {
+		return fn(&journalTx{JournalTx: tx, returned: &returned})
+	})
+	if err != nil {
+		return err
+	}
+
+	s.backup = fileBackup{}
+	err = s.writeChanged(before)
+	backup := s.backup
+	s.backup = nil
+	if err != nil {
+		if restoreErr := backup.restore(); restoreErr != nil {
+			err = errors.Join(err, fmt.Errorf("failed to restore journal files: %w", restoreErr))
+		}
+		return s.rollback(err)
+	}
+	s.commit(ctx, "Apply a batch of changes")
+	s.setCommitHashes(returned...)
+	return nil
+}
+
+// journalTx wraps the index's transaction to remember the entries it
+// returns, so their commit hashes can be set once the files are committed.
+type journalTx struct {
+	domain.JournalTx
+	returned *[]*domain.JournalEntry
+}
+
+func (t *journalTx) Create(ctx context.Context, title, content string, revealAt time.Time, doc *domain.Document, tags []string) (*domain.JournalEntry, error) {
+	entry, err := t.JournalTx.Create(ctx, title, content, revealAt, doc, tags)
+	if err == nil {
+		*t.returned = append(*t.returned, entry)
+	}
+	return entry, err
+}
+
+func (t *journalTx) GetByID(ctx context.Context, id int64) (*domain.JournalEntry, error) {
+	entry, err := t.JournalTx.GetByID(ctx, id)
+	if err == nil {
+		*t.returned = append(*t.returned, entry)
+	}
+	return entry, err
+}
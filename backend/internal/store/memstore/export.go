@@ -0,0 +1,38 @@
+package memstore
+
+import (
+	"context"
+	"slices"
+
+	"github.com/parkernilson/micro-journal/internal/domain"
+)
+
+// Export calls fn for every entry that is not in the trash, oldest first,
+// stopping at the first error fn returns or when ctx is done.
+// fn runs on a snapshot taken before the first call, without the store
+// locked, so fn may use the store.
+func (s *JournalStore) Export(ctx context.Context, fn func(entry *domain.JournalEntry) error) error {
+	s.mu.RLock()
+	var entries []*domain.JournalEntry
+	for _, entry := range s.entries {
+		if entry.DeletedAt.IsZero() {
+			entries = append(entries, cloneEntry(entry))
+		}
+	}
+	s.mu.RUnlock()
+
+	slices.SortFunc(entries, func(a, b *domain.JournalEntry) int {
+		return newestFirst(b, a)
+	})
+
+	for _, entry := range entries {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := fn(entry); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
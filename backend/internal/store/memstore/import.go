@@ -0,0 +1,46 @@
+package memstore
+
+import (
+	"context"
+	"time"
+
+	"github.com/parkernilson/micro-journal/internal/domain"
+)
+
+// Import stores entries, keeping each entry's CreatedAt, RevealAt, Document,
+// and Tags. Tags must already be normalized.
+// An entry is skipped as a duplicate if an entry with the same created_at,
+// title, and content already exists, including in the trash, so an import
+// can be retried without bringing back entries that were deleted since.
+// Returns the number of entries stored. Nothing is stored if ctx is done by
+// the time the store is locked.
+func (s *JournalStore) Import(ctx context.Context, entries []*domain.JournalEntry) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	var imported int64
+	for _, entry := range entries {
+		if s.exists(timestamp(entry.CreatedAt), entry.Title, entry.Content) {
+			continue
+		}
+		s.create(entry.Title, entry.Content, entry.CreatedAt, entry.RevealAt, entry.Document, entry.Tags)
+		imported++
+	}
+
+	return imported, nil
+}
+
+// exists reports whether an entry with the given creation time, title, and
+// content is stored, including in the trash. s.mu must be held.
+func (s *JournalStore) exists(createdAt time.Time, title, content string) bool {
+	for _, entry := range s.entries {
+		if entry.CreatedAt.Equal(createdAt) && entry.Title == title && entry.Content == content {
+			return true
+		}
+	}
+	return false
+}
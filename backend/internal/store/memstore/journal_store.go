@@ -0,0 +1,416 @@
+// Package memstore implements the journal store in memory, for demos and
+// for tests that do not want a database. Nothing is persisted.
+package memstore
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/parkernilson/micro-journal/internal/domain"
+)
+
+// errArchiveUnsupported is returned by VerifyArchive, since an archive kept
+// in memory could not outlive the entries it protects.
+var errArchiveUnsupported = fmt.Errorf("the entry archive is not supported by the in-memory store: %w", domain.ErrFailedPrecondition)
+
+// searchTerm matches one term of a store search query: a quoted word,
+// optionally followed by '*' for a prefix match.
+var searchTerm = regexp.MustCompile(`"([^"]*)"(\*?)`)
+
+// JournalStore keeps journal entries in a mutex-protected map. It behaves
+// like the SQLite store: deleted entries go to the trash, updates save
+// revisions, listings are ordered the same way, and timestamps are kept at
+// millisecond precision. Search matches whole words or prefixes without
+// stemming.
+//
+// Entries are copied in and out, so callers can never modify stored state.
+type JournalStore struct {
+	mu             sync.RWMutex
+	entries        map[int64]*domain.JournalEntry
+	revisions      map[int64][]*domain.Revision
+	nextID         int64
+	nextRevisionID int64
+}
+
+// NewJournalStore creates a new instance of JournalStore.
+func NewJournalStore() *JournalStore {
+	return &JournalStore{
+		entries:   map[int64]*domain.JournalEntry{},
+		revisions: map[int64][]*domain.Revision{},
+	}
+}
+
+// Create stores a new journal entry.
+// A zero revealAt stores an entry that is readable immediately, and a nil doc
+// stores a plain-text entry. tags must already be normalized.
+func (s *JournalStore) Create(ctx context.Context, title, content string, revealAt time.Time, doc *domain.Document, tags []string) (*domain.JournalEntry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry := s.create(title, content, time.Now(), revealAt, doc, tags)
+	return cloneEntry(entry), nil
+}
+
+// create stores an entry written at createdAt. s.mu must be held.
+func (s *JournalStore) create(title, content string, createdAt, revealAt time.Time, doc *domain.Document, tags []string) *domain.JournalEntry {
+	s.nextID++
+	entry := &domain.JournalEntry{
+		ID:        s.nextID,
+		Title:     title,
+		Content:   content,
+		CreatedAt: timestamp(createdAt),
+		UpdatedAt: timestamp(createdAt),
+		Version:   1,
+		RevealAt:  timestamp(revealAt),
+		Document:  cloneDocument(doc),
+		Tags:      sortedTags(tags),
+	}
+	s.entries[entry.ID] = entry
+	return entry
+}
+
+// GetByID retrieves a journal entry by its ID.
+func (s *JournalStore) GetByID(ctx context.Context, id int64) (*domain.JournalEntry, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	entry, err := s.get(id)
+	if err != nil {
+		return nil, err
+	}
+	return cloneEntry(entry), nil
+}
+
+// get returns the stored entry with id if it is not in the trash. s.mu must
+// be held.
+func (s *JournalStore) get(id int64) (*domain.JournalEntry, error) {
+	entry, ok := s.entries[id]
+	if !ok || !entry.DeletedAt.IsZero() {
+		return nil, fmt.Errorf("journal entry %d: %w", id, domain.ErrNotFound)
+	}
+	return entry, nil
+}
+
+// Update modifies an existing journal entry. A nil doc turns the entry back
+// into plain text, and tags replace the entry's existing tags. The previous
+// title, content, and document are saved as a revision. A non-zero
+// expectedVersion must match the entry's version.
+func (s *JournalStore) Update(ctx context.Context, id int64, title, content string, doc *domain.Document, tags []string, expectedVersion int64) (*domain.JournalEntry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, err := s.get(id)
+	if err != nil {
+		return nil, err
+	}
+	if expectedVersion != 0 && entry.Version != expectedVersion {
+		return nil, fmt.Errorf("journal entry %d is at version %d, not %d: %w", id, entry.Version, expectedVersion, domain.ErrConflict)
+	}
+
+	s.updateContent(entry, title, content, doc)
+	entry.Tags = sortedTags(tags)
+	return cloneEntry(entry), nil
+}
+
+// updateContent saves the current version of entry as a revision and then
+// replaces its title, content, and document, incrementing its version. s.mu
+// must be held.
+func (s *JournalStore) updateContent(entry *domain.JournalEntry, title, content string, doc *domain.Document) {
+	s.nextRevisionID++
+	s.revisions[entry.ID] = append(s.revisions[entry.ID], &domain.Revision{
+		ID:        s.nextRevisionID,
+		EntryID:   entry.ID,
+		Title:     entry.Title,
+		Content:   entry.Content,
+		Document:  entry.Document,
+		CreatedAt: entry.UpdatedAt,
+	})
+
+	entry.Title = title
+	entry.Content = content
+	entry.Document = cloneDocument(doc)
+	entry.UpdatedAt = timestamp(time.Now())
+	entry.Version++
+}
+
+// Delete moves a journal entry to the trash.
+func (s *JournalStore) Delete(ctx context.Context, id int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.delete(id)
+}
+
+// delete moves the entry with id to the trash. s.mu must be held.
+func (s *JournalStore) delete(id int64) error {
+	entry, err := s.get(id)
+	if err != nil {
+		return err
+	}
+	entry.DeletedAt = timestamp(time.Now())
+	return nil
+}
+
+// List retrieves journal entries matching filter with pagination, newest
+// first. Returns the entries and the total count of all matching entries.
+func (s *JournalStore) List(ctx context.Context, filter domain.EntryFilter, limit, offset int) ([]*domain.JournalEntry, int64, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var matches []*domain.JournalEntry
+	for _, entry := range s.entries {
+		if matchesFilter(entry, filter) {
+			matches = append(matches, entry)
+		}
+	}
+	slices.SortFunc(matches, newestFirst)
+
+	return page(matches, limit, offset, cloneEntry), int64(len(matches)), nil
+}
+
+// Search retrieves journal entries matching a search query, best match
+// first. Title matches are weighted above content matches. Entries that are
+// still sealed are excluded so a match cannot reveal what they contain.
+// Returns the entries and the total count of all matching entries.
+func (s *JournalStore) Search(ctx context.Context, query string, limit, offset int) ([]*domain.JournalEntry, int64, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	terms := parseQuery(query)
+	now := time.Now()
+
+	scores := map[int64]int{}
+	var matches []*domain.JournalEntry
+	for _, entry := range s.entries {
+		if !entry.DeletedAt.IsZero() || entry.IsSealedAt(now) {
+			continue
+		}
+		if score, ok := scoreText(terms, entry.Title, entry.Content); ok {
+			scores[entry.ID] = score
+			matches = append(matches, entry)
+		}
+	}
+	slices.SortFunc(matches, func(a, b *domain.JournalEntry) int {
+		if scores[a.ID] != scores[b.ID] {
+			return scores[b.ID] - scores[a.ID]
+		}
+		return newestFirst(a, b)
+	})
+
+	return page(matches, limit, offset, cloneEntry), int64(len(matches)), nil
+}
+
+// VerifyArchive always fails, because the in-memory store does not keep the
+// entry archive.
+func (s *JournalStore) VerifyArchive(ctx context.Context) (*domain.ArchiveReport, error) {
+	return nil, errArchiveUnsupported
+}
+
+// matchesFilter reports whether entry is outside the trash and matches
+// filter.
+func matchesFilter(entry *domain.JournalEntry, filter domain.EntryFilter) bool {
+	if !entry.DeletedAt.IsZero() {
+		return false
+	}
+	if !filter.CreatedFrom.IsZero() && entry.CreatedAt.Before(filter.CreatedFrom) {
+		return false
+	}
+	if !filter.CreatedUntil.IsZero() && !entry.CreatedAt.Before(filter.CreatedUntil) {
+		return false
+	}
+	if filter.Tag != "" && !slices.Contains(entry.Tags, filter.Tag) {
+		return false
+	}
+	return true
+}
+
+// newestFirst orders entries by creation time, newest first, breaking ties by
+// ID like the SQL stores.
+func newestFirst(a, b *domain.JournalEntry) int {
+	if c := b.CreatedAt.Compare(a.CreatedAt); c != 0 {
+		return c
+	}
+	return compareIDs(b.ID, a.ID)
+}
+
+// compareIDs compares two IDs for sorting.
+func compareIDs(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// page returns copies of the items in the requested page.
+func page[T any](items []T, limit, offset int, clone func(T) T) []T {
+	if offset >= len(items) {
+		return nil
+	}
+	items = items[offset:min(offset+limit, len(items))]
+
+	result := make([]T, len(items))
+	for i, item := range items {
+		result[i] = clone(item)
+	}
+	return result
+}
+
+// searchQueryTerm is a parsed term of a store search query.
+type searchQueryTerm struct {
+	word   string
+	prefix bool
+}
+
+// parseQuery parses a store search query such as `"coffee" "morn"*`.
+func parseQuery(query string) []searchQueryTerm {
+	var terms []searchQueryTerm
+	for _, match := range searchTerm.FindAllStringSubmatch(query, -1) {
+		terms = append(terms, searchQueryTerm{word: strings.ToLower(match[1]), prefix: match[2] != ""})
+	}
+	return terms
+}
+
+// scoreText reports whether every term matches a word of title or content,
+// and scores the match with title matches weighted ten times content
+// matches.
+func scoreText(terms []searchQueryTerm, title, content string) (int, bool) {
+	if len(terms) == 0 {
+		return 0, false
+	}
+
+	titleWords, contentWords := words(title), words(content)
+	score := 0
+	for _, term := range terms {
+		titleHits, contentHits := countMatches(term, titleWords), countMatches(term, contentWords)
+		if titleHits+contentHits == 0 {
+			return 0, false
+		}
+		score += 10*titleHits + contentHits
+	}
+	return score, true
+}
+
+// words splits text into lowercase words of letters and numbers.
+func words(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+}
+
+// countMatches counts the words term matches.
+func countMatches(term searchQueryTerm, words []string) int {
+	count := 0
+	for _, word := range words {
+		if word == term.word || term.prefix && strings.HasPrefix(word, term.word) {
+			count++
+		}
+	}
+	return count
+}
+
+// timestamp drops t's monotonic clock reading and location and truncates it
+// to the millisecond precision the SQLite store keeps.
+func timestamp(t time.Time) time.Time {
+	if t.IsZero() {
+		return time.Time{}
+	}
+	return t.UTC().Truncate(time.Millisecond)
+}
+
+// sortedTags returns a sorted copy of tags, or nil if there are none.
+func sortedTags(tags []string) []string {
+	if len(tags) == 0 {
+		return nil
+	}
+	tags = slices.Clone(tags)
+	slices.Sort(tags)
+	return slices.Compact(tags)
+}
+
+// cloneEntry returns a deep copy of entry.
+func cloneEntry(entry *domain.JournalEntry) *domain.JournalEntry {
+	clone := *entry
+	clone.Document = cloneDocument(entry.Document)
+	clone.Tags = slices.Clone(entry.Tags)
+	return &clone
+}
+
+// cloneRevision returns a deep copy of revision.
+func cloneRevision(revision *domain.Revision) *domain.Revision {
+	clone := *revision
+	clone.Document = cloneDocument(revision.Document)
+	return &clone
+}
+
+// cloneDocument returns a deep copy of doc, or nil if doc is nil.
+func cloneDocument(doc *domain.Document) *domain.Document {
+	if doc == nil {
+		return nil
+	}
+	clone := &domain.Document{Version: doc.Version, Sections: slices.Clone(doc.Sections)}
+	for i := range clone.Sections {
+		clone.Sections[i].Items = slices.Clone(clone.Sections[i].Items)
+	}
+	return clone
+}
+
+// Load replaces the store's contents with entries and their revisions,
+// keeping their IDs, so the store can serve as the in-memory index of a
+// store that persists entries elsewhere. New IDs continue after the highest
+// loaded ID.
+func (s *JournalStore) Load(entries []*domain.JournalEntry, revisions []*domain.Revision) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.entries = make(map[int64]*domain.JournalEntry, len(entries))
+	s.revisions = map[int64][]*domain.Revision{}
+	s.nextID, s.nextRevisionID = 0, 0
+
+	for _, entry := range entries {
+		s.entries[entry.ID] = cloneEntry(entry)
+		s.nextID = max(s.nextID, entry.ID)
+	}
+
+	revisions = slices.Clone(revisions)
+	slices.SortFunc(revisions, func(a, b *domain.Revision) int { return compareIDs(a.ID, b.ID) })
+	for _, revision := range revisions {
+		s.revisions[revision.EntryID] = append(s.revisions[revision.EntryID], cloneRevision(revision))
+		s.nextRevisionID = max(s.nextRevisionID, revision.ID)
+	}
+}
+
+// Entries returns a copy of every entry, including entries in the trash,
+// ordered by ID.
+func (s *JournalStore) Entries() []*domain.JournalEntry {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	entries := make([]*domain.JournalEntry, 0, len(s.entries))
+	for _, entry := range s.entries {
+		entries = append(entries, cloneEntry(entry))
+	}
+	slices.SortFunc(entries, func(a, b *domain.JournalEntry) int { return compareIDs(a.ID, b.ID) })
+	return entries
+}
+
+// Entry returns a copy of the entry with id, including an entry in the
+// trash, and whether it exists.
+func (s *JournalStore) Entry(id int64) (*domain.JournalEntry, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	entry, ok := s.entries[id]
+	if !ok {
+		return nil, false
+	}
+	return cloneEntry(entry), true
+}
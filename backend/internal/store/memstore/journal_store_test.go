@@ -0,0 +1,314 @@
+package memstore
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/parkernilson/micro-journal/internal/domain"
+)
+
+func TestJournalStore_CreateUpdateDelete(t *testing.T) {
+	var store domain.JournalStore = NewJournalStore()
+	ctx := context.Background()
+
+	doc := &domain.Document{Sections: []domain.Section{{Type: domain.SectionText, Text: "Hello"}}}
+	entry, err := store.Create(ctx, "Title", "Content", time.Time{}, doc, []string{"work", "home"})
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if entry.ID != 1 || !reflect.DeepEqual(entry.Tags, []string{"home", "work"}) {
+		t.Errorf("Unexpected created entry %+v", entry)
+	}
+
+	t.Run("returned entries are copies", func(t *testing.T) {
+		entry.Tags[0] = "changed"
+		doc.Sections[0].Text = "Changed"
+
+		got, err := store.GetByID(ctx, entry.ID)
+		if err != nil {
+			t.Fatalf("GetByID failed: %v", err)
+		}
+		if got.Tags[0] != "home" || got.Document.Sections[0].Text != "Hello" {
+			t.Errorf("Expected stored entry to be unaffected, got %+v", got)
+		}
+	})
+
+	updated, err := store.Update(ctx, entry.ID, "New Title", "New Content", nil, []string{"work"}, 0)
+	if err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	if updated.Title != "New Title" || updated.Document != nil || updated.Version != 2 {
+		t.Errorf("Unexpected updated entry %+v", updated)
+	}
+
+	t.Run("stale version", func(t *testing.T) {
+		_, err := store.Update(ctx, entry.ID, "Stale Title", "Stale Content", nil, nil, entry.Version)
+		if !errors.Is(err, domain.ErrConflict) {
+			t.Errorf("Expected conflict for stale version, got %v", err)
+		}
+	})
+
+	revisions, total, err := store.ListRevisions(ctx, entry.ID, 10, 0)
+	if err != nil {
+		t.Fatalf("ListRevisions failed: %v", err)
+	}
+	if total != 1 || revisions[0].Title != "Title" || revisions[0].Document == nil {
+		t.Fatalf("Expected the original version as a revision, got %d: %+v", total, revisions)
+	}
+
+	restored, err := store.RestoreRevision(ctx, entry.ID, revisions[0].ID)
+	if err != nil {
+		t.Fatalf("RestoreRevision failed: %v", err)
+	}
+	if restored.Title != "Title" {
+		t.Errorf("Unexpected restored entry %+v", restored)
+	}
+	if _, total, _ := store.ListRevisions(ctx, entry.ID, 10, 0); total != 2 {
+		t.Errorf("Expected the restore to save a revision, got %d", total)
+	}
+
+	if err := store.Delete(ctx, entry.ID); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if _, err := store.GetByID(ctx, entry.ID); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("Expected deleted entry to be hidden, got %v", err)
+	}
+	if _, err := store.Update(ctx, entry.ID, "Title", "Content", nil, nil, 0); err == nil {
+		t.Error("Expected updating an entry in the trash to fail")
+	}
+
+	trash, total, err := store.ListTrash(ctx, 10, 0)
+	if err != nil {
+		t.Fatalf("ListTrash failed: %v", err)
+	}
+	if total != 1 || trash[0].DeletedAt.IsZero() {
+		t.Errorf("Expected the entry in the trash, got %d: %+v", total, trash)
+	}
+
+	purged, err := store.PurgeTrash(ctx, time.Time{})
+	if err != nil {
+		t.Fatalf("PurgeTrash failed: %v", err)
+	}
+	if purged != 1 {
+		t.Errorf("Expected 1 purged entry, got %d", purged)
+	}
+	if _, total, _ := store.ListRevisions(ctx, entry.ID, 10, 0); total != 0 {
+		t.Errorf("Expected revisions to be purged with the entry, got %d", total)
+	}
+}
+
+func TestJournalStore_VerifyArchive(t *testing.T) {
+	store := NewJournalStore()
+	if _, err := store.VerifyArchive(context.Background()); !errors.Is(err, domain.ErrFailedPrecondition) {
+		t.Errorf("Expected ErrFailedPrecondition, got %v", err)
+	}
+}
+
+func TestJournalStore_WithTx(t *testing.T) {
+	store := NewJournalStore()
+	ctx := context.Background()
+
+	existing, _ := store.Create(ctx, "Existing", "Content", time.Time{}, nil, nil)
+	store.Update(ctx, existing.ID, "Existing", "Edited", nil, nil, 0)
+
+	err := store.WithTx(ctx, func(tx domain.JournalTx) error {
+		if _, err := tx.Create(ctx, "Rolled back", "Content", time.Time{}, nil, nil); err != nil {
+			return err
+		}
+		if err := tx.Delete(ctx, existing.ID); err != nil {
+			return err
+		}
+		return tx.Purge(ctx, existing.ID)
+	})
+	if err != nil {
+		t.Fatalf("WithTx failed: %v", err)
+	}
+	if _, total, _ := store.List(ctx, domain.EntryFilter{}, 10, 0); total != 1 {
+		t.Errorf("Expected the created entry only, got %d entries", total)
+	}
+
+	t.Run("rolls back when fn fails", func(t *testing.T) {
+		store := NewJournalStore()
+		existing, _ := store.Create(ctx, "Existing", "Content", time.Time{}, nil, nil)
+		store.Update(ctx, existing.ID, "Existing", "Edited", nil, nil, 0)
+
+		failure := errors.New("failure")
+		err := store.WithTx(ctx, func(tx domain.JournalTx) error {
+			tx.Create(ctx, "Rolled back", "Content", time.Time{}, nil, nil)
+			tx.Purge(ctx, existing.ID)
+			return failure
+		})
+		if !errors.Is(err, failure) {
+			t.Fatalf("Expected the error from fn, got %v", err)
+		}
+
+		got, err := store.GetByID(ctx, existing.ID)
+		if err != nil || got.Content != "Edited" {
+			t.Errorf("Expected the purged entry to be restored, got %+v (%v)", got, err)
+		}
+		if _, total, _ := store.ListRevisions(ctx, existing.ID, 10, 0); total != 1 {
+			t.Errorf("Expected its revisions to be restored, got %d", total)
+		}
+		if next, _ := store.Create(ctx, "Next", "Content", time.Time{}, nil, nil); next.ID != 2 {
+			t.Errorf("Expected IDs to be reused after a rollback, got %d", next.ID)
+		}
+	})
+}
+
+func TestJournalStore_ListAndSearch(t *testing.T) {
+	store := NewJournalStore()
+	ctx := context.Background()
+
+	base := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	entries := []*domain.JournalEntry{
+		{Title: "Morning coffee", Content: "A slow start", CreatedAt: base, Tags: []string{"daily"}},
+		{Title: "Evening walk", Content: "Coffee afterwards", CreatedAt: base.Add(time.Hour), Tags: []string{"daily"}},
+		{Title: "Same time", Content: "Tie", CreatedAt: base.Add(time.Hour)},
+		{Title: "Sealed coffee", Content: "Secret", CreatedAt: base, RevealAt: time.Now().Add(time.Hour)},
+	}
+	if _, err := store.Import(ctx, entries); err != nil {
+		t.Fatalf("Import failed: %v", err)
+	}
+
+	t.Run("newest first with ties broken by ID", func(t *testing.T) {
+		got, total, err := store.List(ctx, domain.EntryFilter{}, 3, 0)
+		if err != nil {
+			t.Fatalf("List failed: %v", err)
+		}
+		var titles []string
+		for _, entry := range got {
+			titles = append(titles, entry.Title)
+		}
+		if total != 4 || !reflect.DeepEqual(titles, []string{"Same time", "Evening walk", "Sealed coffee"}) {
+			t.Errorf("Unexpected order %v of %d", titles, total)
+		}
+
+		next, _, _ := store.List(ctx, domain.EntryFilter{}, 3, 3)
+		if len(next) != 1 || next[0].Title != "Morning coffee" {
+			t.Errorf("Unexpected second page %+v", next)
+		}
+	})
+
+	t.Run("filters", func(t *testing.T) {
+		filter := domain.EntryFilter{Tag: "daily", CreatedFrom: base, CreatedUntil: base.Add(time.Hour)}
+		got, total, err := store.List(ctx, filter, 10, 0)
+		if err != nil {
+			t.Fatalf("List failed: %v", err)
+		}
+		if total != 1 || got[0].Title != "Morning coffee" {
+			t.Errorf("Expected only the morning entry, got %d: %+v", total, got)
+		}
+	})
+
+	t.Run("search ranks title matches first and skips sealed entries", func(t *testing.T) {
+		got, total, err := store.Search(ctx, `"coff"*`, 10, 0)
+		if err != nil {
+			t.Fatalf("Search failed: %v", err)
+		}
+		if total != 2 || got[0].Title != "Morning coffee" {
+			t.Errorf("Unexpected search results %d: %+v", total, got)
+		}
+
+		if _, total, _ := store.Search(ctx, `"coff"`, 10, 0); total != 0 {
+			t.Errorf("Expected a term without '*' to match whole words only, got %d", total)
+		}
+	})
+
+	t.Run("import skips duplicates", func(t *testing.T) {
+		imported, err := store.Import(ctx, entries[:1])
+		if err != nil {
+			t.Fatalf("Import failed: %v", err)
+		}
+		if imported != 0 {
+			t.Errorf("Expected duplicate to be skipped, got %d imported", imported)
+		}
+	})
+
+	t.Run("export oldest first", func(t *testing.T) {
+		var titles []string
+		err := store.Export(ctx, func(entry *domain.JournalEntry) error {
+			titles = append(titles, entry.Title)
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("Export failed: %v", err)
+		}
+		if !reflect.DeepEqual(titles, []string{"Morning coffee", "Sealed coffee", "Evening walk", "Same time"}) {
+			t.Errorf("Unexpected export order %v", titles)
+		}
+	})
+}
+
+func TestJournalStore_Cancelled(t *testing.T) {
+	store := NewJournalStore()
+	for _, title := range []string{"First", "Second", "Third"} {
+		store.Create(context.Background(), title, "Content", time.Time{}, nil, nil)
+	}
+
+	t.Run("export stops when the client goes away", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		exported := 0
+		err := store.Export(ctx, func(entry *domain.JournalEntry) error {
+			exported++
+			cancel()
+			return nil
+		})
+		if !errors.Is(err, context.Canceled) || exported != 1 {
+			t.Errorf("Expected context.Canceled after 1 entry, got %v after %d", err, exported)
+		}
+	})
+
+	t.Run("import stores nothing", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := store.Import(ctx, []*domain.JournalEntry{{Title: "Old", Content: "Content", CreatedAt: time.Now()}})
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("Expected context.Canceled, got %v", err)
+		}
+		if _, total, _ := store.List(context.Background(), domain.EntryFilter{}, 10, 0); total != 3 {
+			t.Errorf("Expected nothing imported, got %d entries", total)
+		}
+	})
+}
+
+func TestJournalStore_Tags(t *testing.T) {
+	store := NewJournalStore()
+	ctx := context.Background()
+
+	first, _ := store.Create(ctx, "First", "Content", time.Time{}, nil, []string{"work"})
+	store.Create(ctx, "Second", "Content", time.Time{}, nil, []string{"job", "work"})
+	store.Delete(ctx, first.ID)
+
+	tags, err := store.ListTags(ctx)
+	if err != nil {
+		t.Fatalf("ListTags failed: %v", err)
+	}
+	want := []*domain.Tag{{Name: "job", EntryCount: 1}, {Name: "work", EntryCount: 1}}
+	if !reflect.DeepEqual(tags, want) {
+		t.Errorf("Expected %+v, got %+v", want, tags)
+	}
+
+	tag, err := store.RenameTag(ctx, "job", "work")
+	if err != nil {
+		t.Fatalf("RenameTag failed: %v", err)
+	}
+	if tag.EntryCount != 1 {
+		t.Errorf("Expected merged tag on 1 entry, got %d", tag.EntryCount)
+	}
+
+	if err := store.DeleteTag(ctx, "work"); err != nil {
+		t.Fatalf("DeleteTag failed: %v", err)
+	}
+	if err := store.DeleteTag(ctx, "work"); err == nil {
+		t.Error("Expected deleting a missing tag to fail")
+	}
+	if tags, _ := store.ListTags(ctx); len(tags) != 0 {
+		t.Errorf("Expected no tags left, got %+v", tags)
+	}
+}
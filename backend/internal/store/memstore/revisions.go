@@ -0,0 +1,70 @@
+package memstore
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/parkernilson/micro-journal/internal/domain"
+)
+
+// ListRevisions retrieves the saved revisions of an entry, newest first.
+// Returns the revisions and the total count of revisions of the entry.
+func (s *JournalStore) ListRevisions(ctx context.Context, entryID int64, limit, offset int) ([]*domain.Revision, int64, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	revisions := slices.Clone(s.revisions[entryID])
+	slices.Reverse(revisions)
+
+	return page(revisions, limit, offset, cloneRevision), int64(len(revisions)), nil
+}
+
+// SearchRevisions retrieves the revisions of an entry matching a search
+// query, best match first. Title matches are weighted above content matches.
+// Returns the revisions and the total count of matching revisions.
+func (s *JournalStore) SearchRevisions(ctx context.Context, entryID int64, query string, limit, offset int) ([]*domain.Revision, int64, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	terms := parseQuery(query)
+
+	scores := map[int64]int{}
+	var matches []*domain.Revision
+	for _, revision := range s.revisions[entryID] {
+		if score, ok := scoreText(terms, revision.Title, revision.Content); ok {
+			scores[revision.ID] = score
+			matches = append(matches, revision)
+		}
+	}
+	slices.SortFunc(matches, func(a, b *domain.Revision) int {
+		if scores[a.ID] != scores[b.ID] {
+			return scores[b.ID] - scores[a.ID]
+		}
+		return compareIDs(b.ID, a.ID)
+	})
+
+	return page(matches, limit, offset, cloneRevision), int64(len(matches)), nil
+}
+
+// RestoreRevision replaces an entry's title, content, and document with
+// those of one of its revisions. The version being replaced is saved as a
+// new revision, so a restore can itself be undone.
+func (s *JournalStore) RestoreRevision(ctx context.Context, entryID, revisionID int64) (*domain.JournalEntry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := slices.IndexFunc(s.revisions[entryID], func(r *domain.Revision) bool { return r.ID == revisionID })
+	if i < 0 {
+		return nil, fmt.Errorf("journal entry revision %d: %w", revisionID, domain.ErrNotFound)
+	}
+	revision := s.revisions[entryID][i]
+
+	entry, err := s.get(entryID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.updateContent(entry, revision.Title, revision.Content, revision.Document)
+	return cloneEntry(entry), nil
+}
@@ -0,0 +1,98 @@
+package memstore
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/parkernilson/micro-journal/internal/domain"
+)
+
+// ListTags retrieves every tag with the number of entries that use it,
+// sorted by name. Entries in the trash are not counted, but their tags are
+// listed until the entries are purged.
+func (s *JournalStore) ListTags(ctx context.Context) ([]*domain.Tag, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	counts := map[string]int64{}
+	for _, entry := range s.entries {
+		for _, name := range entry.Tags {
+			if entry.DeletedAt.IsZero() {
+				counts[name]++
+			} else if _, ok := counts[name]; !ok {
+				counts[name] = 0
+			}
+		}
+	}
+
+	var tags []*domain.Tag
+	for name, count := range counts {
+		tags = append(tags, &domain.Tag{Name: name, EntryCount: count})
+	}
+	slices.SortFunc(tags, func(a, b *domain.Tag) int {
+		if a.Name < b.Name {
+			return -1
+		}
+		if a.Name > b.Name {
+			return 1
+		}
+		return 0
+	})
+
+	return tags, nil
+}
+
+// RenameTag renames a tag on every entry that uses it. If a tag named
+// newName already exists, the two tags are merged.
+func (s *JournalStore) RenameTag(ctx context.Context, name, newName string) (*domain.Tag, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.hasTag(name) {
+		return nil, fmt.Errorf("tag %q: %w", name, domain.ErrNotFound)
+	}
+
+	tag := &domain.Tag{Name: newName}
+	for _, entry := range s.entries {
+		if i := slices.Index(entry.Tags, name); i >= 0 {
+			entry.Tags[i] = newName
+			entry.Tags = sortedTags(entry.Tags)
+		}
+		if entry.DeletedAt.IsZero() && slices.Contains(entry.Tags, newName) {
+			tag.EntryCount++
+		}
+	}
+
+	return tag, nil
+}
+
+// DeleteTag removes a tag from every entry that uses it.
+func (s *JournalStore) DeleteTag(ctx context.Context, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.hasTag(name) {
+		return fmt.Errorf("tag %q: %w", name, domain.ErrNotFound)
+	}
+
+	for _, entry := range s.entries {
+		entry.Tags = slices.DeleteFunc(entry.Tags, func(tag string) bool { return tag == name })
+		if len(entry.Tags) == 0 {
+			entry.Tags = nil
+		}
+	}
+
+	return nil
+}
+
+// hasTag reports whether any entry, including those in the trash, has the
+// tag called name. s.mu must be held.
+func (s *JournalStore) hasTag(name string) bool {
+	for _, entry := range s.entries {
+		if slices.Contains(entry.Tags, name) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,86 @@
+package memstore
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/parkernilson/micro-journal/internal/domain"
+)
+
+// ListTrash retrieves entries in the trash, most recently deleted first.
+// Returns the entries and the total count of entries in the trash.
+func (s *JournalStore) ListTrash(ctx context.Context, limit, offset int) ([]*domain.JournalEntry, int64, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var trash []*domain.JournalEntry
+	for _, entry := range s.entries {
+		if !entry.DeletedAt.IsZero() {
+			trash = append(trash, entry)
+		}
+	}
+	slices.SortFunc(trash, func(a, b *domain.JournalEntry) int {
+		if c := b.DeletedAt.Compare(a.DeletedAt); c != 0 {
+			return c
+		}
+		return compareIDs(b.ID, a.ID)
+	})
+
+	return page(trash, limit, offset, cloneEntry), int64(len(trash)), nil
+}
+
+// Restore moves a journal entry out of the trash.
+func (s *JournalStore) Restore(ctx context.Context, id int64) (*domain.JournalEntry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, ok := s.entries[id]
+	if !ok || entry.DeletedAt.IsZero() {
+		return nil, fmt.Errorf("journal entry %d in the trash: %w", id, domain.ErrNotFound)
+	}
+
+	entry.DeletedAt = time.Time{}
+	return cloneEntry(entry), nil
+}
+
+// Purge permanently removes a journal entry, whether or not it is in the
+// trash, along with its revisions.
+func (s *JournalStore) Purge(ctx context.Context, id int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.purge(id)
+}
+
+// purge removes the entry with id and its revisions. s.mu must be held.
+func (s *JournalStore) purge(id int64) error {
+	if _, ok := s.entries[id]; !ok {
+		return fmt.Errorf("journal entry %d: %w", id, domain.ErrNotFound)
+	}
+
+	delete(s.entries, id)
+	delete(s.revisions, id)
+	return nil
+}
+
+// PurgeTrash permanently removes entries that were moved to the trash before
+// deletedBefore, or every entry in the trash if deletedBefore is zero.
+// Returns the number of entries removed.
+func (s *JournalStore) PurgeTrash(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var purged int64
+	for id, entry := range s.entries {
+		if entry.DeletedAt.IsZero() || !deletedBefore.IsZero() && !entry.DeletedAt.Before(deletedBefore) {
+			continue
+		}
+		delete(s.entries, id)
+		delete(s.revisions, id)
+		purged++
+	}
+
+	return purged, nil
+}
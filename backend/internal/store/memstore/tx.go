@@ -0,0 +1,57 @@
+package memstore
+
+import (
+	"context"
+	"maps"
+	"time"
+
+	"github.com/parkernilson/micro-journal/internal/domain"
+)
+
+// WithTx runs fn with the store locked. If fn returns an error, the entries
+// and revisions are restored to what they were before fn ran.
+func (s *JournalStore) WithTx(ctx context.Context, fn func(tx domain.JournalTx) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Entries are changed in place, so they are copied; revisions are only
+	// removed within a transaction, so their slices can be shared
+	entries := make(map[int64]*domain.JournalEntry, len(s.entries))
+	for id, entry := range s.entries {
+		entries[id] = cloneEntry(entry)
+	}
+	revisions := maps.Clone(s.revisions)
+	nextID := s.nextID
+
+	if err := fn(&journalTx{store: s}); err != nil {
+		s.entries, s.revisions, s.nextID = entries, revisions, nextID
+		return err
+	}
+	return nil
+}
+
+// journalTx is the domain.JournalTx passed to fn by WithTx. Its methods run
+// with the store's lock already held.
+type journalTx struct {
+	store *JournalStore
+}
+
+func (t *journalTx) Create(ctx context.Context, title, content string, revealAt time.Time, doc *domain.Document, tags []string) (*domain.JournalEntry, error) {
+	return cloneEntry(t.store.create(title, content, time.Now(), revealAt, doc, tags)), nil
+}
+
+func (t *journalTx) GetByID(ctx context.Context, id int64) (*domain.JournalEntry, error) {
+	entry, err := t.store.get(id)
+	if err != nil {
+		return nil, err
+	}
+	return cloneEntry(entry), nil
+}
+
+func (t *journalTx) Delete(ctx context.Context, id int64) error {
+	return t.store.delete(id)
+}
+
+func (t *journalTx) Purge(ctx context.Context, id int64) error {
+	return t.store.purge(id)
+}
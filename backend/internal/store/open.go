@@ -0,0 +1,32 @@
+package store
+
+import (
+	"database/sql"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/uptrace/opentelemetry-go-extra/otelsql"
+)
+
+// Open opens the SQLite database file at path with the settings the store
+// relies on, applied to every connection: WAL journal mode so readers do not
+// block the writer, a busy timeout so a locked database is waited on instead
+// of failing immediately, and foreign key enforcement.
+//
+// With foreign keys enforced, a migration that rebuilds a table other tables
+// reference must turn them off first, or dropping the old table cascades.
+//
+// Statements are traced with the global OpenTelemetry tracer provider.
+func Open(path string, busyTimeout time.Duration) (*sql.DB, error) {
+	pragmas := url.Values{}
+	pragmas.Add("_pragma", "journal_mode(WAL)")
+	pragmas.Add("_pragma", fmt.Sprintf("busy_timeout(%d)", busyTimeout.Milliseconds()))
+	pragmas.Add("_pragma", "foreign_keys(ON)")
+
+	db, err := otelsql.Open("sqlite", path+"?"+pragmas.Encode(), otelsql.WithDBSystem("sqlite"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+	return db, nil
+}
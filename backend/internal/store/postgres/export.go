@@ -0,0 +1,56 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/parkernilson/micro-journal/internal/domain"
+)
+
+// exportBatchSize is the number of entries read per query while exporting.
+const exportBatchSize = 100
+
+// Export calls fn for every entry that is not in the trash, oldest first,
+// stopping at the first error fn returns or when ctx is done.
+// Entries are read in batches and no query is open while fn runs, so fn may
+// use the store and a slow consumer does not hold a read open.
+func (s *JournalStore) Export(ctx context.Context, fn func(entry *domain.JournalEntry) error) error {
+	query := `
+		SELECT ` + entryColumns + `
+		FROM journal_entries
+		WHERE deleted_at IS NULL AND (created_at, id) > ($1, $2)
+		ORDER BY created_at, id
+		LIMIT $3
+	`
+
+	lastCreatedAt, lastID := time.Time{}, int64(0)
+	for {
+		rows, err := s.db.QueryContext(ctx, query, lastCreatedAt, lastID, exportBatchSize)
+		if err != nil {
+			return fmt.Errorf("failed to query journal entries: %w", err)
+		}
+		entries, err := scanEntries(rows)
+		if err != nil {
+			return err
+		}
+		if err := loadTags(ctx, s.db, entries); err != nil {
+			return err
+		}
+
+		for _, entry := range entries {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := fn(entry); err != nil {
+				return err
+			}
+		}
+
+		if len(entries) < exportBatchSize {
+			return nil
+		}
+		last := entries[len(entries)-1]
+		lastCreatedAt, lastID = last.CreatedAt, last.ID
+	}
+}
@@ -0,0 +1,466 @@
+// Package postgres implements the journal store on PostgreSQL, for
+// deployments that want a multi-connection database server instead of a
+// single SQLite file. Its schema is applied by migrations.ApplyPostgres.
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	// Register the "pgx" database/sql driver
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/uptrace/opentelemetry-go-extra/otelsql"
+
+	"github.com/parkernilson/micro-journal/internal/domain"
+)
+
+// entryColumns is the column list scanned by scanEntry.
+const entryColumns = "id, title, content, created_at, updated_at, version, reveal_at, document, deleted_at"
+
+// errArchiveUnsupported is returned by VerifyArchive. The hash-chained entry
+// archive relies on SQLite's single writer to keep the chain in order.
+var errArchiveUnsupported = fmt.Errorf("the entry archive is not supported by the PostgreSQL store: %w", domain.ErrFailedPrecondition)
+
+// querier is satisfied by both *sql.DB and *sql.Tx, so reads can run either
+// standalone or inside the transaction that performed a write.
+type querier interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// execer is satisfied by both *sql.DB and *sql.Tx, so writes that need no
+// transaction of their own can also run inside one.
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+// JournalStore handles data access operations for journal entries stored in
+// PostgreSQL. It behaves like the SQLite store, including the trash, tags,
+// and revisions, except that the entry archive is not supported.
+type JournalStore struct {
+	db *sql.DB
+}
+
+// Open opens a connection pool to the PostgreSQL database described by dsn,
+// either a URL or a keyword/value connection string. Statements are traced
+// with the global OpenTelemetry tracer provider.
+func Open(dsn string) (*sql.DB, error) {
+	db, err := otelsql.Open("pgx", dsn, otelsql.WithDBSystem("postgresql"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+	return db, nil
+}
+
+// NewJournalStore creates a new instance of JournalStore.
+func NewJournalStore(db *sql.DB) *JournalStore {
+	return &JournalStore{db: db}
+}
+
+// Create inserts a new journal entry into the database.
+// A zero revealAt stores an entry that is readable immediately, and a nil doc
+// stores a plain-text entry. tags must already be normalized.
+// The insert and the read of the created row happen in one transaction.
+func (s *JournalStore) Create(ctx context.Context, title, content string, revealAt time.Time, doc *domain.Document, tags []string) (*domain.JournalEntry, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	entry, err := create(ctx, tx, title, content, revealAt, doc, tags)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return entry, nil
+}
+
+// create inserts a new journal entry using tx and reads it back.
+func create(ctx context.Context, tx *sql.Tx, title, content string, revealAt time.Time, doc *domain.Document, tags []string) (*domain.JournalEntry, error) {
+	document, err := marshalDocument(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := createWithTimestamp(ctx, tx, title, content, time.Now(), revealAt, document, tags)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch the created entry to get accurate timestamps
+	return getByID(ctx, tx, id)
+}
+
+// createWithTimestamp inserts an entry written at createdAt and sets its
+// tags. Returns the new entry's ID.
+func createWithTimestamp(ctx context.Context, tx *sql.Tx, title, content string, createdAt, revealAt time.Time, document sql.NullString, tags []string) (int64, error) {
+	query := `
+		INSERT INTO journal_entries (title, content, created_at, updated_at, reveal_at, document)
+		VALUES ($1, $2, $3, $3, $4, $5)
+		RETURNING id
+	`
+
+	var id int64
+	err := tx.QueryRowContext(ctx, query, title, content, createdAt.UTC(), nullTime(revealAt), document).Scan(&id)
+	if err != nil {
+		return 0, fmt.Errorf("failed to insert journal entry: %w", err)
+	}
+
+	if err := setTags(ctx, tx, id, tags); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// GetByID retrieves a journal entry by its ID.
+func (s *JournalStore) GetByID(ctx context.Context, id int64) (*domain.JournalEntry, error) {
+	return getByID(ctx, s.db, id)
+}
+
+// getByID retrieves a journal entry that is not in the trash by its ID using
+// the given querier.
+func getByID(ctx context.Context, q querier, id int64) (*domain.JournalEntry, error) {
+	query := `
+		SELECT ` + entryColumns + `
+		FROM journal_entries
+		WHERE id = $1 AND deleted_at IS NULL
+	`
+
+	entry, err := scanEntry(q.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("journal entry %d: %w", id, domain.ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get journal entry: %w", err)
+	}
+
+	if err := loadTags(ctx, q, []*domain.JournalEntry{entry}); err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// Update modifies an existing journal entry. A nil doc turns the entry back
+// into plain text, and tags replace the entry's existing tags. The previous
+// title, content, and document are saved as a revision. A non-zero
+// expectedVersion must match the entry's version.
+// The update and the read of the modified row happen in one transaction.
+func (s *JournalStore) Update(ctx context.Context, id int64, title, content string, doc *domain.Document, tags []string, expectedVersion int64) (*domain.JournalEntry, error) {
+	document, err := marshalDocument(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := updateContent(ctx, tx, id, expectedVersion, title, content, document); err != nil {
+		return nil, err
+	}
+
+	if err := setTags(ctx, tx, id, tags); err != nil {
+		return nil, err
+	}
+
+	entry, err := getByID(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return entry, nil
+}
+
+// updateContent saves the current version of an entry as a revision and then
+// replaces its title, content, and document, incrementing its version. The
+// entry row is locked first so concurrent updates save their revisions in
+// order and check the version they expect against the latest one. A non-zero
+// expectedVersion must match the entry's version.
+func updateContent(ctx context.Context, tx *sql.Tx, id, expectedVersion int64, title, content string, document sql.NullString) error {
+	var version int64
+	lockQuery := `SELECT version FROM journal_entries WHERE id = $1 AND deleted_at IS NULL FOR UPDATE`
+	err := tx.QueryRowContext(ctx, lockQuery, id).Scan(&version)
+	if err == sql.ErrNoRows {
+		return fmt.Errorf("journal entry %d: %w", id, domain.ErrNotFound)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to lock journal entry: %w", err)
+	}
+	if expectedVersion != 0 && version != expectedVersion {
+		return fmt.Errorf("journal entry %d is at version %d, not %d: %w", id, version, expectedVersion, domain.ErrConflict)
+	}
+
+	saveQuery := `
+		INSERT INTO entry_revisions (entry_id, title, content, document, created_at)
+		SELECT id, title, content, document, updated_at
+		FROM journal_entries
+		WHERE id = $1
+	`
+	if _, err := tx.ExecContext(ctx, saveQuery, id); err != nil {
+		return fmt.Errorf("failed to save journal entry revision: %w", err)
+	}
+
+	updateQuery := `
+		UPDATE journal_entries
+		SET title = $1, content = $2, document = $3, updated_at = $4, version = version + 1
+		WHERE id = $5
+	`
+	if _, err := tx.ExecContext(ctx, updateQuery, title, content, document, time.Now().UTC(), id); err != nil {
+		return fmt.Errorf("failed to update journal entry: %w", err)
+	}
+
+	return nil
+}
+
+// Delete moves a journal entry to the trash.
+func (s *JournalStore) Delete(ctx context.Context, id int64) error {
+	return deleteEntry(ctx, s.db, id)
+}
+
+// deleteEntry moves a journal entry to the trash using the given execer.
+func deleteEntry(ctx context.Context, e execer, id int64) error {
+	query := `UPDATE journal_entries SET deleted_at = $1 WHERE id = $2 AND deleted_at IS NULL`
+
+	result, err := e.ExecContext(ctx, query, time.Now().UTC(), id)
+	if err != nil {
+		return fmt.Errorf("failed to delete journal entry: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rows == 0 {
+		return fmt.Errorf("journal entry %d: %w", id, domain.ErrNotFound)
+	}
+
+	return nil
+}
+
+// List retrieves journal entries matching filter with pagination.
+// Returns the entries and the total count of all matching entries.
+func (s *JournalStore) List(ctx context.Context, filter domain.EntryFilter, limit, offset int) ([]*domain.JournalEntry, int64, error) {
+	where, args := filterClause(filter)
+
+	// Get total count
+	var totalCount int64
+	countQuery := `SELECT COUNT(*) FROM journal_entries` + where
+	err := s.db.QueryRowContext(ctx, countQuery, args...).Scan(&totalCount)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count journal entries: %w", err)
+	}
+
+	// Get paginated entries
+	query := `
+		SELECT ` + entryColumns + `
+		FROM journal_entries` + where + `
+		ORDER BY created_at DESC, id DESC
+		LIMIT ` + placeholder(len(args)+1) + ` OFFSET ` + placeholder(len(args)+2)
+
+	rows, err := s.db.QueryContext(ctx, query, append(args, limit, offset)...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to query journal entries: %w", err)
+	}
+
+	entries, err := scanEntries(rows)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if err := loadTags(ctx, s.db, entries); err != nil {
+		return nil, 0, err
+	}
+
+	return entries, totalCount, nil
+}
+
+// Search retrieves journal entries matching a search query, best match
+// first. Title matches are weighted above content matches. Entries that are
+// still sealed are excluded so a match cannot reveal what they contain.
+// Returns the entries and the total count of all matching entries.
+func (s *JournalStore) Search(ctx context.Context, query string, limit, offset int) ([]*domain.JournalEntry, int64, error) {
+	matches := `
+		FROM journal_entries, to_tsquery('english', $1) AS q
+		WHERE search @@ q AND deleted_at IS NULL AND (reveal_at IS NULL OR reveal_at <= $2)
+	`
+	tsQuery := toTSQuery(query)
+	now := time.Now().UTC()
+
+	// Get total count
+	var totalCount int64
+	err := s.db.QueryRowContext(ctx, `SELECT COUNT(*)`+matches, tsQuery, now).Scan(&totalCount)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count matching journal entries: %w", err)
+	}
+
+	// Get paginated entries
+	selectQuery := `
+		SELECT ` + entryColumns + matches + `
+		ORDER BY ts_rank(search, q) DESC, created_at DESC, id DESC
+		LIMIT $3 OFFSET $4
+	`
+
+	rows, err := s.db.QueryContext(ctx, selectQuery, tsQuery, now, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to search journal entries: %w", err)
+	}
+
+	entries, err := scanEntries(rows)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if err := loadTags(ctx, s.db, entries); err != nil {
+		return nil, 0, err
+	}
+
+	return entries, totalCount, nil
+}
+
+// VerifyArchive always fails, because the PostgreSQL store does not keep the
+// entry archive.
+func (s *JournalStore) VerifyArchive(ctx context.Context) (*domain.ArchiveReport, error) {
+	return nil, errArchiveUnsupported
+}
+
+// filterClause builds a WHERE clause and its arguments for filter, numbering
+// placeholders from $1. Entries in the trash never match.
+func filterClause(filter domain.EntryFilter) (string, []any) {
+	conditions := []string{"deleted_at IS NULL"}
+	var args []any
+
+	if !filter.CreatedFrom.IsZero() {
+		args = append(args, filter.CreatedFrom.UTC())
+		conditions = append(conditions, "created_at >= "+placeholder(len(args)))
+	}
+	if !filter.CreatedUntil.IsZero() {
+		args = append(args, filter.CreatedUntil.UTC())
+		conditions = append(conditions, "created_at < "+placeholder(len(args)))
+	}
+	if filter.Tag != "" {
+		args = append(args, filter.Tag)
+		conditions = append(conditions, `id IN (
+			SELECT entry_tags.entry_id FROM entry_tags
+			JOIN tags ON tags.id = entry_tags.tag_id
+			WHERE tags.name = `+placeholder(len(args))+`)`)
+	}
+
+	return "\n\t\tWHERE " + strings.Join(conditions, " AND "), args
+}
+
+// placeholder returns the nth positional parameter, such as $1.
+func placeholder(n int) string {
+	return "$" + strconv.Itoa(n)
+}
+
+// nullTime maps the zero time to NULL for a nullable timestamp column.
+func nullTime(t time.Time) sql.NullTime {
+	if t.IsZero() {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: t.UTC(), Valid: true}
+}
+
+// marshalDocument encodes doc for the document column, mapping nil to NULL.
+func marshalDocument(doc *domain.Document) (sql.NullString, error) {
+	if doc == nil {
+		return sql.NullString{}, nil
+	}
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return sql.NullString{}, fmt.Errorf("failed to encode document: %w", err)
+	}
+	return sql.NullString{String: string(data), Valid: true}, nil
+}
+
+// unmarshalDocument decodes a document column, mapping NULL to nil.
+func unmarshalDocument(document sql.NullString) (*domain.Document, error) {
+	if !document.Valid {
+		return nil, nil
+	}
+	doc := &domain.Document{}
+	if err := json.Unmarshal([]byte(document.String), doc); err != nil {
+		return nil, fmt.Errorf("invalid document: %w", err)
+	}
+	return doc, nil
+}
+
+// scanner is satisfied by both *sql.Row and *sql.Rows.
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+// scanEntry scans a journal_entries row selected with entryColumns.
+func scanEntry(row scanner) (*domain.JournalEntry, error) {
+	entry := &domain.JournalEntry{}
+	var revealAt, deletedAt sql.NullTime
+	var document sql.NullString
+	err := row.Scan(
+		&entry.ID,
+		&entry.Title,
+		&entry.Content,
+		&entry.CreatedAt,
+		&entry.UpdatedAt,
+		&entry.Version,
+		&revealAt,
+		&document,
+		&deletedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	entry.CreatedAt = entry.CreatedAt.UTC()
+	entry.UpdatedAt = entry.UpdatedAt.UTC()
+	if revealAt.Valid {
+		entry.RevealAt = revealAt.Time.UTC()
+	}
+	if deletedAt.Valid {
+		entry.DeletedAt = deletedAt.Time.UTC()
+	}
+	entry.Document, err = unmarshalDocument(document)
+	if err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// scanEntries scans and closes rows of journal_entries selected with
+// entryColumns.
+func scanEntries(rows *sql.Rows) ([]*domain.JournalEntry, error) {
+	defer rows.Close()
+
+	var entries []*domain.JournalEntry
+	for rows.Next() {
+		entry, err := scanEntry(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan journal entry: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return entries, nil
+}
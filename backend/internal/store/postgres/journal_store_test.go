@@ -0,0 +1,201 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/parkernilson/micro-journal/internal/domain"
+	"github.com/parkernilson/micro-journal/migrations"
+)
+
+// setupTestDB connects to the PostgreSQL database named by
+// MJ_TEST_POSTGRES_DSN, applies migrations, and empties every table. Tests
+// are skipped when it is not set.
+func setupTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	dsn := os.Getenv("MJ_TEST_POSTGRES_DSN")
+	if dsn == "" {
+		t.Skip("MJ_TEST_POSTGRES_DSN not set")
+	}
+
+	db, err := Open(dsn)
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	ctx := context.Background()
+	if _, err := migrations.ApplyPostgres(ctx, db); err != nil {
+		t.Fatalf("failed to apply migrations: %v", err)
+	}
+	if _, err := db.ExecContext(ctx, `TRUNCATE journal_entries, tags, entry_tags, entry_revisions RESTART IDENTITY`); err != nil {
+		t.Fatalf("failed to empty tables: %v", err)
+	}
+
+	return db
+}
+
+func TestToTSQuery(t *testing.T) {
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{`"coffee"`, `'coffee'`},
+		{`"coffee" "morn"*`, `'coffee' & 'morn':*`},
+		{`"it's"`, `'it''s'`},
+	}
+
+	for _, tt := range tests {
+		if got := toTSQuery(tt.query); got != tt.want {
+			t.Errorf("toTSQuery(%q) = %q, want %q", tt.query, got, tt.want)
+		}
+	}
+}
+
+func TestJournalStore_VerifyArchive(t *testing.T) {
+	store := NewJournalStore(nil)
+	if _, err := store.VerifyArchive(context.Background()); !errors.Is(err, domain.ErrFailedPrecondition) {
+		t.Errorf("Expected ErrFailedPrecondition, got %v", err)
+	}
+}
+
+func TestJournalStore_CreateUpdateDelete(t *testing.T) {
+	db := setupTestDB(t)
+	store := NewJournalStore(db)
+	ctx := context.Background()
+
+	doc := &domain.Document{Sections: []domain.Section{{Type: domain.SectionText, Text: "Hello"}}}
+	entry, err := store.Create(ctx, "Title", "Content", time.Time{}, doc, []string{"work", "home"})
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if entry.ID == 0 || entry.Document == nil || !reflect.DeepEqual(entry.Tags, []string{"home", "work"}) {
+		t.Errorf("Unexpected created entry %+v", entry)
+	}
+
+	updated, err := store.Update(ctx, entry.ID, "New Title", "New Content", nil, []string{"work"}, entry.Version)
+	if err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	if updated.Title != "New Title" || updated.Document != nil || updated.Version != 2 || !reflect.DeepEqual(updated.Tags, []string{"work"}) {
+		t.Errorf("Unexpected updated entry %+v", updated)
+	}
+	if _, err := store.Update(ctx, entry.ID, "Stale Title", "Stale Content", nil, nil, entry.Version); !errors.Is(err, domain.ErrConflict) {
+		t.Errorf("Expected conflict for stale version, got %v", err)
+	}
+
+	revisions, total, err := store.ListRevisions(ctx, entry.ID, 10, 0)
+	if err != nil {
+		t.Fatalf("ListRevisions failed: %v", err)
+	}
+	if total != 1 || revisions[0].Title != "Title" {
+		t.Errorf("Expected the original version as a revision, got %d: %+v", total, revisions)
+	}
+
+	tags, err := store.ListTags(ctx)
+	if err != nil {
+		t.Fatalf("ListTags failed: %v", err)
+	}
+	if len(tags) != 1 || tags[0].Name != "work" {
+		t.Errorf("Expected the unused tag to be pruned, got %+v", tags)
+	}
+
+	restored, err := store.RestoreRevision(ctx, entry.ID, revisions[0].ID)
+	if err != nil {
+		t.Fatalf("RestoreRevision failed: %v", err)
+	}
+	if restored.Title != "Title" || restored.Document == nil {
+		t.Errorf("Unexpected restored entry %+v", restored)
+	}
+
+	if err := store.Delete(ctx, entry.ID); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if _, err := store.GetByID(ctx, entry.ID); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("Expected deleted entry to be hidden, got %v", err)
+	}
+	if _, total, _ := store.ListTrash(ctx, 10, 0); total != 1 {
+		t.Errorf("Expected 1 entry in the trash, got %d", total)
+	}
+	if _, err := store.Restore(ctx, entry.ID); err != nil {
+		t.Fatalf("Restore failed: %v", err)
+	}
+
+	if err := store.Purge(ctx, entry.ID); err != nil {
+		t.Fatalf("Purge failed: %v", err)
+	}
+	if _, total, _ := store.ListRevisions(ctx, entry.ID, 10, 0); total != 0 {
+		t.Errorf("Expected revisions to be purged with the entry, got %d", total)
+	}
+}
+
+func TestJournalStore_ListAndSearch(t *testing.T) {
+	db := setupTestDB(t)
+	store := NewJournalStore(db)
+	ctx := context.Background()
+
+	for _, title := range []string{"Morning coffee", "Evening walk", "Coffee with Sam"} {
+		if _, err := store.Create(ctx, title, "Content of "+title, time.Time{}, nil, []string{"daily"}); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+	}
+	if _, err := store.Create(ctx, "Sealed coffee", "Secret", time.Now().Add(time.Hour), nil, nil); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	entries, total, err := store.List(ctx, domain.EntryFilter{Tag: "daily"}, 2, 0)
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if total != 3 || len(entries) != 2 || entries[0].Title != "Coffee with Sam" {
+		t.Errorf("Expected newest tagged entries first, got %d: %+v", total, entries)
+	}
+
+	entries, total, err = store.Search(ctx, `"coff"*`, 10, 0)
+	if err != nil {
+		t.Fatalf("Search failed: %v", err)
+	}
+	if total != 2 || len(entries) != 2 {
+		t.Errorf("Expected 2 unsealed coffee entries, got %d: %+v", total, entries)
+	}
+}
+
+func TestJournalStore_ImportExport(t *testing.T) {
+	db := setupTestDB(t)
+	store := NewJournalStore(db)
+	ctx := context.Background()
+
+	entries := []*domain.JournalEntry{
+		{Title: "Old", Content: "From 2015", CreatedAt: time.Date(2015, 6, 1, 8, 30, 0, 0, time.UTC), Tags: []string{"travel"}},
+		{Title: "Older", Content: "From 2014", CreatedAt: time.Date(2014, 6, 1, 8, 30, 0, 0, time.UTC)},
+	}
+
+	imported, err := store.Import(ctx, entries)
+	if err != nil {
+		t.Fatalf("Import failed: %v", err)
+	}
+	if imported != 2 {
+		t.Errorf("Expected 2 imported entries, got %d", imported)
+	}
+	if imported, _ := store.Import(ctx, entries); imported != 0 {
+		t.Errorf("Expected duplicates to be skipped, got %d imported", imported)
+	}
+
+	var titles []string
+	err = store.Export(ctx, func(entry *domain.JournalEntry) error {
+		titles = append(titles, entry.Title)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Export failed: %v", err)
+	}
+	if !reflect.DeepEqual(titles, []string{"Older", "Old"}) {
+		t.Errorf("Expected entries oldest first, got %v", titles)
+	}
+}
@@ -0,0 +1,157 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/parkernilson/micro-journal/internal/domain"
+)
+
+// revisionColumns is the column list scanned by scanRevision.
+const revisionColumns = "id, entry_id, title, content, document, created_at"
+
+// ListRevisions retrieves the saved revisions of an entry, newest first.
+// Returns the revisions and the total count of revisions of the entry.
+func (s *JournalStore) ListRevisions(ctx context.Context, entryID int64, limit, offset int) ([]*domain.Revision, int64, error) {
+	// Get total count
+	var totalCount int64
+	countQuery := `SELECT COUNT(*) FROM entry_revisions WHERE entry_id = $1`
+	err := s.db.QueryRowContext(ctx, countQuery, entryID).Scan(&totalCount)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count journal entry revisions: %w", err)
+	}
+
+	// Get paginated revisions
+	query := `
+		SELECT ` + revisionColumns + `
+		FROM entry_revisions
+		WHERE entry_id = $1
+		ORDER BY id DESC
+		LIMIT $2 OFFSET $3
+	`
+
+	rows, err := s.db.QueryContext(ctx, query, entryID, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to query journal entry revisions: %w", err)
+	}
+
+	revisions, err := scanRevisions(rows)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return revisions, totalCount, nil
+}
+
+// SearchRevisions retrieves the revisions of an entry matching a search
+// query, best match first. Title matches are weighted above content matches.
+// Returns the revisions and the total count of matching revisions.
+func (s *JournalStore) SearchRevisions(ctx context.Context, entryID int64, query string, limit, offset int) ([]*domain.Revision, int64, error) {
+	matches := `
+		FROM entry_revisions, to_tsquery('english', $1) AS q
+		WHERE search @@ q AND entry_id = $2
+	`
+	tsQuery := toTSQuery(query)
+
+	// Get total count
+	var totalCount int64
+	err := s.db.QueryRowContext(ctx, `SELECT COUNT(*)`+matches, tsQuery, entryID).Scan(&totalCount)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count matching journal entry revisions: %w", err)
+	}
+
+	// Get paginated revisions
+	selectQuery := `
+		SELECT ` + revisionColumns + matches + `
+		ORDER BY ts_rank(search, q) DESC, id DESC
+		LIMIT $3 OFFSET $4
+	`
+
+	rows, err := s.db.QueryContext(ctx, selectQuery, tsQuery, entryID, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to search journal entry revisions: %w", err)
+	}
+
+	revisions, err := scanRevisions(rows)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return revisions, totalCount, nil
+}
+
+// RestoreRevision replaces an entry's title, content, and document with
+// those of one of its revisions. The version being replaced is saved as a
+// new revision, so a restore can itself be undone.
+// The restore and the read of the modified row happen in one transaction.
+func (s *JournalStore) RestoreRevision(ctx context.Context, entryID, revisionID int64) (*domain.JournalEntry, error) {
+	query := `SELECT title, content, document FROM entry_revisions WHERE id = $1 AND entry_id = $2`
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var title, content string
+	var document sql.NullString
+	err = tx.QueryRowContext(ctx, query, revisionID, entryID).Scan(&title, &content, &document)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("journal entry revision %d: %w", revisionID, domain.ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get journal entry revision: %w", err)
+	}
+
+	if err := updateContent(ctx, tx, entryID, 0, title, content, document); err != nil {
+		return nil, err
+	}
+
+	entry, err := getByID(ctx, tx, entryID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return entry, nil
+}
+
+// scanRevisions scans and closes rows of entry_revisions selected with
+// revisionColumns.
+func scanRevisions(rows *sql.Rows) ([]*domain.Revision, error) {
+	defer rows.Close()
+
+	var revisions []*domain.Revision
+	for rows.Next() {
+		revision := &domain.Revision{}
+		var document sql.NullString
+		err := rows.Scan(
+			&revision.ID,
+			&revision.EntryID,
+			&revision.Title,
+			&revision.Content,
+			&document,
+			&revision.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan journal entry revision: %w", err)
+		}
+
+		revision.CreatedAt = revision.CreatedAt.UTC()
+		revision.Document, err = unmarshalDocument(document)
+		if err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, revision)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return revisions, nil
+}
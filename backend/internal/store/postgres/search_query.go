@@ -0,0 +1,25 @@
+package postgres
+
+import (
+	"regexp"
+	"strings"
+)
+
+// searchTerm matches one term of a store search query: a quoted word,
+// optionally followed by '*' for a prefix match.
+var searchTerm = regexp.MustCompile(`"([^"]*)"(\*?)`)
+
+// toTSQuery converts a store search query such as `"coffee" "morn"*` into
+// to_tsquery syntax, `'coffee' & 'morn':*`. Terms are quoted so that any
+// word is taken literally.
+func toTSQuery(query string) string {
+	var terms []string
+	for _, match := range searchTerm.FindAllStringSubmatch(query, -1) {
+		term := "'" + strings.ReplaceAll(match[1], "'", "''") + "'"
+		if match[2] != "" {
+			term += ":*"
+		}
+		terms = append(terms, term)
+	}
+	return strings.Join(terms, " & ")
+}
@@ -0,0 +1,206 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/parkernilson/micro-journal/internal/domain"
+)
+
+// ListTags retrieves every tag with the number of entries that use it,
+// sorted by name. Entries in the trash are not counted.
+func (s *JournalStore) ListTags(ctx context.Context) ([]*domain.Tag, error) {
+	query := `
+		SELECT tags.name, COUNT(journal_entries.id)
+		FROM tags
+		LEFT JOIN entry_tags ON entry_tags.tag_id = tags.id
+		LEFT JOIN journal_entries ON journal_entries.id = entry_tags.entry_id
+			AND journal_entries.deleted_at IS NULL
+		GROUP BY tags.id
+		ORDER BY tags.name
+	`
+
+	rows, err := s.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query tags: %w", err)
+	}
+	defer rows.Close()
+
+	var tags []*domain.Tag
+	for rows.Next() {
+		tag := &domain.Tag{}
+		if err := rows.Scan(&tag.Name, &tag.EntryCount); err != nil {
+			return nil, fmt.Errorf("failed to scan tag: %w", err)
+		}
+		tags = append(tags, tag)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return tags, nil
+}
+
+// RenameTag renames a tag on every entry that uses it. If a tag named
+// newName already exists, the two tags are merged.
+func (s *JournalStore) RenameTag(ctx context.Context, name, newName string) (*domain.Tag, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	id, err := tagID(ctx, tx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var newID int64
+	err = tx.QueryRowContext(ctx, `SELECT id FROM tags WHERE name = $1`, newName).Scan(&newID)
+	switch {
+	case err == sql.ErrNoRows:
+		if _, err := tx.ExecContext(ctx, `UPDATE tags SET name = $1 WHERE id = $2`, newName, id); err != nil {
+			return nil, fmt.Errorf("failed to rename tag: %w", err)
+		}
+	case err != nil:
+		return nil, fmt.Errorf("failed to get tag: %w", err)
+	case newID != id:
+		// Merge into the existing tag; removing the last link of the old tag
+		// deletes it
+		query := `
+			INSERT INTO entry_tags (entry_id, tag_id)
+			SELECT entry_id, $1 FROM entry_tags WHERE tag_id = $2
+			ON CONFLICT DO NOTHING
+		`
+		if _, err := tx.ExecContext(ctx, query, newID, id); err != nil {
+			return nil, fmt.Errorf("failed to merge tags: %w", err)
+		}
+		if _, err := tx.ExecContext(ctx, `DELETE FROM entry_tags WHERE tag_id = $1`, id); err != nil {
+			return nil, fmt.Errorf("failed to merge tags: %w", err)
+		}
+		id = newID
+	}
+
+	tag := &domain.Tag{Name: newName}
+	query := `
+		SELECT COUNT(*)
+		FROM entry_tags
+		JOIN journal_entries ON journal_entries.id = entry_tags.entry_id
+		WHERE entry_tags.tag_id = $1 AND journal_entries.deleted_at IS NULL
+	`
+	if err := tx.QueryRowContext(ctx, query, id).Scan(&tag.EntryCount); err != nil {
+		return nil, fmt.Errorf("failed to count tag entries: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return tag, nil
+}
+
+// DeleteTag removes a tag from every entry that uses it.
+func (s *JournalStore) DeleteTag(ctx context.Context, name string) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	id, err := tagID(ctx, tx, name)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM entry_tags WHERE tag_id = $1`, id); err != nil {
+		return fmt.Errorf("failed to delete tag: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM tags WHERE id = $1`, id); err != nil {
+		return fmt.Errorf("failed to delete tag: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// tagID looks up the ID of the tag called name.
+func tagID(ctx context.Context, q querier, name string) (int64, error) {
+	var id int64
+	err := q.QueryRowContext(ctx, `SELECT id FROM tags WHERE name = $1`, name).Scan(&id)
+	if err == sql.ErrNoRows {
+		return 0, fmt.Errorf("tag %q: %w", name, domain.ErrNotFound)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to get tag: %w", err)
+	}
+	return id, nil
+}
+
+// setTags replaces the tags of an entry, creating tags that do not exist
+// yet. Tags left without entries are deleted by the entry_tags_prune trigger.
+func setTags(ctx context.Context, tx *sql.Tx, entryID int64, tags []string) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM entry_tags WHERE entry_id = $1`, entryID); err != nil {
+		return fmt.Errorf("failed to clear entry tags: %w", err)
+	}
+
+	for _, name := range tags {
+		if _, err := tx.ExecContext(ctx, `INSERT INTO tags (name) VALUES ($1) ON CONFLICT (name) DO NOTHING`, name); err != nil {
+			return fmt.Errorf("failed to create tag: %w", err)
+		}
+		query := `INSERT INTO entry_tags (entry_id, tag_id) SELECT $1, id FROM tags WHERE name = $2`
+		if _, err := tx.ExecContext(ctx, query, entryID, name); err != nil {
+			return fmt.Errorf("failed to tag entry: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// loadTags fills in the Tags of entries with one query.
+func loadTags(ctx context.Context, q querier, entries []*domain.JournalEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	byID := make(map[int64]*domain.JournalEntry, len(entries))
+	ids := make([]int64, len(entries))
+	for i, entry := range entries {
+		byID[entry.ID] = entry
+		ids[i] = entry.ID
+	}
+
+	query := `
+		SELECT entry_tags.entry_id, tags.name
+		FROM entry_tags
+		JOIN tags ON tags.id = entry_tags.tag_id
+		WHERE entry_tags.entry_id = ANY($1)
+		ORDER BY tags.name
+	`
+
+	rows, err := q.QueryContext(ctx, query, ids)
+	if err != nil {
+		return fmt.Errorf("failed to query entry tags: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var entryID int64
+		var name string
+		if err := rows.Scan(&entryID, &name); err != nil {
+			return fmt.Errorf("failed to scan entry tag: %w", err)
+		}
+		entry := byID[entryID]
+		entry.Tags = append(entry.Tags, name)
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/parkernilson/micro-journal/internal/domain"
+)
+
+// ListTrash retrieves entries in the trash, most recently deleted first.
+// Returns the entries and the total count of entries in the trash.
+func (s *JournalStore) ListTrash(ctx context.Context, limit, offset int) ([]*domain.JournalEntry, int64, error) {
+	// Get total count
+	var totalCount int64
+	countQuery := `SELECT COUNT(*) FROM journal_entries WHERE deleted_at IS NOT NULL`
+	err := s.db.QueryRowContext(ctx, countQuery).Scan(&totalCount)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count trashed journal entries: %w", err)
+	}
+
+	// Get paginated entries
+	query := `
+		SELECT ` + entryColumns + `
+		FROM journal_entries
+		WHERE deleted_at IS NOT NULL
+		ORDER BY deleted_at DESC, id DESC
+		LIMIT $1 OFFSET $2
+	`
+
+	rows, err := s.db.QueryContext(ctx, query, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to query trashed journal entries: %w", err)
+	}
+
+	entries, err := scanEntries(rows)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if err := loadTags(ctx, s.db, entries); err != nil {
+		return nil, 0, err
+	}
+
+	return entries, totalCount, nil
+}
+
+// Restore moves a journal entry out of the trash.
+// The restore and the read of the restored row happen in one transaction.
+func (s *JournalStore) Restore(ctx context.Context, id int64) (*domain.JournalEntry, error) {
+	query := `UPDATE journal_entries SET deleted_at = NULL WHERE id = $1 AND deleted_at IS NOT NULL`
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, query, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore journal entry: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rows == 0 {
+		return nil, fmt.Errorf("journal entry %d in the trash: %w", id, domain.ErrNotFound)
+	}
+
+	entry, err := getByID(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return entry, nil
+}
+
+// Purge permanently removes a journal entry, whether or not it is in the
+// trash. Its tags and revisions are removed with it.
+func (s *JournalStore) Purge(ctx context.Context, id int64) error {
+	return purgeEntry(ctx, s.db, id)
+}
+
+// purgeEntry permanently removes a journal entry using the given execer.
+func purgeEntry(ctx context.Context, e execer, id int64) error {
+	query := `DELETE FROM journal_entries WHERE id = $1`
+
+	result, err := e.ExecContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to purge journal entry: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rows == 0 {
+		return fmt.Errorf("journal entry %d: %w", id, domain.ErrNotFound)
+	}
+
+	return nil
+}
+
+// PurgeTrash permanently removes entries that were moved to the trash before
+// deletedBefore, or every entry in the trash if deletedBefore is zero.
+// Returns the number of entries removed.
+func (s *JournalStore) PurgeTrash(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	query := `DELETE FROM journal_entries WHERE deleted_at IS NOT NULL`
+	var args []any
+	if !deletedBefore.IsZero() {
+		query += ` AND deleted_at < $1`
+		args = append(args, deletedBefore.UTC())
+	}
+
+	result, err := s.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge trash: %w", err)
+	}
+
+	purged, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return purged, nil
+}
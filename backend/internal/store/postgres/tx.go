@@ -0,0 +1,53 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/parkernilson/micro-journal/internal/domain"
+)
+
+// WithTx runs fn in one database transaction, committing it if fn returns
+// nil and rolling it back otherwise. PostgreSQL rejects every statement after
+// one that failed, so fn should return the first database error it gets;
+// not-found errors are detected without a failed statement and are safe to
+// continue past.
+func (s *JournalStore) WithTx(ctx context.Context, fn func(tx domain.JournalTx) error) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := fn(&journalTx{tx: tx}); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// journalTx is the domain.JournalTx passed to fn by WithTx.
+type journalTx struct {
+	tx *sql.Tx
+}
+
+func (t *journalTx) Create(ctx context.Context, title, content string, revealAt time.Time, doc *domain.Document, tags []string) (*domain.JournalEntry, error) {
+	return create(ctx, t.tx, title, content, revealAt, doc, tags)
+}
+
+func (t *journalTx) GetByID(ctx context.Context, id int64) (*domain.JournalEntry, error) {
+	return getByID(ctx, t.tx, id)
+}
+
+func (t *journalTx) Delete(ctx context.Context, id int64) error {
+	return deleteEntry(ctx, t.tx, id)
+}
+
+func (t *journalTx) Purge(ctx context.Context, id int64) error {
+	return purgeEntry(ctx, t.tx, id)
+}
@@ -0,0 +1,180 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/parkernilson/micro-journal/internal/domain"
+)
+
+// revisionColumns is the column list scanned by scanRevision.
+const revisionColumns = "id, entry_id, title, content, document, created_at"
+
+// ListRevisions retrieves the saved revisions of an entry, newest first.
+// Returns the revisions and the total count of revisions of the entry.
+func (s *JournalStore) ListRevisions(ctx context.Context, entryID int64, limit, offset int) ([]*domain.Revision, int64, error) {
+	// Get total count
+	var totalCount int64
+	countQuery := `SELECT COUNT(*) FROM entry_revisions WHERE entry_id = ?`
+	err := s.db.QueryRowContext(ctx, countQuery, entryID).Scan(&totalCount)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count journal entry revisions: %w", err)
+	}
+
+	// Get paginated revisions
+	query := `
+		SELECT ` + revisionColumns + `
+		FROM entry_revisions
+		WHERE entry_id = ?
+		ORDER BY id DESC
+		LIMIT ? OFFSET ?
+	`
+
+	rows, err := s.db.QueryContext(ctx, query, entryID, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to query journal entry revisions: %w", err)
+	}
+	defer rows.Close()
+
+	var revisions []*domain.Revision
+	for rows.Next() {
+		revision, err := scanRevision(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan journal entry revision: %w", err)
+		}
+		revisions = append(revisions, revision)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return revisions, totalCount, nil
+}
+
+// SearchRevisions retrieves the revisions of an entry matching an FTS5
+// query, best match first. Title matches are weighted above content matches.
+// Returns the revisions and the total count of matching revisions.
+func (s *JournalStore) SearchRevisions(ctx context.Context, entryID int64, query string, limit, offset int) ([]*domain.Revision, int64, error) {
+	matches := `
+		FROM entry_revisions
+		JOIN (
+			SELECT rowid, bm25(entry_revisions_fts, 10.0, 1.0) AS score
+			FROM entry_revisions_fts
+			WHERE entry_revisions_fts MATCH ?
+		) AS matches ON matches.rowid = entry_revisions.id
+		WHERE entry_id = ?
+	`
+
+	// Get total count
+	var totalCount int64
+	err := s.db.QueryRowContext(ctx, `SELECT COUNT(*)`+matches, query, entryID).Scan(&totalCount)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count matching journal entry revisions: %w", err)
+	}
+
+	// Get paginated revisions
+	selectQuery := `
+		SELECT ` + revisionColumns + matches + `
+		ORDER BY matches.score, id DESC
+		LIMIT ? OFFSET ?
+	`
+
+	rows, err := s.db.QueryContext(ctx, selectQuery, query, entryID, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to search journal entry revisions: %w", err)
+	}
+	defer rows.Close()
+
+	var revisions []*domain.Revision
+	for rows.Next() {
+		revision, err := scanRevision(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan journal entry revision: %w", err)
+		}
+		revisions = append(revisions, revision)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return revisions, totalCount, nil
+}
+
+// RestoreRevision replaces an entry's title, content, and document with
+// those of one of its revisions. The version being replaced is saved as a
+// new revision, so a restore can itself be undone.
+// The restore and the read of the modified row happen in one transaction.
+func (s *JournalStore) RestoreRevision(ctx context.Context, entryID, revisionID int64) (*domain.JournalEntry, error) {
+	query := `SELECT title, content, document FROM entry_revisions WHERE id = ? AND entry_id = ?`
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var title, content string
+	var document sql.NullString
+	err = tx.QueryRowContext(ctx, query, revisionID, entryID).Scan(&title, &content, &document)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("journal entry revision %d: %w", revisionID, domain.ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get journal entry revision: %w", err)
+	}
+
+	if err := updateContent(ctx, tx, entryID, 0, title, content, document); err != nil {
+		return nil, err
+	}
+
+	if err := s.appendArchive(ctx, tx, entryID); err != nil {
+		return nil, err
+	}
+
+	entry, err := getByID(ctx, tx, entryID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return entry, nil
+}
+
+// scanRevision scans an entry_revisions row selected with revisionColumns.
+func scanRevision(row scanner) (*domain.Revision, error) {
+	revision := &domain.Revision{}
+	var createdAt string
+	var document sql.NullString
+	err := row.Scan(
+		&revision.ID,
+		&revision.EntryID,
+		&revision.Title,
+		&revision.Content,
+		&document,
+		&createdAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	revision.CreatedAt, err = time.Parse(timestampLayout, createdAt)
+	if err != nil {
+		return nil, fmt.Errorf("invalid created_at %q: %w", createdAt, err)
+	}
+	if document.Valid {
+		revision.Document = &domain.Document{}
+		if err := json.Unmarshal([]byte(document.String), revision.Document); err != nil {
+			return nil, fmt.Errorf("invalid document: %w", err)
+		}
+	}
+
+	return revision, nil
+}
@@ -0,0 +1,89 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// searchIndexes are the external-content FTS5 tables created by the
+// migrations, each with the table whose title and content it indexes.
+var searchIndexes = []struct {
+	table   string
+	content string
+}{
+	{"journal_entries_fts", "journal_entries"},
+	{"entry_revisions_fts", "entry_revisions"},
+}
+
+// tokenizeOption extracts the tokenize option from an FTS5 table's
+// CREATE VIRTUAL TABLE statement.
+var tokenizeOption = regexp.MustCompile(`tokenize\s*=\s*'((?:[^']|'')*)'`)
+
+// SearchTokenizer returns the FTS5 tokenizer the search indexes use, such as
+// "porter unicode61".
+func SearchTokenizer(ctx context.Context, db *sql.DB) (string, error) {
+	var ddl string
+	err := db.QueryRowContext(ctx, `SELECT sql FROM sqlite_master WHERE name = ?`, searchIndexes[0].table).Scan(&ddl)
+	if err != nil {
+		return "", fmt.Errorf("failed to read search index: %w", err)
+	}
+
+	match := tokenizeOption.FindStringSubmatch(ddl)
+	if match == nil {
+		// FTS5's default
+		return "unicode61", nil
+	}
+	return strings.ReplaceAll(match[1], "''", "'"), nil
+}
+
+// SetSearchTokenizer rebuilds the entry and revision search indexes with
+// tokenizer if they use a different one, and reports whether they were
+// rebuilt. tokenizer is an FTS5 tokenizer spec: "porter unicode61" stems
+// English words, while "unicode61 remove_diacritics 2" or "trigram" suit
+// other languages. Rebuilding re-reads every entry and revision in one
+// transaction, so an invalid tokenizer leaves the indexes unchanged.
+func SetSearchTokenizer(ctx context.Context, db *sql.DB, tokenizer string) (bool, error) {
+	current, err := SearchTokenizer(ctx, db)
+	if err != nil {
+		return false, err
+	}
+	if current == tokenizer {
+		return false, nil
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	quoted := "'" + strings.ReplaceAll(tokenizer, "'", "''") + "'"
+	for _, index := range searchIndexes {
+		statements := []string{
+			fmt.Sprintf(`DROP TABLE %s`, index.table),
+			fmt.Sprintf(`
+				CREATE VIRTUAL TABLE %s USING fts5(
+					title,
+					content,
+					content='%s',
+					content_rowid='id',
+					tokenize=%s
+				)
+			`, index.table, index.content, quoted),
+			fmt.Sprintf(`INSERT INTO %s (%s) VALUES ('rebuild')`, index.table, index.table),
+		}
+		for _, statement := range statements {
+			if _, err := tx.ExecContext(ctx, statement); err != nil {
+				return false, fmt.Errorf("failed to rebuild %s: %w", index.table, err)
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return true, nil
+}
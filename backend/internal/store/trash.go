@@ -0,0 +1,184 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/parkernilson/micro-journal/internal/domain"
+)
+
+// ListTrash retrieves entries in the trash, most recently deleted first.
+// Returns the entries and the total count of entries in the trash.
+func (s *JournalStore) ListTrash(ctx context.Context, limit, offset int) ([]*domain.JournalEntry, int64, error) {
+	// Get total count
+	var totalCount int64
+	countQuery := `SELECT COUNT(*) FROM journal_entries WHERE deleted_at IS NOT NULL`
+	err := s.db.QueryRowContext(ctx, countQuery).Scan(&totalCount)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count trashed journal entries: %w", err)
+	}
+
+	// Get paginated entries
+	query := `
+		SELECT ` + entryColumns + `
+		FROM journal_entries
+		WHERE deleted_at IS NOT NULL
+		ORDER BY deleted_at DESC, id DESC
+		LIMIT ? OFFSET ?
+	`
+
+	rows, err := s.db.QueryContext(ctx, query, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to query trashed journal entries: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []*domain.JournalEntry
+	for rows.Next() {
+		entry, err := scanEntry(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan journal entry: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	if err := loadTags(ctx, s.db, entries); err != nil {
+		return nil, 0, err
+	}
+
+	return entries, totalCount, nil
+}
+
+// Restore moves a journal entry out of the trash.
+// The restore and the read of the restored row happen in one transaction.
+func (s *JournalStore) Restore(ctx context.Context, id int64) (*domain.JournalEntry, error) {
+	query := `UPDATE journal_entries SET deleted_at = NULL WHERE id = ? AND deleted_at IS NOT NULL`
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, query, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore journal entry: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rows == 0 {
+		return nil, fmt.Errorf("journal entry %d in the trash: %w", id, domain.ErrNotFound)
+	}
+
+	entry, err := getByID(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return entry, nil
+}
+
+// Purge permanently removes a journal entry, whether or not it is in the
+// trash.
+func (s *JournalStore) Purge(ctx context.Context, id int64) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := s.purge(ctx, tx, id); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// purge permanently removes a journal entry in tx and records it in the
+// archive.
+func (s *JournalStore) purge(ctx context.Context, tx *sql.Tx, id int64) error {
+	query := `DELETE FROM journal_entries WHERE id = ?`
+
+	result, err := tx.ExecContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to purge journal entry: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rows == 0 {
+		return fmt.Errorf("journal entry %d: %w", id, domain.ErrNotFound)
+	}
+
+	return s.appendTombstones(ctx, tx, id)
+}
+
+// PurgeTrash permanently removes entries that were moved to the trash before
+// deletedBefore, or every entry in the trash if deletedBefore is zero.
+// Returns the number of entries removed.
+func (s *JournalStore) PurgeTrash(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	query := `DELETE FROM journal_entries WHERE deleted_at IS NOT NULL`
+	var args []any
+	if !deletedBefore.IsZero() {
+		query += ` AND deleted_at < ?`
+		args = append(args, formatTimestamp(deletedBefore))
+	}
+	query += ` RETURNING id`
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	ids, err := queryIDs(ctx, tx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge trash: %w", err)
+	}
+	if err := s.appendTombstones(ctx, tx, ids...); err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return int64(len(ids)), nil
+}
+
+// queryIDs runs query and returns the single integer column of each row.
+func queryIDs(ctx context.Context, q querier, query string, args ...any) ([]int64, error) {
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
@@ -0,0 +1,51 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/parkernilson/micro-journal/internal/domain"
+)
+
+// WithTx runs fn in one database transaction, committing it if fn returns
+// nil and rolling it back otherwise.
+func (s *JournalStore) WithTx(ctx context.Context, fn func(tx domain.JournalTx) error) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := fn(&journalTx{store: s, tx: tx}); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// journalTx is the domain.JournalTx passed to fn by WithTx.
+type journalTx struct {
+	store *JournalStore
+	tx    *sql.Tx
+}
+
+func (t *journalTx) Create(ctx context.Context, title, content string, revealAt time.Time, doc *domain.Document, tags []string) (*domain.JournalEntry, error) {
+	return t.store.create(ctx, t.tx, title, content, revealAt, doc, tags)
+}
+
+func (t *journalTx) GetByID(ctx context.Context, id int64) (*domain.JournalEntry, error) {
+	return getByID(ctx, t.tx, id)
+}
+
+func (t *journalTx) Delete(ctx context.Context, id int64) error {
+	return deleteEntry(ctx, t.tx, id)
+}
+
+func (t *journalTx) Purge(ctx context.Context, id int64) error {
+	return t.store.purge(ctx, t.tx, id)
+}
@@ -0,0 +1,114 @@
+package systemd
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestListeners_NotActivated(t *testing.T) {
+	t.Setenv("LISTEN_PID", "")
+	t.Setenv("LISTEN_FDS", "")
+
+	listeners, err := Listeners()
+	if err != nil {
+		t.Fatalf("Listeners failed: %v", err)
+	}
+	if listeners != nil {
+		t.Errorf("Expected no listeners, got %d", len(listeners))
+	}
+}
+
+func TestListeners_OtherProcess(t *testing.T) {
+	t.Setenv("LISTEN_PID", strconv.Itoa(os.Getpid()+1))
+	t.Setenv("LISTEN_FDS", "1")
+
+	listeners, err := Listeners()
+	if err != nil {
+		t.Fatalf("Listeners failed: %v", err)
+	}
+	if listeners != nil {
+		t.Errorf("Expected no listeners for another process, got %d", len(listeners))
+	}
+	if os.Getenv("LISTEN_FDS") != "" {
+		t.Error("Expected LISTEN_FDS to be cleared")
+	}
+}
+
+func TestNotify(t *testing.T) {
+	t.Run("no socket", func(t *testing.T) {
+		t.Setenv("NOTIFY_SOCKET", "")
+
+		sent, err := Notify("READY=1")
+		if err != nil {
+			t.Fatalf("Notify failed: %v", err)
+		}
+		if sent {
+			t.Error("Expected notification not to be sent")
+		}
+	})
+
+	t.Run("with socket", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "notify.sock")
+		conn, err := net.ListenUnixgram("unixgram", &net.UnixAddr{Name: path, Net: "unixgram"})
+		if err != nil {
+			t.Fatalf("failed to listen on notify socket: %v", err)
+		}
+		defer conn.Close()
+
+		t.Setenv("NOTIFY_SOCKET", path)
+
+		sent, err := Notify("READY=1")
+		if err != nil {
+			t.Fatalf("Notify failed: %v", err)
+		}
+		if !sent {
+			t.Error("Expected notification to be sent")
+		}
+
+		buf := make([]byte, 64)
+		conn.SetReadDeadline(time.Now().Add(time.Second))
+		n, err := conn.Read(buf)
+		if err != nil {
+			t.Fatalf("failed to read notification: %v", err)
+		}
+		if got := string(buf[:n]); got != "READY=1" {
+			t.Errorf("Expected 'READY=1', got '%s'", got)
+		}
+	})
+}
+
+func TestWatchdogInterval(t *testing.T) {
+	t.Run("enabled", func(t *testing.T) {
+		t.Setenv("WATCHDOG_USEC", "30000000")
+		t.Setenv("WATCHDOG_PID", strconv.Itoa(os.Getpid()))
+
+		interval, ok := WatchdogInterval()
+		if !ok {
+			t.Fatal("Expected watchdog to be enabled")
+		}
+		if interval != 30*time.Second {
+			t.Errorf("Expected 30s, got %v", interval)
+		}
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		t.Setenv("WATCHDOG_USEC", "")
+
+		if _, ok := WatchdogInterval(); ok {
+			t.Error("Expected watchdog to be disabled")
+		}
+	})
+
+	t.Run("other process", func(t *testing.T) {
+		t.Setenv("WATCHDOG_USEC", "30000000")
+		t.Setenv("WATCHDOG_PID", strconv.Itoa(os.Getpid()+1))
+
+		if _, ok := WatchdogInterval(); ok {
+			t.Error("Expected watchdog to be disabled for another process")
+		}
+	})
+}
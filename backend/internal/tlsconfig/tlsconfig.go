@@ -0,0 +1,73 @@
+// Package tlsconfig builds the TLS settings of the API listeners and decides
+// when they may serve without TLS.
+package tlsconfig
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+)
+
+// Load returns a server TLS configuration presenting the certificate and
+// key in the PEM files certFile and keyFile. If clientCAFile is set, clients
+// must present a certificate signed by one of the CAs it contains (mutual
+// TLS). Certificates are read once, so the server must be restarted to pick
+// up a renewed one.
+func Load(certFile, keyFile, clientCAFile string) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load certificate: %w", err)
+	}
+
+	config := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if clientCAFile == "" {
+		return config, nil
+	}
+
+	pem, err := os.ReadFile(clientCAFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read client CA: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificates found in client CA %s", clientCAFile)
+	}
+	config.ClientCAs = pool
+	config.ClientAuth = tls.RequireAndVerifyClientCert
+	return config, nil
+}
+
+// IsLocal reports whether addr can only be reached from this machine: a
+// loopback TCP address or a Unix socket. Plaintext is safe on such an
+// address without being explicitly enabled.
+func IsLocal(addr net.Addr) bool {
+	switch addr := addr.(type) {
+	case *net.TCPAddr:
+		return addr.IP.IsLoopback()
+	case *net.UnixAddr:
+		return true
+	default:
+		return false
+	}
+}
+
+// ErrPlaintext is returned by CheckPlaintext when an API would be served
+// without TLS to other machines.
+var ErrPlaintext = errors.New("refusing to serve without TLS on a non-loopback address; set MJ_TLS_CERT and MJ_TLS_KEY, or MJ_PLAINTEXT=true")
+
+// CheckPlaintext returns ErrPlaintext if an API listening on addr without
+// TLS could be reached from other machines and plaintext was not explicitly
+// allowed. Every API listener follows this policy when no certificate is
+// configured.
+func CheckPlaintext(addr net.Addr, allowed bool) error {
+	if allowed || IsLocal(addr) {
+		return nil
+	}
+	return fmt.Errorf("%s: %w", addr, ErrPlaintext)
+}
@@ -0,0 +1,207 @@
+package tlsconfig
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// testCA signs certificates for tests.
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("Failed to create CA certificate: %v", err)
+	}
+	cert, _ := x509.ParseCertificate(der)
+	return &testCA{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// issue returns a certificate for localhost signed by the CA, as PEM
+// certificate and key.
+func (ca *testCA) issue(t *testing.T, usage x509.ExtKeyUsage) ([]byte, []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		DNSNames:     []string{"localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatalf("Failed to create certificate: %v", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("Failed to marshal key: %v", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func writeFile(t *testing.T, dir, name string, data []byte) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("Failed to write %s: %v", name, err)
+	}
+	return path
+}
+
+// handshake connects a client using client to a TLS server using server
+// and returns the error the client sees.
+func handshake(t *testing.T, server, client *tls.Config) error {
+	t.Helper()
+	lis, err := tls.Listen("tcp", "127.0.0.1:0", server)
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	defer lis.Close()
+
+	go func() {
+		conn, err := lis.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		if conn.(*tls.Conn).Handshake() == nil {
+			conn.Write([]byte{1})
+		}
+	}()
+
+	conn, err := tls.Dial("tcp", lis.Addr().String(), client)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	// With TLS 1.3 a rejected client certificate only surfaces on read
+	_, err = conn.Read(make([]byte, 1))
+	return err
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t)
+	serverCert, serverKey := ca.issue(t, x509.ExtKeyUsageServerAuth)
+	clientCert, clientKey := ca.issue(t, x509.ExtKeyUsageClientAuth)
+
+	certFile := writeFile(t, dir, "server.crt", serverCert)
+	keyFile := writeFile(t, dir, "server.key", serverKey)
+	caFile := writeFile(t, dir, "ca.crt", ca.pem)
+
+	roots := x509.NewCertPool()
+	roots.AppendCertsFromPEM(ca.pem)
+
+	t.Run("server certificate", func(t *testing.T) {
+		config, err := Load(certFile, keyFile, "")
+		if err != nil {
+			t.Fatalf("Load failed: %v", err)
+		}
+		if config.ClientAuth != tls.NoClientCert {
+			t.Errorf("Expected no client certificate to be required, got %v", config.ClientAuth)
+		}
+		if err := handshake(t, config, &tls.Config{RootCAs: roots, ServerName: "localhost"}); err != nil {
+			t.Errorf("Expected handshake to succeed, got %v", err)
+		}
+	})
+
+	t.Run("mutual TLS", func(t *testing.T) {
+		config, err := Load(certFile, keyFile, caFile)
+		if err != nil {
+			t.Fatalf("Load failed: %v", err)
+		}
+
+		pair, err := tls.X509KeyPair(clientCert, clientKey)
+		if err != nil {
+			t.Fatalf("Failed to parse client certificate: %v", err)
+		}
+		withCert := &tls.Config{RootCAs: roots, ServerName: "localhost", Certificates: []tls.Certificate{pair}}
+		if err := handshake(t, config, withCert); err != nil {
+			t.Errorf("Expected a client with a certificate to connect, got %v", err)
+		}
+
+		withoutCert := &tls.Config{RootCAs: roots, ServerName: "localhost"}
+		if err := handshake(t, config, withoutCert); err == nil {
+			t.Error("Expected a client without a certificate to be rejected")
+		}
+	})
+
+	t.Run("invalid files", func(t *testing.T) {
+		if _, err := Load(filepath.Join(dir, "missing.crt"), keyFile, ""); err == nil {
+			t.Error("Expected error for a missing certificate")
+		}
+		if _, err := Load(certFile, keyFile, keyFile); err == nil {
+			t.Error("Expected error for a client CA without certificates")
+		}
+	})
+}
+
+func TestIsLocal(t *testing.T) {
+	tests := []struct {
+		addr net.Addr
+		want bool
+	}{
+		{&net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 50051}, true},
+		{&net.TCPAddr{IP: net.IPv6loopback, Port: 50051}, true},
+		{&net.TCPAddr{IP: net.IPv6unspecified, Port: 50051}, false},
+		{&net.TCPAddr{IP: net.IPv4(192, 168, 1, 2), Port: 50051}, false},
+		{&net.UnixAddr{Name: "/run/micro-journal.sock", Net: "unix"}, true},
+	}
+	for _, tt := range tests {
+		if got := IsLocal(tt.addr); got != tt.want {
+			t.Errorf("IsLocal(%v) = %v, want %v", tt.addr, got, tt.want)
+		}
+	}
+}
+
+func TestCheckPlaintext(t *testing.T) {
+	public := &net.TCPAddr{IP: net.IPv6unspecified, Port: 8081}
+	loopback := &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 8081}
+
+	if err := CheckPlaintext(public, false); !errors.Is(err, ErrPlaintext) {
+		t.Errorf("Expected a non-loopback address to be refused, got %v", err)
+	}
+	if err := CheckPlaintext(public, true); err != nil {
+		t.Errorf("Expected explicitly allowed plaintext to be accepted, got %v", err)
+	}
+	if err := CheckPlaintext(loopback, false); err != nil {
+		t.Errorf("Expected a loopback address to be accepted, got %v", err)
+	}
+}
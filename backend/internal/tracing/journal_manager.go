@@ -0,0 +1,189 @@
+package tracing
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/parkernilson/micro-journal/internal/domain"
+	"github.com/parkernilson/micro-journal/internal/manager"
+	"github.com/parkernilson/micro-journal/internal/service"
+)
+
+// JournalManager records a span for every call to the manager it wraps.
+type JournalManager struct {
+	next service.JournalManager
+}
+
+// NewJournalManager creates a new instance of JournalManager wrapping next.
+func NewJournalManager(next service.JournalManager) *JournalManager {
+	return &JournalManager{next: next}
+}
+
+// start starts a span named after a manager method.
+func (m *JournalManager) start(ctx context.Context, method string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return start(ctx, "JournalManager."+method, attrs...)
+}
+
+func (m *JournalManager) CreateEntry(ctx context.Context, title, content string, revealAt time.Time, doc *domain.Document, tags []string) (*domain.JournalEntry, error) {
+	ctx, span := m.start(ctx, "CreateEntry")
+	entry, err := m.next.CreateEntry(ctx, title, content, revealAt, doc, tags)
+	end(span, err)
+	return entry, err
+}
+
+func (m *JournalManager) GetEntry(ctx context.Context, id int64) (*domain.JournalEntry, error) {
+	ctx, span := m.start(ctx, "GetEntry", attribute.Int64("entry.id", id))
+	entry, err := m.next.GetEntry(ctx, id)
+	end(span, err)
+	return entry, err
+}
+
+func (m *JournalManager) UpdateEntry(ctx context.Context, id int64, title, content string, doc *domain.Document, tags []string, expectedVersion int64) (*domain.JournalEntry, error) {
+	ctx, span := m.start(ctx, "UpdateEntry", attribute.Int64("entry.id", id), attribute.Int64("entry.expected_version", expectedVersion))
+	entry, err := m.next.UpdateEntry(ctx, id, title, content, doc, tags, expectedVersion)
+	end(span, err)
+	return entry, err
+}
+
+func (m *JournalManager) DeleteEntry(ctx context.Context, id int64, permanent bool) error {
+	ctx, span := m.start(ctx, "DeleteEntry", attribute.Int64("entry.id", id), attribute.Bool("permanent", permanent))
+	err := m.next.DeleteEntry(ctx, id, permanent)
+	end(span, err)
+	return err
+}
+
+func (m *JournalManager) BatchCreateEntries(ctx context.Context, entries []*domain.JournalEntry) ([]manager.BatchResult, error) {
+	ctx, span := m.start(ctx, "BatchCreateEntries", attribute.Int("entries", len(entries)))
+	results, err := m.next.BatchCreateEntries(ctx, entries)
+	end(span, err)
+	return results, err
+}
+
+func (m *JournalManager) BatchGetEntries(ctx context.Context, ids []int64) ([]manager.BatchResult, error) {
+	ctx, span := m.start(ctx, "BatchGetEntries", attribute.Int("entries", len(ids)))
+	results, err := m.next.BatchGetEntries(ctx, ids)
+	end(span, err)
+	return results, err
+}
+
+func (m *JournalManager) BatchDeleteEntries(ctx context.Context, ids []int64, permanent bool) ([]manager.BatchResult, error) {
+	ctx, span := m.start(ctx, "BatchDeleteEntries", attribute.Int("entries", len(ids)), attribute.Bool("permanent", permanent))
+	results, err := m.next.BatchDeleteEntries(ctx, ids, permanent)
+	end(span, err)
+	return results, err
+}
+
+func (m *JournalManager) ListEntries(ctx context.Context, pageSize int32, pageToken string, opts manager.ListOptions) (*manager.ListEntriesResult, error) {
+	ctx, span := m.start(ctx, "ListEntries", attribute.Int("page_size", int(pageSize)))
+	result, err := m.next.ListEntries(ctx, pageSize, pageToken, opts)
+	end(span, err)
+	return result, err
+}
+
+func (m *JournalManager) SearchEntries(ctx context.Context, query string, pageSize int32, pageToken string) (*manager.ListEntriesResult, error) {
+	ctx, span := m.start(ctx, "SearchEntries", attribute.Int("page_size", int(pageSize)))
+	result, err := m.next.SearchEntries(ctx, query, pageSize, pageToken)
+	end(span, err)
+	return result, err
+}
+
+func (m *JournalManager) ListTags(ctx context.Context) ([]*domain.Tag, error) {
+	ctx, span := m.start(ctx, "ListTags")
+	tags, err := m.next.ListTags(ctx)
+	end(span, err)
+	return tags, err
+}
+
+func (m *JournalManager) RenameTag(ctx context.Context, name, newName string) (*domain.Tag, error) {
+	ctx, span := m.start(ctx, "RenameTag")
+	tag, err := m.next.RenameTag(ctx, name, newName)
+	end(span, err)
+	return tag, err
+}
+
+func (m *JournalManager) DeleteTag(ctx context.Context, name string) error {
+	ctx, span := m.start(ctx, "DeleteTag")
+	err := m.next.DeleteTag(ctx, name)
+	end(span, err)
+	return err
+}
+
+func (m *JournalManager) ListTrash(ctx context.Context, pageSize int32, pageToken string) (*manager.ListEntriesResult, error) {
+	ctx, span := m.start(ctx, "ListTrash", attribute.Int("page_size", int(pageSize)))
+	result, err := m.next.ListTrash(ctx, pageSize, pageToken)
+	end(span, err)
+	return result, err
+}
+
+func (m *JournalManager) RestoreEntry(ctx context.Context, id int64) (*domain.JournalEntry, error) {
+	ctx, span := m.start(ctx, "RestoreEntry", attribute.Int64("entry.id", id))
+	entry, err := m.next.RestoreEntry(ctx, id)
+	end(span, err)
+	return entry, err
+}
+
+func (m *JournalManager) PurgeTrash(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	ctx, span := m.start(ctx, "PurgeTrash")
+	purged, err := m.next.PurgeTrash(ctx, deletedBefore)
+	end(span, err)
+	return purged, err
+}
+
+func (m *JournalManager) ListRevisions(ctx context.Context, entryID int64, pageSize int32, pageToken string) (*manager.ListRevisionsResult, error) {
+	ctx, span := m.start(ctx, "ListRevisions", attribute.Int64("entry.id", entryID), attribute.Int("page_size", int(pageSize)))
+	result, err := m.next.ListRevisions(ctx, entryID, pageSize, pageToken)
+	end(span, err)
+	return result, err
+}
+
+func (m *JournalManager) SearchRevisions(ctx context.Context, entryID int64, query string, pageSize int32, pageToken string) (*manager.ListRevisionsResult, error) {
+	ctx, span := m.start(ctx, "SearchRevisions", attribute.Int64("entry.id", entryID), attribute.Int("page_size", int(pageSize)))
+	result, err := m.next.SearchRevisions(ctx, entryID, query, pageSize, pageToken)
+	end(span, err)
+	return result, err
+}
+
+func (m *JournalManager) RestoreRevision(ctx context.Context, entryID, revisionID int64) (*domain.JournalEntry, error) {
+	ctx, span := m.start(ctx, "RestoreRevision", attribute.Int64("entry.id", entryID), attribute.Int64("revision.id", revisionID))
+	entry, err := m.next.RestoreRevision(ctx, entryID, revisionID)
+	end(span, err)
+	return entry, err
+}
+
+func (m *JournalManager) VerifyArchive(ctx context.Context) (*domain.ArchiveReport, error) {
+	ctx, span := m.start(ctx, "VerifyArchive")
+	report, err := m.next.VerifyArchive(ctx)
+	end(span, err)
+	return report, err
+}
+
+func (m *JournalManager) PushBackup(ctx context.Context) (string, error) {
+	ctx, span := m.start(ctx, "PushBackup")
+	version, err := m.next.PushBackup(ctx)
+	end(span, err)
+	return version, err
+}
+
+func (m *JournalManager) ExportEntries(ctx context.Context, fn func(entry *domain.JournalEntry) error) error {
+	ctx, span := m.start(ctx, "ExportEntries")
+	err := m.next.ExportEntries(ctx, fn)
+	end(span, err)
+	return err
+}
+
+func (m *JournalManager) ImportEntries(ctx context.Context, entries []*domain.JournalEntry) (int64, error) {
+	ctx, span := m.start(ctx, "ImportEntries", attribute.Int("entries", len(entries)))
+	imported, err := m.next.ImportEntries(ctx, entries)
+	end(span, err)
+	return imported, err
+}
+
+func (m *JournalManager) SuggestTitle(ctx context.Context, content string) (string, error) {
+	ctx, span := m.start(ctx, "SuggestTitle")
+	title, err := m.next.SuggestTitle(ctx, content)
+	end(span, err)
+	return title, err
+}
@@ -0,0 +1,225 @@
+package tracing
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/parkernilson/micro-journal/internal/domain"
+)
+
+// JournalStore records a span for every call to the store it wraps.
+type JournalStore struct {
+	next domain.JournalStore
+}
+
+// backupJournalStore is a JournalStore over a store that can also push
+// backups, so wrapping does not hide that ability from the manager.
+type backupJournalStore struct {
+	*JournalStore
+	pusher domain.BackupPusher
+}
+
+// NewJournalStore creates a new instance of JournalStore wrapping next. The
+// result also implements domain.BackupPusher if next does.
+func NewJournalStore(next domain.JournalStore) domain.JournalStore {
+	s := &JournalStore{next: next}
+	if pusher, ok := next.(domain.BackupPusher); ok {
+		return &backupJournalStore{JournalStore: s, pusher: pusher}
+	}
+	return s
+}
+
+// start starts a span named after a store method.
+func (s *JournalStore) start(ctx context.Context, method string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return start(ctx, "JournalStore."+method, attrs...)
+}
+
+func (s *JournalStore) Create(ctx context.Context, title, content string, revealAt time.Time, doc *domain.Document, tags []string) (*domain.JournalEntry, error) {
+	ctx, span := s.start(ctx, "Create")
+	entry, err := s.next.Create(ctx, title, content, revealAt, doc, tags)
+	end(span, err)
+	return entry, err
+}
+
+func (s *JournalStore) GetByID(ctx context.Context, id int64) (*domain.JournalEntry, error) {
+	ctx, span := s.start(ctx, "GetByID", attribute.Int64("entry.id", id))
+	entry, err := s.next.GetByID(ctx, id)
+	end(span, err)
+	return entry, err
+}
+
+func (s *JournalStore) Update(ctx context.Context, id int64, title, content string, doc *domain.Document, tags []string, expectedVersion int64) (*domain.JournalEntry, error) {
+	ctx, span := s.start(ctx, "Update", attribute.Int64("entry.id", id), attribute.Int64("entry.expected_version", expectedVersion))
+	entry, err := s.next.Update(ctx, id, title, content, doc, tags, expectedVersion)
+	end(span, err)
+	return entry, err
+}
+
+func (s *JournalStore) Delete(ctx context.Context, id int64) error {
+	ctx, span := s.start(ctx, "Delete", attribute.Int64("entry.id", id))
+	err := s.next.Delete(ctx, id)
+	end(span, err)
+	return err
+}
+
+func (s *JournalStore) Purge(ctx context.Context, id int64) error {
+	ctx, span := s.start(ctx, "Purge", attribute.Int64("entry.id", id))
+	err := s.next.Purge(ctx, id)
+	end(span, err)
+	return err
+}
+
+func (s *JournalStore) List(ctx context.Context, filter domain.EntryFilter, limit, offset int) ([]*domain.JournalEntry, int64, error) {
+	ctx, span := s.start(ctx, "List", attribute.Int("limit", limit), attribute.Int("offset", offset))
+	entries, total, err := s.next.List(ctx, filter, limit, offset)
+	end(span, err)
+	return entries, total, err
+}
+
+func (s *JournalStore) Search(ctx context.Context, query string, limit, offset int) ([]*domain.JournalEntry, int64, error) {
+	ctx, span := s.start(ctx, "Search", attribute.Int("limit", limit), attribute.Int("offset", offset))
+	entries, total, err := s.next.Search(ctx, query, limit, offset)
+	end(span, err)
+	return entries, total, err
+}
+
+func (s *JournalStore) ListTags(ctx context.Context) ([]*domain.Tag, error) {
+	ctx, span := s.start(ctx, "ListTags")
+	tags, err := s.next.ListTags(ctx)
+	end(span, err)
+	return tags, err
+}
+
+func (s *JournalStore) RenameTag(ctx context.Context, name, newName string) (*domain.Tag, error) {
+	ctx, span := s.start(ctx, "RenameTag")
+	tag, err := s.next.RenameTag(ctx, name, newName)
+	end(span, err)
+	return tag, err
+}
+
+func (s *JournalStore) DeleteTag(ctx context.Context, name string) error {
+	ctx, span := s.start(ctx, "DeleteTag")
+	err := s.next.DeleteTag(ctx, name)
+	end(span, err)
+	return err
+}
+
+func (s *JournalStore) ListTrash(ctx context.Context, limit, offset int) ([]*domain.JournalEntry, int64, error) {
+	ctx, span := s.start(ctx, "ListTrash", attribute.Int("limit", limit), attribute.Int("offset", offset))
+	entries, total, err := s.next.ListTrash(ctx, limit, offset)
+	end(span, err)
+	return entries, total, err
+}
+
+func (s *JournalStore) Restore(ctx context.Context, id int64) (*domain.JournalEntry, error) {
+	ctx, span := s.start(ctx, "Restore", attribute.Int64("entry.id", id))
+	entry, err := s.next.Restore(ctx, id)
+	end(span, err)
+	return entry, err
+}
+
+func (s *JournalStore) PurgeTrash(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	ctx, span := s.start(ctx, "PurgeTrash")
+	purged, err := s.next.PurgeTrash(ctx, deletedBefore)
+	end(span, err)
+	return purged, err
+}
+
+func (s *JournalStore) ListRevisions(ctx context.Context, entryID int64, limit, offset int) ([]*domain.Revision, int64, error) {
+	ctx, span := s.start(ctx, "ListRevisions", attribute.Int64("entry.id", entryID), attribute.Int("limit", limit), attribute.Int("offset", offset))
+	revisions, total, err := s.next.ListRevisions(ctx, entryID, limit, offset)
+	end(span, err)
+	return revisions, total, err
+}
+
+func (s *JournalStore) SearchRevisions(ctx context.Context, entryID int64, query string, limit, offset int) ([]*domain.Revision, int64, error) {
+	ctx, span := s.start(ctx, "SearchRevisions", attribute.Int64("entry.id", entryID), attribute.Int("limit", limit), attribute.Int("offset", offset))
+	revisions, total, err := s.next.SearchRevisions(ctx, entryID, query, limit, offset)
+	end(span, err)
+	return revisions, total, err
+}
+
+func (s *JournalStore) RestoreRevision(ctx context.Context, entryID, revisionID int64) (*domain.JournalEntry, error) {
+	ctx, span := s.start(ctx, "RestoreRevision", attribute.Int64("entry.id", entryID), attribute.Int64("revision.id", revisionID))
+	entry, err := s.next.RestoreRevision(ctx, entryID, revisionID)
+	end(span, err)
+	return entry, err
+}
+
+func (s *JournalStore) VerifyArchive(ctx context.Context) (*domain.ArchiveReport, error) {
+	ctx, span := s.start(ctx, "VerifyArchive")
+	report, err := s.next.VerifyArchive(ctx)
+	end(span, err)
+	return report, err
+}
+
+func (s *JournalStore) Export(ctx context.Context, fn func(entry *domain.JournalEntry) error) error {
+	ctx, span := s.start(ctx, "Export")
+	err := s.next.Export(ctx, fn)
+	end(span, err)
+	return err
+}
+
+func (s *JournalStore) Import(ctx context.Context, entries []*domain.JournalEntry) (int64, error) {
+	ctx, span := s.start(ctx, "Import", attribute.Int("entries", len(entries)))
+	imported, err := s.next.Import(ctx, entries)
+	end(span, err)
+	return imported, err
+}
+
+func (s *JournalStore) WithTx(ctx context.Context, fn func(tx domain.JournalTx) error) error {
+	ctx, span := s.start(ctx, "WithTx")
+	err := s.next.WithTx(ctx, func(tx domain.JournalTx) error {
+		return fn(&journalTx{next: tx})
+	})
+	end(span, err)
+	return err
+}
+
+// journalTx records a span for every call to the transaction it wraps.
+type journalTx struct {
+	next domain.JournalTx
+}
+
+// start starts a span named after a transaction method.
+func (t *journalTx) start(ctx context.Context, method string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return start(ctx, "JournalTx."+method, attrs...)
+}
+
+func (t *journalTx) Create(ctx context.Context, title, content string, revealAt time.Time, doc *domain.Document, tags []string) (*domain.JournalEntry, error) {
+	ctx, span := t.start(ctx, "Create")
+	entry, err := t.next.Create(ctx, title, content, revealAt, doc, tags)
+	end(span, err)
+	return entry, err
+}
+
+func (t *journalTx) GetByID(ctx context.Context, id int64) (*domain.JournalEntry, error) {
+	ctx, span := t.start(ctx, "GetByID", attribute.Int64("entry.id", id))
+	entry, err := t.next.GetByID(ctx, id)
+	end(span, err)
+	return entry, err
+}
+
+func (t *journalTx) Delete(ctx context.Context, id int64) error {
+	ctx, span := t.start(ctx, "Delete", attribute.Int64("entry.id", id))
+	err := t.next.Delete(ctx, id)
+	end(span, err)
+	return err
+}
+
+func (t *journalTx) Purge(ctx context.Context, id int64) error {
+	ctx, span := t.start(ctx, "Purge", attribute.Int64("entry.id", id))
+	err := t.next.Purge(ctx, id)
+	end(span, err)
+	return err
+}
+
+func (s *backupJournalStore) PushBackup(ctx context.Context) (string, error) {
+	ctx, span := s.start(ctx, "PushBackup")
+	version, err := s.pusher.PushBackup(ctx)
+	end(span, err)
+	return version, err
+}
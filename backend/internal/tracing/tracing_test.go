@@ -0,0 +1,99 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/parkernilson/micro-journal/internal/domain"
+	"github.com/parkernilson/micro-journal/internal/manager"
+	"github.com/parkernilson/micro-journal/internal/store/memstore"
+)
+
+// recordSpans installs a tracer provider that records every span for the
+// rest of the test.
+func recordSpans(t *testing.T) *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+	return recorder
+}
+
+// backupStore is a memory store that can push backups.
+type backupStore struct {
+	*memstore.JournalStore
+}
+
+func (s *backupStore) PushBackup(ctx context.Context) (string, error) {
+	return "abc123", nil
+}
+
+func TestJournalManager_Spans(t *testing.T) {
+	recorder := recordSpans(t)
+	ctx := context.Background()
+
+	store := NewJournalStore(memstore.NewJournalStore())
+	m := NewJournalManager(manager.NewJournalManager(store))
+
+	if _, err := m.CreateEntry(ctx, "Title", "Content", time.Time{}, nil, nil); err != nil {
+		t.Fatalf("CreateEntry failed: %v", err)
+	}
+
+	t.Run("store span is a child of the manager span", func(t *testing.T) {
+		spans := recorder.Ended()
+		if len(spans) != 2 {
+			t.Fatalf("Expected 2 spans, got %d", len(spans))
+		}
+		storeSpan, managerSpan := spans[0], spans[1]
+		if storeSpan.Name() != "JournalStore.Create" || managerSpan.Name() != "JournalManager.CreateEntry" {
+			t.Fatalf("Unexpected span names %q and %q", storeSpan.Name(), managerSpan.Name())
+		}
+		if storeSpan.Parent().SpanID() != managerSpan.SpanContext().SpanID() {
+			t.Error("Expected the store span to be a child of the manager span")
+		}
+	})
+
+	t.Run("errors are recorded", func(t *testing.T) {
+		if _, err := m.GetEntry(ctx, 999); err == nil {
+			t.Fatal("Expected an error for a missing entry")
+		}
+		spans := recorder.Ended()
+		span := spans[len(spans)-1]
+		if span.Name() != "JournalManager.GetEntry" {
+			t.Fatalf("Unexpected span name %q", span.Name())
+		}
+		if span.Status().Code != codes.Error || len(span.Events()) == 0 {
+			t.Errorf("Expected an error status and event, got %+v", span.Status())
+		}
+	})
+}
+
+func TestNewJournalStore_BackupPusher(t *testing.T) {
+	t.Run("without backups", func(t *testing.T) {
+		if _, ok := NewJournalStore(memstore.NewJournalStore()).(domain.BackupPusher); ok {
+			t.Error("Expected the wrapped store not to push backups")
+		}
+	})
+
+	t.Run("with backups", func(t *testing.T) {
+		recorder := recordSpans(t)
+		store := NewJournalStore(&backupStore{memstore.NewJournalStore()})
+		pusher, ok := store.(domain.BackupPusher)
+		if !ok {
+			t.Fatal("Expected the wrapped store to push backups")
+		}
+		version, err := pusher.PushBackup(context.Background())
+		if err != nil || version != "abc123" {
+			t.Fatalf("PushBackup = %q, %v", version, err)
+		}
+		if spans := recorder.Ended(); len(spans) != 1 || spans[0].Name() != "JournalStore.PushBackup" {
+			t.Errorf("Expected a JournalStore.PushBackup span, got %d spans", len(spans))
+		}
+	})
+}
@@ -0,0 +1,25 @@
+-- Rebuild journal_entries as a STRICT table so column types and basic
+-- invariants are enforced by SQLite rather than only by the manager.
+-- STRICT tables do not accept the DATETIME type, so timestamps are TEXT and
+-- must parse as a valid SQLite date/time.
+BEGIN TRANSACTION;
+
+CREATE TABLE journal_entries_strict (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    title TEXT NOT NULL CHECK (length(title) > 0),
+    content TEXT NOT NULL CHECK (length(content) > 0),
+    created_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP CHECK (datetime(created_at) IS NOT NULL),
+    updated_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP CHECK (datetime(updated_at) IS NOT NULL)
+) STRICT;
+
+INSERT INTO journal_entries_strict (id, title, content, created_at, updated_at)
+SELECT id, title, content, created_at, updated_at FROM journal_entries;
+
+DROP TABLE journal_entries;
+
+ALTER TABLE journal_entries_strict RENAME TO journal_entries;
+
+-- Recreate index on created_at for sorting
+CREATE INDEX idx_journal_entries_created_at ON journal_entries(created_at DESC);
+
+COMMIT;
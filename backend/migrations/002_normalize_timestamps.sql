@@ -0,0 +1,34 @@
+-- Normalize created_at/updated_at to RFC 3339 UTC text with millisecond
+-- precision (e.g. 2024-01-02T03:04:05.000Z). The fixed width keeps lexical
+-- order identical to chronological order, and the explicit Z removes the
+-- timezone ambiguity of CURRENT_TIMESTAMP values. Existing values were
+-- written by CURRENT_TIMESTAMP, which is UTC, so they convert exactly.
+BEGIN TRANSACTION;
+
+CREATE TABLE journal_entries_normalized (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    title TEXT NOT NULL CHECK (length(title) > 0),
+    content TEXT NOT NULL CHECK (length(content) > 0),
+    created_at TEXT NOT NULL DEFAULT (strftime('%Y-%m-%dT%H:%M:%fZ', 'now'))
+        CHECK (created_at GLOB '[0-9][0-9][0-9][0-9]-[0-9][0-9]-[0-9][0-9]T[0-9][0-9]:[0-9][0-9]:[0-9][0-9].[0-9][0-9][0-9]Z'),
+    updated_at TEXT NOT NULL DEFAULT (strftime('%Y-%m-%dT%H:%M:%fZ', 'now'))
+        CHECK (updated_at GLOB '[0-9][0-9][0-9][0-9]-[0-9][0-9]-[0-9][0-9]T[0-9][0-9]:[0-9][0-9]:[0-9][0-9].[0-9][0-9][0-9]Z')
+) STRICT;
+
+INSERT INTO journal_entries_normalized (id, title, content, created_at, updated_at)
+SELECT
+    id,
+    title,
+    content,
+    strftime('%Y-%m-%dT%H:%M:%fZ', created_at),
+    strftime('%Y-%m-%dT%H:%M:%fZ', updated_at)
+FROM journal_entries;
+
+DROP TABLE journal_entries;
+
+ALTER TABLE journal_entries_normalized RENAME TO journal_entries;
+
+-- Recreate index on created_at for sorting
+CREATE INDEX idx_journal_entries_created_at ON journal_entries(created_at DESC);
+
+COMMIT;
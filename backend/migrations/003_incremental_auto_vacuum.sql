@@ -0,0 +1,7 @@
+-- Switch the database to incremental auto-vacuum so free pages can be
+-- reclaimed by the periodic PRAGMA incremental_vacuum maintenance task.
+-- Changing auto_vacuum on an existing database only takes effect after a
+-- VACUUM, which cannot run inside a transaction.
+PRAGMA auto_vacuum = INCREMENTAL;
+
+VACUUM;
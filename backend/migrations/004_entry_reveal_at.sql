@@ -0,0 +1,4 @@
+-- Add reveal_at for sealed entries ("letters to your future self").
+-- A NULL reveal_at means the entry is readable immediately.
+ALTER TABLE journal_entries ADD COLUMN reveal_at TEXT
+    CHECK (reveal_at IS NULL OR reveal_at GLOB '[0-9][0-9][0-9][0-9]-[0-9][0-9]-[0-9][0-9]T[0-9][0-9]:[0-9][0-9]:[0-9][0-9].[0-9][0-9][0-9]Z');
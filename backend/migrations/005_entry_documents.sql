@@ -0,0 +1,5 @@
+-- Add document for structured entries: versioned JSON made of typed
+-- sections. A NULL document means the entry is plain text; structured
+-- entries keep a plain-text rendering in content.
+ALTER TABLE journal_entries ADD COLUMN document TEXT
+    CHECK (document IS NULL OR json_valid(document));
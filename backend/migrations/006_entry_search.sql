@@ -0,0 +1,34 @@
+-- Add a full-text index over entry titles and content. The FTS5 table is
+-- external-content: it stores only the index and reads text from
+-- journal_entries, and the triggers below keep it in sync with every write.
+BEGIN TRANSACTION;
+
+CREATE VIRTUAL TABLE journal_entries_fts USING fts5(
+    title,
+    content,
+    content='journal_entries',
+    content_rowid='id',
+    tokenize='porter unicode61'
+);
+
+CREATE TRIGGER journal_entries_fts_insert AFTER INSERT ON journal_entries BEGIN
+    INSERT INTO journal_entries_fts (rowid, title, content)
+    VALUES (new.id, new.title, new.content);
+END;
+
+CREATE TRIGGER journal_entries_fts_delete AFTER DELETE ON journal_entries BEGIN
+    INSERT INTO journal_entries_fts (journal_entries_fts, rowid, title, content)
+    VALUES ('delete', old.id, old.title, old.content);
+END;
+
+CREATE TRIGGER journal_entries_fts_update AFTER UPDATE OF title, content ON journal_entries BEGIN
+    INSERT INTO journal_entries_fts (journal_entries_fts, rowid, title, content)
+    VALUES ('delete', old.id, old.title, old.content);
+    INSERT INTO journal_entries_fts (rowid, title, content)
+    VALUES (new.id, new.title, new.content);
+END;
+
+-- Index entries that existed before this migration
+INSERT INTO journal_entries_fts (journal_entries_fts) VALUES ('rebuild');
+
+COMMIT;
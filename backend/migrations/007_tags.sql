@@ -0,0 +1,31 @@
+-- Add tags. Tag names are unique and stored lowercase; entry_tags links
+-- entries to their tags. SQLite does not enforce foreign keys unless asked
+-- to, so triggers remove the links of deleted entries and drop tags that
+-- are no longer used by any entry.
+BEGIN TRANSACTION;
+
+CREATE TABLE tags (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL UNIQUE CHECK (length(name) > 0 AND name = lower(name))
+) STRICT;
+
+CREATE TABLE entry_tags (
+    entry_id INTEGER NOT NULL REFERENCES journal_entries(id) ON DELETE CASCADE,
+    tag_id INTEGER NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+    PRIMARY KEY (entry_id, tag_id)
+) STRICT, WITHOUT ROWID;
+
+-- Look up the entries of a tag for tag filters and renames
+CREATE INDEX idx_entry_tags_tag_id ON entry_tags(tag_id);
+
+CREATE TRIGGER journal_entries_tags_delete AFTER DELETE ON journal_entries BEGIN
+    DELETE FROM entry_tags WHERE entry_id = old.id;
+END;
+
+CREATE TRIGGER entry_tags_prune AFTER DELETE ON entry_tags BEGIN
+    DELETE FROM tags
+    WHERE id = old.tag_id
+      AND NOT EXISTS (SELECT 1 FROM entry_tags WHERE tag_id = old.tag_id);
+END;
+
+COMMIT;
@@ -0,0 +1,13 @@
+-- Add deleted_at for soft deletes. Deleted entries stay in the trash, hidden
+-- from reads, until they are restored or purged. A NULL deleted_at means the
+-- entry is not in the trash.
+BEGIN TRANSACTION;
+
+ALTER TABLE journal_entries ADD COLUMN deleted_at TEXT
+    CHECK (deleted_at IS NULL OR deleted_at GLOB '[0-9][0-9][0-9][0-9]-[0-9][0-9]-[0-9][0-9]T[0-9][0-9]:[0-9][0-9]:[0-9][0-9].[0-9][0-9][0-9]Z');
+
+-- List the trash by deletion time without scanning live entries
+CREATE INDEX idx_journal_entries_deleted_at ON journal_entries(deleted_at DESC)
+    WHERE deleted_at IS NOT NULL;
+
+COMMIT;
@@ -0,0 +1,22 @@
+-- Add entry_revisions. Each update saves the entry's previous title,
+-- content, and document here so edits can be listed and rolled back.
+-- created_at is when that version was written (the entry's updated_at at the
+-- time). Revisions are removed along with their entry when it is purged.
+BEGIN TRANSACTION;
+
+CREATE TABLE entry_revisions (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    entry_id INTEGER NOT NULL REFERENCES journal_entries(id) ON DELETE CASCADE,
+    title TEXT NOT NULL CHECK (length(title) > 0),
+    content TEXT NOT NULL CHECK (length(content) > 0),
+    document TEXT CHECK (document IS NULL OR json_valid(document)),
+    created_at TEXT NOT NULL CHECK (created_at GLOB '[0-9][0-9][0-9][0-9]-[0-9][0-9]-[0-9][0-9]T[0-9][0-9]:[0-9][0-9]:[0-9][0-9].[0-9][0-9][0-9]Z')
+) STRICT;
+
+CREATE INDEX idx_entry_revisions_entry_id ON entry_revisions(entry_id, id DESC);
+
+CREATE TRIGGER journal_entries_revisions_delete AFTER DELETE ON journal_entries BEGIN
+    DELETE FROM entry_revisions WHERE entry_id = old.id;
+END;
+
+COMMIT;
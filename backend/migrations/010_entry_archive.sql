@@ -0,0 +1,29 @@
+-- Add entry_archive, an append-only log of every version of every entry.
+-- Each record's hash covers its contents and the previous record's hash, so
+-- altering or removing any record breaks the chain from that point on.
+-- Records are kept after their entry is purged, and triggers reject any
+-- update or delete of the table itself.
+BEGIN TRANSACTION;
+
+CREATE TABLE entry_archive (
+    seq INTEGER PRIMARY KEY AUTOINCREMENT,
+    entry_id INTEGER NOT NULL,
+    title TEXT NOT NULL,
+    content TEXT NOT NULL,
+    document TEXT CHECK (document IS NULL OR json_valid(document)),
+    recorded_at TEXT NOT NULL CHECK (recorded_at GLOB '[0-9][0-9][0-9][0-9]-[0-9][0-9]-[0-9][0-9]T[0-9][0-9]:[0-9][0-9]:[0-9][0-9].[0-9][0-9][0-9]Z'),
+    prev_hash TEXT NOT NULL CHECK (length(prev_hash) = 64),
+    hash TEXT NOT NULL UNIQUE CHECK (length(hash) = 64)
+) STRICT;
+
+CREATE INDEX idx_entry_archive_entry_id ON entry_archive(entry_id, seq DESC);
+
+CREATE TRIGGER entry_archive_no_update BEFORE UPDATE ON entry_archive BEGIN
+    SELECT RAISE(ABORT, 'entry_archive is append-only');
+END;
+
+CREATE TRIGGER entry_archive_no_delete BEFORE DELETE ON entry_archive BEGIN
+    SELECT RAISE(ABORT, 'entry_archive is append-only');
+END;
+
+COMMIT;
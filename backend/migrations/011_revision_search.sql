@@ -0,0 +1,27 @@
+-- Add a full-text index over entry revisions, so an entry's history can be
+-- searched. Like journal_entries_fts it is external-content; revisions are
+-- never updated, so only inserts and deletes need triggers.
+BEGIN TRANSACTION;
+
+CREATE VIRTUAL TABLE entry_revisions_fts USING fts5(
+    title,
+    content,
+    content='entry_revisions',
+    content_rowid='id',
+    tokenize='porter unicode61'
+);
+
+CREATE TRIGGER entry_revisions_fts_insert AFTER INSERT ON entry_revisions BEGIN
+    INSERT INTO entry_revisions_fts (rowid, title, content)
+    VALUES (new.id, new.title, new.content);
+END;
+
+CREATE TRIGGER entry_revisions_fts_delete AFTER DELETE ON entry_revisions BEGIN
+    INSERT INTO entry_revisions_fts (entry_revisions_fts, rowid, title, content)
+    VALUES ('delete', old.id, old.title, old.content);
+END;
+
+-- Index revisions that existed before this migration
+INSERT INTO entry_revisions_fts (entry_revisions_fts) VALUES ('rebuild');
+
+COMMIT;
@@ -0,0 +1,4 @@
+-- Add version to journal_entries for optimistic concurrency. It starts at 1
+-- and is incremented each time the entry's content changes, so an update can
+-- require that the entry has not changed since the client read it.
+ALTER TABLE journal_entries ADD COLUMN version INTEGER NOT NULL DEFAULT 1 CHECK (version > 0);
@@ -0,0 +1,4 @@
+-- Add deleted to entry_archive. Purging an archived entry appends a record
+-- with deleted set, so an entry removed outside of the API can be told apart
+-- from one that was purged.
+ALTER TABLE entry_archive ADD COLUMN deleted INTEGER NOT NULL DEFAULT 0 CHECK (deleted IN (0, 1));
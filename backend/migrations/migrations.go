@@ -0,0 +1,102 @@
+// Package migrations embeds the SQL migrations and applies them to a
+// database, recording progress in the same schema_migrations table that
+// script/migrate.sh uses.
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strings"
+)
+
+//go:embed *.sql
+var files embed.FS
+
+//go:embed postgres/*.sql
+var postgresFiles embed.FS
+
+// Apply runs every migration that has not been recorded in
+// schema_migrations, in filename order, and returns the versions applied.
+// Migration files manage their own transactions, so a failed migration may
+// leave earlier statements in the same file applied.
+func Apply(ctx context.Context, db *sql.DB) ([]string, error) {
+	return apply(ctx, db, files, dialect{
+		createTable: `
+			CREATE TABLE IF NOT EXISTS schema_migrations (
+				version TEXT PRIMARY KEY,
+				applied_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+			)
+		`,
+		placeholder: "?",
+	})
+}
+
+// ApplyPostgres is Apply for a PostgreSQL database, using the PostgreSQL
+// migrations in postgres/.
+func ApplyPostgres(ctx context.Context, db *sql.DB) ([]string, error) {
+	sub, err := fs.Sub(postgresFiles, "postgres")
+	if err != nil {
+		return nil, fmt.Errorf("failed to open postgres migrations: %w", err)
+	}
+	return apply(ctx, db, sub, dialect{
+		createTable: `
+			CREATE TABLE IF NOT EXISTS schema_migrations (
+				version TEXT PRIMARY KEY,
+				applied_at TIMESTAMPTZ NOT NULL DEFAULT now()
+			)
+		`,
+		placeholder: "$1",
+	})
+}
+
+// dialect holds the SQL that differs between databases.
+type dialect struct {
+	createTable string
+	placeholder string
+}
+
+// apply runs the migrations in files that have not been applied yet.
+func apply(ctx context.Context, db *sql.DB, files fs.FS, d dialect) ([]string, error) {
+	if _, err := db.ExecContext(ctx, d.createTable); err != nil {
+		return nil, fmt.Errorf("failed to create schema_migrations table: %w", err)
+	}
+
+	names, err := fs.Glob(files, "*.sql")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list migrations: %w", err)
+	}
+	sort.Strings(names)
+
+	var applied []string
+	for _, name := range names {
+		version := strings.TrimSuffix(name, ".sql")
+
+		var count int
+		err := db.QueryRowContext(ctx, `SELECT COUNT(*) FROM schema_migrations WHERE version = `+d.placeholder, version).Scan(&count)
+		if err != nil {
+			return applied, fmt.Errorf("failed to check migration %s: %w", version, err)
+		}
+		if count > 0 {
+			continue
+		}
+
+		migration, err := fs.ReadFile(files, name)
+		if err != nil {
+			return applied, fmt.Errorf("failed to read migration %s: %w", version, err)
+		}
+		if _, err := db.ExecContext(ctx, string(migration)); err != nil {
+			return applied, fmt.Errorf("failed to apply migration %s: %w", version, err)
+		}
+		if _, err := db.ExecContext(ctx, `INSERT INTO schema_migrations (version) VALUES (`+d.placeholder+`)`, version); err != nil {
+			return applied, fmt.Errorf("failed to record migration %s: %w", version, err)
+		}
+
+		applied = append(applied, version)
+	}
+
+	return applied, nil
+}
@@ -0,0 +1,48 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	_ "modernc.org/sqlite"
+)
+
+func TestApply(t *testing.T) {
+	db, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		t.Fatalf("failed to open in-memory database: %v", err)
+	}
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	ctx := context.Background()
+
+	applied, err := Apply(ctx, db)
+	if err != nil {
+		t.Fatalf("Apply failed: %v", err)
+	}
+	if len(applied) == 0 {
+		t.Fatal("Expected migrations to be applied")
+	}
+	if applied[0] != "000_initial_schema" {
+		t.Errorf("Expected first migration '000_initial_schema', got '%s'", applied[0])
+	}
+
+	var count int
+	if err := db.QueryRow(`SELECT COUNT(*) FROM schema_migrations`).Scan(&count); err != nil {
+		t.Fatalf("failed to count recorded migrations: %v", err)
+	}
+	if count != len(applied) {
+		t.Errorf("Expected %d recorded migrations, got %d", len(applied), count)
+	}
+
+	// Applying again is a no-op
+	applied, err = Apply(ctx, db)
+	if err != nil {
+		t.Fatalf("second Apply failed: %v", err)
+	}
+	if len(applied) != 0 {
+		t.Errorf("Expected no migrations on second run, got %v", applied)
+	}
+}
@@ -0,0 +1,67 @@
+-- Schema for the PostgreSQL store, equivalent to the SQLite migrations up to
+-- 011_revision_search. The SQLite-only entry archive is not included.
+BEGIN;
+
+CREATE TABLE journal_entries (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    title TEXT NOT NULL CHECK (length(title) > 0),
+    content TEXT NOT NULL CHECK (length(content) > 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    reveal_at TIMESTAMPTZ,
+    document JSONB,
+    deleted_at TIMESTAMPTZ,
+    search TSVECTOR GENERATED ALWAYS AS (
+        setweight(to_tsvector('english', title), 'A') ||
+        setweight(to_tsvector('english', content), 'D')
+    ) STORED
+);
+
+CREATE INDEX idx_journal_entries_created_at ON journal_entries (created_at DESC, id DESC);
+CREATE INDEX idx_journal_entries_deleted_at ON journal_entries (deleted_at DESC)
+    WHERE deleted_at IS NOT NULL;
+CREATE INDEX idx_journal_entries_search ON journal_entries USING GIN (search);
+
+CREATE TABLE tags (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    name TEXT NOT NULL UNIQUE CHECK (length(name) > 0 AND name = lower(name))
+);
+
+CREATE TABLE entry_tags (
+    entry_id BIGINT NOT NULL REFERENCES journal_entries (id) ON DELETE CASCADE,
+    tag_id BIGINT NOT NULL REFERENCES tags (id) ON DELETE CASCADE,
+    PRIMARY KEY (entry_id, tag_id)
+);
+
+CREATE INDEX idx_entry_tags_tag_id ON entry_tags (tag_id);
+
+-- Delete tags that no longer have any entries
+CREATE FUNCTION entry_tags_prune() RETURNS trigger AS $$
+BEGIN
+    DELETE FROM tags
+    WHERE id = old.tag_id
+      AND NOT EXISTS (SELECT 1 FROM entry_tags WHERE tag_id = old.tag_id);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER entry_tags_prune AFTER DELETE ON entry_tags
+    FOR EACH ROW EXECUTE FUNCTION entry_tags_prune();
+
+CREATE TABLE entry_revisions (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    entry_id BIGINT NOT NULL REFERENCES journal_entries (id) ON DELETE CASCADE,
+    title TEXT NOT NULL CHECK (length(title) > 0),
+    content TEXT NOT NULL CHECK (length(content) > 0),
+    document JSONB,
+    created_at TIMESTAMPTZ NOT NULL,
+    search TSVECTOR GENERATED ALWAYS AS (
+        setweight(to_tsvector('english', title), 'A') ||
+        setweight(to_tsvector('english', content), 'D')
+    ) STORED
+);
+
+CREATE INDEX idx_entry_revisions_entry_id ON entry_revisions (entry_id, id DESC);
+CREATE INDEX idx_entry_revisions_search ON entry_revisions USING GIN (search);
+
+COMMIT;
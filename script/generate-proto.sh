@@ -15,7 +15,7 @@ echo -e "${YELLOW}Generating protobuf code...${NC}"
 cd "$(dirname "$0")/.."
 
 # Create output directories if they don't exist
-mkdir -p backend/gen/proto/journal/v1
+mkdir -p backend/gen/journal/v1
 mkdir -p frontend/MicroJournal/MicroJournal/Generated
 
 # Generate Go code from proto files
@@ -25,6 +25,8 @@ protoc \
   --go_opt=paths=source_relative \
   --go-grpc_out=backend/gen \
   --go-grpc_opt=paths=source_relative \
+  --connect-go_out=backend/gen \
+  --connect-go_opt=paths=source_relative,simple \
   --proto_path=proto \
   proto/journal/v1/journal.proto
 
@@ -39,8 +41,9 @@ protoc \
 echo -e "${GREEN}Protobuf code generated successfully!${NC}"
 echo -e "Generated files:"
 echo -e "  Go:"
-echo -e "    - backend/gen/proto/journal/v1/journal.pb.go"
-echo -e "    - backend/gen/proto/journal/v1/journal_grpc.pb.go"
+echo -e "    - backend/gen/journal/v1/journal.pb.go"
+echo -e "    - backend/gen/journal/v1/journal_grpc.pb.go"
+echo -e "    - backend/gen/journal/v1/journalv1connect/journal.connect.go"
 echo -e "  Swift:"
 echo -e "    - frontend/MicroJournal/MicroJournal/Generated/journal.pb.swift"
 echo -e "    - frontend/MicroJournal/MicroJournal/Generated/journal.grpc.swift"
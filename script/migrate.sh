@@ -2,8 +2,8 @@
 
 set -e
 
-# Database path
-DB_PATH="backend/data/micro_journal.db"
+# Database path (MJ_DB_PATH overrides it, as it does for the server)
+DB_PATH="${MJ_DB_PATH:-backend/data/micro_journal.db}"
 MIGRATIONS_DIR="backend/migrations"
 
 # Get script directory